@@ -0,0 +1,102 @@
+package twitcheventsub
+
+import "sync"
+
+// BroadcastEntry is a value published on a Broadcaster with its sequence number, starting at 1.
+type BroadcastEntry[T any] struct {
+	Seq   uint64
+	Value T
+}
+
+// Broadcaster fans the values published on it out to receivers and keeps the most recent ones in a ring buffer,
+// so a receiver that reconnects can resume where it stopped. A receiver whose queue is full is dropped and its
+// channel closed, a slow consumer never blocks the client delivering notifications. It backs SSEServer and the
+// gRPC server of eventsubgrpc.
+type Broadcaster[T any] struct {
+	size int
+
+	mu  sync.Mutex
+	seq uint64
+	// buffer holds the last size entries, once full the oldest one is at start
+	buffer    []BroadcastEntry[T]
+	start     int
+	receivers map[*BroadcastReceiver[T]]struct{}
+}
+
+// BroadcastReceiver receives the values of a Broadcaster accepted by its match function.
+type BroadcastReceiver[T any] struct {
+	b     *Broadcaster[T]
+	match func(T) bool
+	queue chan BroadcastEntry[T]
+}
+
+// NewBroadcaster creates a Broadcaster keeping the last bufferSize values.
+func NewBroadcaster[T any](bufferSize int) *Broadcaster[T] {
+	return &Broadcaster[T]{size: max(bufferSize, 1), receivers: map[*BroadcastReceiver[T]]struct{}{}}
+}
+
+// Publish buffers the value built by f from its sequence number and queues it for the receivers it matches.
+func (b *Broadcaster[T]) Publish(f func(seq uint64) T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq++
+	e := BroadcastEntry[T]{Seq: b.seq, Value: f(b.seq)}
+	if len(b.buffer) < b.size {
+		b.buffer = append(b.buffer, e)
+	} else {
+		b.buffer[b.start] = e
+		b.start = (b.start + 1) % b.size
+	}
+	for r := range b.receivers {
+		if r.match != nil && !r.match(e.Value) {
+			continue
+		}
+		select {
+		case r.queue <- e:
+		default:
+			delete(b.receivers, r)
+			close(r.queue)
+		}
+	}
+}
+
+// Subscribe adds a receiver for the values match accepts, all of them when match is nil, queueing up to queue
+// values. When after is not nil the buffered values the receiver accepts that come after the last entry after
+// returns true for are returned as backlog, ok is false when there is no such entry and the backlog then holds
+// every buffered value the receiver accepts.
+func (b *Broadcaster[T]) Subscribe(queue int, match func(T) bool, after func(e BroadcastEntry[T]) bool) (r *BroadcastReceiver[T], backlog []BroadcastEntry[T], ok bool) {
+	r = &BroadcastReceiver[T]{b: b, match: match, queue: make(chan BroadcastEntry[T], queue)}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if after != nil {
+		n := len(b.buffer)
+		start := 0
+		for i := range n {
+			if after(b.buffer[(b.start+i)%n]) {
+				start, ok = i+1, true
+			}
+		}
+		for i := start; i < n; i++ {
+			if e := b.buffer[(b.start+i)%n]; match == nil || match(e.Value) {
+				backlog = append(backlog, e)
+			}
+		}
+	}
+	b.receivers[r] = struct{}{}
+	return r, backlog, ok
+}
+
+// C returns the channel of the receiver, it is closed when the receiver is dropped for being too slow or closed.
+func (r *BroadcastReceiver[T]) C() <-chan BroadcastEntry[T] {
+	return r.queue
+}
+
+// Close removes the receiver from its Broadcaster.
+func (r *BroadcastReceiver[T]) Close() {
+	r.b.mu.Lock()
+	defer r.b.mu.Unlock()
+	if _, ok := r.b.receivers[r]; ok {
+		delete(r.b.receivers, r)
+		close(r.queue)
+	}
+}
@@ -0,0 +1,93 @@
+package twitcheventsub_test
+
+import (
+	"slices"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+)
+
+func publishInts(b *twitcheventsub.Broadcaster[int], n int) {
+	for range n {
+		b.Publish(func(seq uint64) int {
+			return int(seq)
+		})
+	}
+}
+
+func values(entries []twitcheventsub.BroadcastEntry[int]) []int {
+	var v []int
+	for _, e := range entries {
+		v = append(v, e.Value)
+	}
+	return v
+}
+
+func after(seq uint64) func(e twitcheventsub.BroadcastEntry[int]) bool {
+	return func(e twitcheventsub.BroadcastEntry[int]) bool {
+		return e.Seq == seq
+	}
+}
+
+func TestBroadcasterBacklog(t *testing.T) {
+	b := twitcheventsub.NewBroadcaster[int](4)
+	// wraps the ring twice
+	publishInts(b, 10)
+	odd := func(v int) bool { return v%2 == 1 }
+	tests := []struct {
+		name    string
+		match   func(int) bool
+		after   func(e twitcheventsub.BroadcastEntry[int]) bool
+		backlog []int
+		ok      bool
+	}{
+		{"no resume", nil, nil, nil, false},
+		{"buffered entry", nil, after(8), []int{9, 10}, true},
+		{"oldest entry", nil, after(7), []int{8, 9, 10}, true},
+		{"newest entry", nil, after(10), nil, true},
+		{"evicted entry", nil, after(6), []int{7, 8, 9, 10}, false},
+		{"matching", odd, after(7), []int{9}, true},
+		{"evicted matching", odd, after(2), []int{7, 9}, false},
+	}
+	for _, tt := range tests {
+		r, backlog, ok := b.Subscribe(1, tt.match, tt.after)
+		r.Close()
+		if !slices.Equal(values(backlog), tt.backlog) || ok != tt.ok {
+			t.Errorf("%s: backlog %v, %t, want %v, %t", tt.name, values(backlog), ok, tt.backlog, tt.ok)
+		}
+	}
+}
+
+func TestBroadcasterReceivers(t *testing.T) {
+	b := twitcheventsub.NewBroadcaster[int](4)
+	even, _, _ := b.Subscribe(8, func(v int) bool { return v%2 == 0 }, nil)
+	defer even.Close()
+	slow, _, _ := b.Subscribe(2, nil, nil)
+	closed, _, _ := b.Subscribe(8, nil, nil)
+	closed.Close()
+	publishInts(b, 5)
+
+	var got []int
+	for range 2 {
+		e := <-even.C()
+		if uint64(e.Value) != e.Seq {
+			t.Errorf("entry %d has sequence %d", e.Value, e.Seq)
+		}
+		got = append(got, e.Value)
+	}
+	if !slices.Equal(got, []int{2, 4}) {
+		t.Errorf("matching receiver got %v, want [2 4]", got)
+	}
+	// the slow receiver keeps what it queued and is then dropped
+	got = nil
+	for e := range slow.C() {
+		got = append(got, e.Value)
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("slow receiver got %v before being dropped, want [1 2]", got)
+	}
+	slow.Close()
+	if _, ok := <-closed.C(); ok {
+		t.Error("closed receiver got a value")
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+)
+
+// config holds the credentials, read from the config file and then overridden by the environment.
+type config struct {
+	ClientID string `json:"client_id"`
+	Token    string `json:"token"`
+	Secret   string `json:"secret"`
+	Callback string `json:"callback"`
+	BaseURL  string `json:"base_url"`
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "eventsub", "config.json")
+}
+
+// configFlag registers the --config flag on fs.
+func configFlag(fs *flag.FlagSet) *string {
+	return fs.String("config", defaultConfigPath(), "JSON config file with client_id, token, secret, callback and base_url")
+}
+
+// loadConfig reads path, a missing file is not an error, then applies the EVENTSUB_* environment variables.
+func loadConfig(path string) (config, error) {
+	var cfg config
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return cfg, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(b, &cfg); err != nil {
+				return cfg, fmt.Errorf("error decoding %s: %w", path, err)
+			}
+		}
+	}
+	for env, v := range map[string]*string{"EVENTSUB_CLIENT_ID": &cfg.ClientID, "EVENTSUB_TOKEN": &cfg.Token,
+		"EVENTSUB_SECRET": &cfg.Secret, "EVENTSUB_CALLBACK": &cfg.Callback, "EVENTSUB_BASE_URL": &cfg.BaseURL} {
+		if e := os.Getenv(env); e != "" {
+			*v = e
+		}
+	}
+	return cfg, nil
+}
+
+// client returns a client for the subscription API, which needs the client id and an app access token.
+func (cfg config) client() (*twitcheventsub.Client, error) {
+	if cfg.ClientID == "" || cfg.Token == "" {
+		return nil, errors.New("client id and token are required, set them in the config file or EVENTSUB_CLIENT_ID and EVENTSUB_TOKEN")
+	}
+	c := twitcheventsub.NewClient(cfg.Secret, cfg.Callback)
+	if cfg.BaseURL != "" {
+		c.SetBaseURL(cfg.BaseURL)
+	}
+	return c, nil
+}
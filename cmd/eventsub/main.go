@@ -0,0 +1,94 @@
+// Command eventsub is a development tool for Twitch EventSub webhooks.
+//
+// Usage:
+//
+//	eventsub list [flags]
+//	eventsub create [flags] <subscription type>
+//	eventsub delete [flags] [subscription id...]
+//	eventsub verify-callback [flags]
+//	eventsub trigger [flags] <subscription type>
+//
+// The commands read the client id, app access token, secret and callback from the config file and the
+// EVENTSUB_CLIENT_ID, EVENTSUB_TOKEN, EVENTSUB_SECRET and EVENTSUB_CALLBACK environment variables, trigger only
+// needs the secret.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+const usage = `Usage: eventsub <command> [flags] [arguments]
+
+Commands:
+  list              list subscriptions
+  create            create a webhook subscription
+  delete            delete subscriptions by id or by status
+  verify-callback   send a signed challenge to a callback and check the answer
+  trigger           send signed fake notifications to a callback
+
+Run eventsub <command> -h for the flags of a command.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "list":
+		err = list(os.Args[2:])
+	case "create":
+		err = create(os.Args[2:])
+	case "delete":
+		err = remove(os.Args[2:])
+	case "verify-callback":
+		err = verifyCallback(os.Args[2:])
+	case "trigger":
+		err = trigger(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "eventsub: "+err.Error())
+		os.Exit(1)
+	}
+}
+
+// parseArgs parses args with fs and returns the positional arguments, flags may come before or after them
+// like in eventsub trigger channel.cheer --count 3. Everything after -- is positional.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		if n := len(args) - len(rest); n > 0 && args[n-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (l *stringList) String() string {
+	return fmt.Sprint(*l)
+}
+
+func (l *stringList) Set(v string) error {
+	*l = append(*l, v)
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"slices"
+	"testing"
+)
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		args       []string
+		positional []string
+		count      int
+		verbose    bool
+	}{
+		{[]string{"channel.cheer"}, []string{"channel.cheer"}, 1, false},
+		{[]string{"--count", "3", "channel.cheer"}, []string{"channel.cheer"}, 3, false},
+		{[]string{"channel.cheer", "--count", "3"}, []string{"channel.cheer"}, 3, false},
+		{[]string{"a", "-v", "b", "--count=2", "c"}, []string{"a", "b", "c"}, 2, true},
+		{[]string{"a", "--", "--count", "3"}, []string{"a", "--count", "3"}, 1, false},
+		{nil, nil, 1, false},
+	}
+	for _, tt := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		count := fs.Int("count", 1, "")
+		verbose := fs.Bool("v", false, "")
+		positional, err := parseArgs(fs, tt.args)
+		if err != nil {
+			t.Errorf("%q: %v", tt.args, err)
+			continue
+		}
+		if !slices.Equal(positional, tt.positional) || *count != tt.count || *verbose != tt.verbose {
+			t.Errorf("%q: positional %q, count %d, v %t, want %q, %d, %t", tt.args, positional, *count, *verbose,
+				tt.positional, tt.count, tt.verbose)
+		}
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Int("count", 1, "")
+	if _, err := parseArgs(fs, []string{"channel.cheer", "--unknown"}); err == nil {
+		t.Error("unknown flag after a positional argument accepted")
+	}
+}
@@ -0,0 +1,321 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+// listAll follows the pagination cursor until every matching subscription has been read. Helix takes a single
+// filter among status, type and user_id, so the narrowest one is sent and the others are applied here.
+func listAll(c *twitcheventsub.Client, cfg config, subType, userId string, status []string) ([]twitcheventsub.Subscription, error) {
+	var query struct {
+		subType, userId string
+		status          []string
+	}
+	switch {
+	case userId != "":
+		query.userId = userId
+	case subType != "":
+		query.subType = subType
+	case len(status) == 1:
+		query.status = status
+	}
+	var subs []twitcheventsub.Subscription
+	after := ""
+	for {
+		res, err := c.GetSubscriptions(cfg.Token, cfg.ClientID, query.subType, query.userId, after, query.status)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range res.Data {
+			if matches(s, subType, userId, status) {
+				subs = append(subs, s)
+			}
+		}
+		if res.Pagination.Cursor == "" || len(res.Data) == 0 {
+			return subs, nil
+		}
+		after = res.Pagination.Cursor
+	}
+}
+
+// matches applies the list filters to s, a user matches any condition field that refers to it.
+func matches(s twitcheventsub.Subscription, subType, userId string, status []string) bool {
+	c := s.Condition
+	switch {
+	case len(status) > 0 && !slices.Contains(status, s.Status):
+		return false
+	case subType != "" && s.Type != subType:
+		return false
+	case userId != "" && c.BroadcasterUserId != userId && c.UserID != userId && c.ModeratorUserId != userId &&
+		c.FromBroadcasterUserId != userId && c.ToBroadcasterUserId != userId:
+		return false
+	}
+	return true
+}
+
+func list(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	path := configFlag(fs)
+	var status stringList
+	fs.Var(&status, "status", "only list subscriptions with this status, repeatable")
+	subType := fs.String("type", "", "only list subscriptions of this type")
+	user := fs.String("user", "", "only list subscriptions whose condition refers to this user id")
+	asJSON := fs.Bool("json", false, "print the subscriptions as JSON instead of a table")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	cfg, err := loadConfig(*path)
+	if err != nil {
+		return err
+	}
+	c, err := cfg.client()
+	if err != nil {
+		return err
+	}
+	subs, err := listAll(c, cfg, *subType, *user, status)
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(subs)
+	}
+	printTable(subs)
+	return nil
+}
+
+func printTable(subs []twitcheventsub.Subscription) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSTATUS\tTYPE\tVERSION\tCONDITION\tCALLBACK\tCREATED")
+	for _, s := range subs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", s.Id, s.Status, s.Type, s.Version, conditionString(s.Condition),
+			s.Transport.Callback, s.CreatedAt.Format(time.RFC3339))
+	}
+	w.Flush()
+}
+
+// conditionFields are the JSON names of the twitcheventsub.Condition fields.
+var conditionFields = []string{"broadcaster_user_id", "moderator_user_id", "user_id", "from_broadcaster_user_id",
+	"to_broadcaster_user_id", "organization_id", "category_id", "campaign_id", "client_id"}
+
+// conditionString returns the non empty condition fields as name=value pairs.
+func conditionString(c twitcheventsub.Condition) string {
+	b, _ := json.Marshal(c)
+	var m map[string]string
+	json.Unmarshal(b, &m)
+	var parts []string
+	for _, k := range conditionFields {
+		if m[k] != "" {
+			parts = append(parts, k+"="+m[k])
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// parseCondition builds a condition from the --broadcaster and --condition flags, a name that is not a condition
+// field is an error instead of being dropped from the request.
+func parseCondition(broadcaster string, conditions []string) (twitcheventsub.Condition, error) {
+	var c twitcheventsub.Condition
+	m := map[string]string{}
+	if broadcaster != "" {
+		m["broadcaster_user_id"] = broadcaster
+	}
+	for _, cond := range conditions {
+		name, value, ok := strings.Cut(cond, "=")
+		if !ok {
+			return c, fmt.Errorf("invalid condition %q, expected name=value", cond)
+		}
+		if !slices.Contains(conditionFields, name) {
+			return c, fmt.Errorf("unknown condition %q, expected one of %s", name, strings.Join(conditionFields, ", "))
+		}
+		m[name] = value
+	}
+	b, _ := json.Marshal(m)
+	err := json.Unmarshal(b, &c)
+	return c, err
+}
+
+func create(args []string) error {
+	fs := flag.NewFlagSet("create", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: eventsub create [flags] <subscription type>")
+		fs.PrintDefaults()
+	}
+	path := configFlag(fs)
+	version := fs.String("version", "1", "subscription type version")
+	broadcaster := fs.String("broadcaster", "", "broadcaster_user_id condition")
+	callback := fs.String("callback", "", "callback URL, defaults to the configured callback")
+	secret := fs.String("secret", "", "webhook secret, defaults to the configured secret")
+	var conditions stringList
+	fs.Var(&conditions, "condition", "extra condition as name=value, for example moderator_user_id=123, repeatable")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		fs.Usage()
+		return errors.New("expected a subscription type")
+	}
+	cfg, err := loadConfig(*path)
+	if err != nil {
+		return err
+	}
+	if *callback != "" {
+		cfg.Callback = *callback
+	}
+	if *secret != "" {
+		cfg.Secret = *secret
+	}
+	if cfg.Callback == "" || cfg.Secret == "" {
+		return errors.New("callback and secret are required")
+	}
+	c, err := cfg.client()
+	if err != nil {
+		return err
+	}
+	var res twitcheventsub.SubscriptionResponse
+	if len(conditions) == 0 {
+		res, err = c.SubscribeToEvent(twitcheventsub.EventType(positional[0]), *broadcaster, cfg.Token, cfg.ClientID,
+			twitcheventsub.WithVersion(*version))
+	} else {
+		var condition twitcheventsub.Condition
+		if condition, err = parseCondition(*broadcaster, conditions); err != nil {
+			return err
+		}
+		res, err = c.CreateSubscription(twitcheventsub.SubscriptionRequest{Type: positional[0], Version: *version,
+			Condition: condition}, cfg.Token, cfg.ClientID)
+	}
+	if err != nil {
+		return err
+	}
+	printTable(res.Data)
+	return nil
+}
+
+func remove(args []string) error {
+	fs := flag.NewFlagSet("delete", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: eventsub delete [flags] [subscription id...]")
+		fs.PrintDefaults()
+	}
+	path := configFlag(fs)
+	var status stringList
+	fs.Var(&status, "status", "delete every subscription with this status, repeatable")
+	subType := fs.String("type", "", "with --status, only delete subscriptions of this type")
+	dryRun := fs.Bool("dry-run", false, "print the subscriptions that would be deleted without deleting them")
+	ids, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if (len(ids) == 0) == (len(status) == 0) {
+		fs.Usage()
+		return errors.New("expected either subscription ids or --status")
+	}
+	cfg, err := loadConfig(*path)
+	if err != nil {
+		return err
+	}
+	c, err := cfg.client()
+	if err != nil {
+		return err
+	}
+	if len(status) > 0 {
+		subs, err := listAll(c, cfg, *subType, "", status)
+		if err != nil {
+			return err
+		}
+		ids = nil
+		for _, s := range subs {
+			ids = append(ids, s.Id)
+		}
+	}
+	failed := 0
+	for _, id := range ids {
+		if *dryRun {
+			fmt.Println("would delete " + id)
+			continue
+		}
+		if err := c.DeleteSubscription(id, cfg.Token, cfg.ClientID); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to delete %s: %s\n", id, err)
+			failed++
+			continue
+		}
+		fmt.Println("deleted " + id)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d deletions failed", failed, len(ids))
+	}
+	return nil
+}
+
+func verifyCallback(args []string) error {
+	fs := flag.NewFlagSet("verify-callback", flag.ContinueOnError)
+	path := configFlag(fs)
+	callback := fs.String("callback", "", "callback URL, defaults to the configured callback")
+	secret := fs.String("secret", "", "webhook secret, defaults to the configured secret")
+	subType := fs.String("type", "channel.follow", "subscription type sent in the challenge")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	cfg, err := loadConfig(*path)
+	if err != nil {
+		return err
+	}
+	if *callback != "" {
+		cfg.Callback = *callback
+	}
+	if *secret != "" {
+		cfg.Secret = *secret
+	}
+	if cfg.Callback == "" || cfg.Secret == "" {
+		return errors.New("callback and secret are required")
+	}
+	f, err := eventsubtest.LatestFixture(*subType)
+	if err != nil {
+		return err
+	}
+	sub := f.Subscription
+	sub.Status = twitcheventsub.StatusVerificationPending
+	sub.Transport.Callback = cfg.Callback
+	challenge := eventsubtest.NewMessageID()
+	body, err := eventsubtest.ChallengeBody(sub, challenge)
+	if err != nil {
+		return err
+	}
+	req, err := eventsubtest.NewRequest(cfg.Callback, cfg.Secret, eventsubtest.MessageTypeChallenge, sub, body)
+	if err != nil {
+		return err
+	}
+	res, err := (&http.Client{Timeout: 10 * time.Second}).Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer res.Body.Close()
+	answer, _ := io.ReadAll(io.LimitReader(res.Body, 1<<16))
+	fmt.Println("status: " + res.Status)
+	switch {
+	case res.StatusCode != http.StatusOK:
+		return errors.New("callback must answer the challenge with 200 OK")
+	case string(answer) != challenge:
+		return fmt.Errorf("callback answered %q instead of the challenge %q", answer, challenge)
+	}
+	if ct := res.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		fmt.Printf("warning: Content-Type is %q, Twitch expects text/plain\n", ct)
+	}
+	fmt.Println("callback verified")
+	return nil
+}
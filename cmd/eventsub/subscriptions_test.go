@@ -0,0 +1,146 @@
+package main
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+func TestConditionFields(t *testing.T) {
+	// every field of a condition can be printed and set
+	ct := reflect.TypeFor[twitcheventsub.Condition]()
+	var names []string
+	for i := range ct.NumField() {
+		name, _, _ := strings.Cut(ct.Field(i).Tag.Get("json"), ",")
+		names = append(names, name)
+	}
+	if !slices.Equal(names, conditionFields) {
+		t.Errorf("conditionFields = %v, want %v", conditionFields, names)
+	}
+}
+
+func TestConditionString(t *testing.T) {
+	tests := []struct {
+		c    twitcheventsub.Condition
+		want string
+	}{
+		{twitcheventsub.Condition{}, ""},
+		{twitcheventsub.Condition{BroadcasterUserId: "1"}, "broadcaster_user_id=1"},
+		{twitcheventsub.Condition{BroadcasterUserId: "1", ModeratorUserId: "2", ClientID: "abc"},
+			"broadcaster_user_id=1,moderator_user_id=2,client_id=abc"},
+		{twitcheventsub.Condition{ToBroadcasterUserId: "3"}, "to_broadcaster_user_id=3"},
+	}
+	for _, tt := range tests {
+		if got := conditionString(tt.c); got != tt.want {
+			t.Errorf("conditionString(%+v) = %q, want %q", tt.c, got, tt.want)
+		}
+	}
+}
+
+func TestParseCondition(t *testing.T) {
+	c, err := parseCondition("1", []string{"moderator_user_id=2", "user_id=a=b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (twitcheventsub.Condition{BroadcasterUserId: "1", ModeratorUserId: "2", UserID: "a=b"}); c != want {
+		t.Errorf("condition = %+v, want %+v", c, want)
+	}
+	// a condition overrides --broadcaster
+	if c, err := parseCondition("1", []string{"broadcaster_user_id=2"}); err != nil || c.BroadcasterUserId != "2" {
+		t.Errorf("broadcaster_user_id = %q, %v, want 2", c.BroadcasterUserId, err)
+	}
+	for _, bad := range []string{"moderator_user_id", "moderator_id=2", "Broadcaster_User_Id=2"} {
+		if _, err := parseCondition("", []string{bad}); err == nil {
+			t.Errorf("condition %q accepted", bad)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	s := twitcheventsub.Subscription{Type: "channel.raid", Status: twitcheventsub.StatusEnabled,
+		Condition: twitcheventsub.Condition{FromBroadcasterUserId: "1", ToBroadcasterUserId: "2"}}
+	tests := []struct {
+		subType, userId string
+		status          []string
+		want            bool
+	}{
+		{"", "", nil, true},
+		{"channel.raid", "", nil, true},
+		{"channel.follow", "", nil, false},
+		{"", "1", nil, true},
+		{"", "2", nil, true},
+		{"", "3", nil, false},
+		{"", "", []string{twitcheventsub.StatusEnabled}, true},
+		{"", "", []string{twitcheventsub.StatusVerificationPending, twitcheventsub.StatusEnabled}, true},
+		{"", "", []string{twitcheventsub.StatusVerificationPending}, false},
+		{"channel.raid", "2", []string{twitcheventsub.StatusEnabled}, true},
+		{"channel.raid", "3", []string{twitcheventsub.StatusEnabled}, false},
+	}
+	for _, tt := range tests {
+		if got := matches(s, tt.subType, tt.userId, tt.status); got != tt.want {
+			t.Errorf("matches(%q, %q, %v) = %t, want %t", tt.subType, tt.userId, tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestListAll(t *testing.T) {
+	helix := eventsubtest.NewServer()
+	defer helix.Close()
+	helix.PageSize = 2
+	cfg := config{ClientID: "test-client-id", Token: "test-token", Secret: "test-secret-value",
+		Callback: "http://127.0.0.1:1/eventsub", BaseURL: helix.URL()}
+	c, err := cfg.client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []twitcheventsub.SubscriptionRequest{
+		{Type: "channel.follow", Version: "2", Condition: twitcheventsub.Condition{BroadcasterUserId: "1", ModeratorUserId: "1"}},
+		{Type: "channel.follow", Version: "2", Condition: twitcheventsub.Condition{BroadcasterUserId: "2", ModeratorUserId: "2"}},
+		{Type: "channel.cheer", Version: "1", Condition: twitcheventsub.Condition{BroadcasterUserId: "1"}},
+		{Type: "channel.raid", Version: "1", Condition: twitcheventsub.Condition{ToBroadcasterUserId: "1"}},
+		{Type: "channel.raid", Version: "1", Condition: twitcheventsub.Condition{FromBroadcasterUserId: "2"}},
+	} {
+		res, err := c.CreateSubscription(r, cfg.Token, cfg.ClientID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		helix.Wait()
+		if r.Type != "channel.cheer" {
+			if err := helix.SetStatus(res.Data[0].Id, twitcheventsub.StatusEnabled); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	tests := []struct {
+		subType, userId string
+		status          []string
+		want            string
+	}{
+		{"", "", nil, "channel.follow/1,channel.follow/2,channel.cheer/1,channel.raid/,channel.raid/"},
+		{"channel.raid", "", nil, "channel.raid/,channel.raid/"},
+		{"", "1", nil, "channel.follow/1,channel.cheer/1,channel.raid/"},
+		// Helix only takes one filter, the others are applied to every page
+		{"channel.follow", "1", nil, "channel.follow/1"},
+		{"", "1", []string{twitcheventsub.StatusEnabled}, "channel.follow/1,channel.raid/"},
+		{"", "", []string{twitcheventsub.StatusEnabled, twitcheventsub.StatusVerificationFailed},
+			"channel.follow/1,channel.follow/2,channel.cheer/1,channel.raid/,channel.raid/"},
+		{"", "", []string{twitcheventsub.StatusVerificationFailed}, "channel.cheer/1"},
+	}
+	for _, tt := range tests {
+		subs, err := listAll(c, cfg, tt.subType, tt.userId, tt.status)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, s := range subs {
+			got = append(got, s.Type+"/"+s.Condition.BroadcasterUserId)
+		}
+		if strings.Join(got, ",") != tt.want {
+			t.Errorf("listAll(%q, %q, %q) = %s, want %s", tt.subType, tt.userId, tt.status, strings.Join(got, ","), tt.want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+func trigger(args []string) error {
+	fs := flag.NewFlagSet("trigger", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: eventsub trigger [flags] <subscription type>")
+		fs.PrintDefaults()
+	}
+	path := configFlag(fs)
+	forward := fs.String("forward-address", "http://localhost:8080/eventsub", "callback URL the notifications are sent to")
+	secret := fs.String("secret", "", "webhook secret used to sign the notifications, defaults to the configured secret")
+	count := fs.Int("count", 1, "number of notifications to send")
+	version := fs.String("version", "", "subscription type version, defaults to the newest fixture")
+	broadcaster := fs.String("broadcaster", "", "broadcaster id set in the condition and the event")
+	var fields stringList
+	fs.Var(&fields, "field", "event field override as name=value, converted to the type of the fixture field, "+
+		"or as name:=json to set raw JSON, repeatable")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		fs.Usage()
+		return errors.New("expected a subscription type")
+	}
+	cfg, err := loadConfig(*path)
+	if err != nil {
+		return err
+	}
+	if *secret != "" {
+		cfg.Secret = *secret
+	}
+	if cfg.Secret == "" {
+		return errors.New("secret is required")
+	}
+	subType := positional[0]
+	var f eventsubtest.Fixture
+	if *version == "" {
+		f, err = eventsubtest.LatestFixture(subType)
+	} else {
+		f, err = eventsubtest.LoadFixture(subType, *version)
+	}
+	if err != nil {
+		return err
+	}
+	if *broadcaster != "" {
+		if f, err = f.WithBroadcaster(*broadcaster); err != nil {
+			return err
+		}
+	}
+	for _, field := range fields {
+		name, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return fmt.Errorf("invalid field %q, expected name=value or name:=json", field)
+		}
+		var v any
+		if raw, isJSON := strings.CutSuffix(name, ":"); isJSON {
+			if !json.Valid([]byte(value)) {
+				return fmt.Errorf("invalid field %q, the value is not valid JSON", field)
+			}
+			name, v = raw, json.RawMessage(value)
+		} else if v, err = fieldValue(f, name, value); err != nil {
+			return err
+		}
+		if f, err = f.WithField(name, v); err != nil {
+			return fmt.Errorf("unable to set %s: %w", name, err)
+		}
+	}
+	body, err := f.Body()
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	for i := 1; i <= *count; i++ {
+		req, err := eventsubtest.NewRequest(*forward, cfg.Secret, eventsubtest.MessageTypeNotification, f.Subscription, body)
+		if err != nil {
+			return err
+		}
+		res, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("error sending request: %w", err)
+		}
+		io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+		fmt.Printf("%s v%s [%d/%d] %s: %s\n", subType, f.Subscription.Version, i, *count,
+			req.Header.Get(eventsubtest.HeaderId), res.Status)
+	}
+	return nil
+}
+
+// fieldValue converts a --field value to the type the field has in the fixture, so ids stay strings and counts
+// numbers. Fields missing from the fixture or null in it are set as strings.
+func fieldValue(f eventsubtest.Fixture, name, value string) (any, error) {
+	payload := f.Payload()
+	if len(f.Events) > 0 {
+		var events []json.RawMessage
+		if err := json.Unmarshal(payload, &events); err != nil || len(events) == 0 {
+			return value, nil
+		}
+		payload = events[0]
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &m); err != nil {
+		return value, nil
+	}
+	current := strings.TrimSpace(string(m[name]))
+	switch {
+	case current == "" || current == "null" || current[0] == '"':
+		return value, nil
+	case !json.Valid([]byte(value)):
+		return nil, fmt.Errorf("invalid value %q for %s, the fixture field is %s", value, name, current)
+	}
+	return json.RawMessage(value), nil
+}
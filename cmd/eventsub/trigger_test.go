@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+func TestFieldValue(t *testing.T) {
+	f := eventsubtest.Fixture{Event: json.RawMessage(`{"bits": 100, "user_id": "1", "anonymous": false, "message": null, "tags": ["a"]}`)}
+	tests := []struct {
+		name, value string
+		want        any
+	}{
+		{"bits", "250", json.RawMessage("250")},
+		{"user_id", "123", "123"},
+		{"anonymous", "true", json.RawMessage("true")},
+		{"message", "42", "42"},
+		{"missing", "7", "7"},
+		{"tags", `["b","c"]`, json.RawMessage(`["b","c"]`)},
+	}
+	for _, tt := range tests {
+		got, err := fieldValue(f, tt.name, tt.value)
+		if err != nil {
+			t.Errorf("%s=%s: %v", tt.name, tt.value, err)
+			continue
+		}
+		if g, w := jsonString(t, got), jsonString(t, tt.want); g != w {
+			t.Errorf("%s=%s set as %s, want %s", tt.name, tt.value, g, w)
+		}
+	}
+	if _, err := fieldValue(f, "bits", "many"); err == nil {
+		t.Error("a string accepted for a number field")
+	}
+
+	// batched types take the field types of their first entry
+	batched := eventsubtest.Fixture{Events: json.RawMessage(`[{"id": "1", "data": {"benefit_id": "b"}, "count": 1}]`)}
+	if got, err := fieldValue(batched, "count", "5"); err != nil || jsonString(t, got) != "5" {
+		t.Errorf("batched count = %v, %v, want 5", got, err)
+	}
+	if got, err := fieldValue(batched, "id", "5"); err != nil || jsonString(t, got) != `"5"` {
+		t.Errorf("batched id = %v, %v, want \"5\"", got, err)
+	}
+}
+
+func jsonString(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestTriggerConfig(t *testing.T) {
+	const secret = "config-secret-value"
+	t.Setenv("EVENTSUB_SECRET", "")
+	c := twitcheventsub.NewClient(secret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	var bits []int
+	c.OnChannelCheer(func(event twitcheventsub.ChannelCheerEvent) {
+		bits = append(bits, event.Bits)
+	})
+	var statuses []int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		rec := httptest.NewRecorder()
+		c.HandleEvent(rec, req)
+		statuses = append(statuses, rec.Code)
+		w.WriteHeader(rec.Code)
+	}))
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"secret": "`+secret+`"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := trigger([]string{"channel.cheer", "--config", path, "--forward-address", srv.URL, "--count", "2",
+		"--field", "bits=250"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(bits) != 2 || bits[0] != 250 {
+		t.Errorf("handled cheers of %v bits, want two of 250", bits)
+	}
+	// --secret wins over the config file
+	if err := trigger([]string{"channel.cheer", "--config", path, "--forward-address", srv.URL, "--secret",
+		"other-secret-value"}); err != nil {
+		t.Fatal(err)
+	}
+	if statuses[len(statuses)-1] != http.StatusForbidden {
+		t.Errorf("status = %d with another secret, want %d", statuses[len(statuses)-1], http.StatusForbidden)
+	}
+	if err := trigger([]string{"channel.cheer", "--config", filepath.Join(t.TempDir(), "missing.json"),
+		"--forward-address", srv.URL}); err == nil {
+		t.Error("trigger without a secret succeeded")
+	}
+}
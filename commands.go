@@ -0,0 +1,278 @@
+package twitcheventsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+const defaultCommandPrefix = "!"
+
+// Permission is the level a chatter needs to run a command, each level includes the ones below it.
+type Permission int
+
+const (
+	PermissionEveryone Permission = iota
+	PermissionSubscriber
+	PermissionVIP
+	PermissionModerator
+	PermissionBroadcaster
+)
+
+var (
+	ErrCommandPermission = errors.New("chatter is not allowed to run the command")
+	ErrCommandCooldown   = errors.New("command is on cooldown")
+)
+
+// Command is a chat command handled by a CommandRouter, names and aliases are matched case-insensitively.
+type Command struct {
+	Name       string
+	Aliases    []string
+	Permission Permission
+	// Cooldown is the time between two runs of the command in a channel, whoever runs it.
+	Cooldown time.Duration
+	// UserCooldown is the time between two runs of the command by the same chatter in a channel.
+	UserCooldown time.Duration
+	Handler      func(ctx context.Context, cmd CommandContext) error
+}
+
+// CommandContext is a parsed command message.
+type CommandContext struct {
+	Event ChannelChatMessageEvent
+	// Command is the name of the command, Alias the name or alias the chatter typed.
+	Command string
+	Alias   string
+	Args    []string
+	// Permission is the level of the chatter, SubscriberMonths the months shown on their subscriber badge.
+	Permission       Permission
+	SubscriberMonths int
+}
+
+// Thread returns the reply thread the message was sent in, nil when it is not a reply.
+func (cmd CommandContext) Thread() *Reply {
+	return cmd.Event.Reply
+}
+
+// ReplyParentID returns the message to reply to so the answer lands in the thread of the command, the root
+// of the thread when the command was itself a reply.
+func (cmd CommandContext) ReplyParentID() string {
+	if cmd.Event.Reply != nil && cmd.Event.Reply.ThreadMessageID != "" {
+		return cmd.Event.Reply.ThreadMessageID
+	}
+	return cmd.Event.MessageID
+}
+
+// CommandRouter runs the commands found in channel.chat.message notifications. Cooldowns are tracked per
+// channel, so one router can serve many broadcasters.
+type CommandRouter struct {
+	mu        sync.Mutex
+	prefix    string
+	commands  map[string]*Command
+	cooldowns map[string]time.Time
+
+	onDenied func(cmd CommandContext, err error)
+}
+
+// NewCommandRouter registers the router on c for channel.chat.message, replacing the handler registered with
+// Handle for that type with ErrHandlerReplaced reported, the one registered with OnChannelChatMessage still runs.
+// The prefix is ! by default.
+func NewCommandRouter(c *Client) *CommandRouter {
+	r := &CommandRouter{prefix: defaultCommandPrefix, commands: map[string]*Command{},
+		cooldowns: map[string]time.Time{}, onDenied: func(cmd CommandContext, err error) {}}
+	Handle(c, ChannelChatMessage, r.Handle)
+	return r
+}
+
+// SetPrefix changes the text commands start with.
+func (r *CommandRouter) SetPrefix(prefix string) {
+	r.mu.Lock()
+	r.prefix = prefix
+	r.mu.Unlock()
+}
+
+// Add registers cmd under its name and aliases, it fails when one of them is already taken.
+func (r *CommandRouter) Add(cmd Command) error {
+	if cmd.Name == "" || cmd.Handler == nil {
+		return errors.New("command needs a name and a handler")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := append([]string{cmd.Name}, cmd.Aliases...)
+	for _, name := range names {
+		if _, ok := r.commands[strings.ToLower(name)]; ok {
+			return fmt.Errorf("command %s is already registered", name)
+		}
+	}
+	for _, name := range names {
+		r.commands[strings.ToLower(name)] = &cmd
+	}
+	return nil
+}
+
+// Remove unregisters the command with the given name and its aliases.
+func (r *CommandRouter) Remove(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cmd, ok := r.commands[strings.ToLower(name)]
+	if !ok {
+		return
+	}
+	for key, c := range r.commands {
+		if c == cmd {
+			delete(r.commands, key)
+		}
+	}
+}
+
+// OnDenied is called when a command is not run, with ErrCommandPermission or ErrCommandCooldown.
+func (r *CommandRouter) OnDenied(f func(cmd CommandContext, err error)) {
+	r.mu.Lock()
+	r.onDenied = f
+	r.mu.Unlock()
+}
+
+// Handle runs the command in e, if any. NewCommandRouter registers it on the client, it is exported for
+// messages received some other way.
+func (r *CommandRouter) Handle(ctx context.Context, e ChannelChatMessageEvent) error {
+	text := strings.TrimSpace(e.Message.Text)
+	// Twitch prepends the mention of the parent author to replies
+	if e.Reply != nil && strings.HasPrefix(text, "@") {
+		mention, rest, _ := strings.Cut(text, " ")
+		if strings.EqualFold(mention[1:], e.Reply.ParentUserLogin) {
+			text = strings.TrimSpace(rest)
+		}
+	}
+	r.mu.Lock()
+	prefix, onDenied := r.prefix, r.onDenied
+	r.mu.Unlock()
+	if !strings.HasPrefix(text, prefix) {
+		return nil
+	}
+	rest := text[len(prefix):]
+	if rest == "" || unicode.IsSpace(rune(rest[0])) {
+		return nil
+	}
+	args := SplitArgs(rest)
+	if len(args) == 0 {
+		return nil
+	}
+	r.mu.Lock()
+	command, ok := r.commands[strings.ToLower(args[0])]
+	r.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	permission, months := ChatterPermission(e)
+	cmd := CommandContext{Event: e, Command: command.Name, Alias: args[0], Args: args[1:], Permission: permission,
+		SubscriberMonths: months}
+	if permission < command.Permission {
+		onDenied(cmd, ErrCommandPermission)
+		return nil
+	}
+	if !r.takeCooldown(command, e) {
+		onDenied(cmd, ErrCommandCooldown)
+		return nil
+	}
+	if err := command.Handler(ctx, cmd); err != nil {
+		return fmt.Errorf("command %s failed: %w", command.Name, err)
+	}
+	return nil
+}
+
+// takeCooldown reports whether the command can run now and starts its cooldowns if so.
+func (r *CommandRouter) takeCooldown(cmd *Command, e ChannelChatMessageEvent) bool {
+	now := time.Now()
+	channel := e.BroadcasterUserID + "/" + strings.ToLower(cmd.Name)
+	user := channel + "/" + e.ChatterUserID
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now.Before(r.cooldowns[channel]) || now.Before(r.cooldowns[user]) {
+		return false
+	}
+	if len(r.cooldowns) > 1024 {
+		for key, until := range r.cooldowns {
+			if !now.Before(until) {
+				delete(r.cooldowns, key)
+			}
+		}
+	}
+	if cmd.Cooldown > 0 {
+		r.cooldowns[channel] = now.Add(cmd.Cooldown)
+	}
+	if cmd.UserCooldown > 0 {
+		r.cooldowns[user] = now.Add(cmd.UserCooldown)
+	}
+	return true
+}
+
+// ChatterPermission returns the permission level of the author of e from their badges, along with the months
+// of their subscriber or founder badge.
+func ChatterPermission(e ChannelChatMessageEvent) (Permission, int) {
+	permission, months := PermissionEveryone, 0
+	if e.ChatterUserID != "" && e.ChatterUserID == e.BroadcasterUserID {
+		permission = PermissionBroadcaster
+	}
+	for _, b := range e.Badges {
+		level := PermissionEveryone
+		switch b.SetID {
+		case "broadcaster":
+			level = PermissionBroadcaster
+		case "moderator", "lead_moderator":
+			level = PermissionModerator
+		case "vip":
+			level = PermissionVIP
+		case "subscriber", "founder":
+			level = PermissionSubscriber
+			if n, err := strconv.Atoi(b.Info); err == nil && n > months {
+				months = n
+			}
+		}
+		permission = max(permission, level)
+	}
+	return permission, months
+}
+
+// SplitArgs splits a command line on whitespace. An argument starting with a double or single quote runs to the
+// matching quote, whitespace included, and a backslash escapes the next character inside quotes. An unterminated
+// quote runs to the end of the line, apostrophes inside words are kept.
+func SplitArgs(s string) []string {
+	var args []string
+	var b strings.Builder
+	var quote rune
+	inArg, escaped := false, false
+	for _, r := range s {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case quote != 0:
+			if r == '\\' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			} else {
+				b.WriteRune(r)
+			}
+		case (r == '"' || r == '\'') && !inArg:
+			quote, inArg = r, true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, b.String())
+				b.Reset()
+				inArg = false
+			}
+		default:
+			b.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, b.String())
+	}
+	return args
+}
@@ -0,0 +1,160 @@
+package twitcheventsub_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"so  streamer", []string{"so", "streamer"}},
+		{`title "Just Chatting with friends"`, []string{"title", "Just Chatting with friends"}},
+		{`say 'single quoted' ok`, []string{"say", "single quoted", "ok"}},
+		{`quote "she said \"hi\""`, []string{"quote", `she said "hi"`}},
+		{`add "unterminated quote`, []string{"add", "unterminated quote"}},
+		{"don't split it's", []string{"don't", "split", "it's"}},
+		{`empty ""`, []string{"empty", ""}},
+		{"tabs\tand\nnewlines", []string{"tabs", "and", "newlines"}},
+	}
+	for _, tt := range tests {
+		if got := twitcheventsub.SplitArgs(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SplitArgs(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestChatterPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		chatter    string
+		badges     []twitcheventsub.Badges
+		permission twitcheventsub.Permission
+		months     int
+	}{
+		{"no badges", "2", nil, twitcheventsub.PermissionEveryone, 0},
+		{"broadcaster without badge", "1", nil, twitcheventsub.PermissionBroadcaster, 0},
+		{"subscriber", "2", []twitcheventsub.Badges{{SetID: "subscriber", Info: "14"}},
+			twitcheventsub.PermissionSubscriber, 14},
+		{"founder", "2", []twitcheventsub.Badges{{SetID: "founder", Info: "3"}}, twitcheventsub.PermissionSubscriber, 3},
+		{"vip subscriber", "2", []twitcheventsub.Badges{{SetID: "subscriber", Info: "6"}, {SetID: "vip"}},
+			twitcheventsub.PermissionVIP, 6},
+		{"lead moderator", "2", []twitcheventsub.Badges{{SetID: "lead_moderator"}}, twitcheventsub.PermissionModerator, 0},
+		{"moderator before vip", "2", []twitcheventsub.Badges{{SetID: "moderator"}, {SetID: "vip"}},
+			twitcheventsub.PermissionModerator, 0},
+		{"unknown badge", "2", []twitcheventsub.Badges{{SetID: "glhf-pledge"}}, twitcheventsub.PermissionEveryone, 0},
+	}
+	for _, tt := range tests {
+		e := twitcheventsub.ChannelChatMessageEvent{BroadcasterUserID: "1", ChatterUserID: tt.chatter, Badges: tt.badges}
+		permission, months := twitcheventsub.ChatterPermission(e)
+		if permission != tt.permission || months != tt.months {
+			t.Errorf("%s: ChatterPermission = %d, %d, want %d, %d", tt.name, permission, months, tt.permission, tt.months)
+		}
+	}
+}
+
+func chatMessage(broadcaster, chatter, text string) twitcheventsub.ChannelChatMessageEvent {
+	return twitcheventsub.ChannelChatMessageEvent{BroadcasterUserID: broadcaster, ChatterUserID: chatter,
+		Message: twitcheventsub.Message{Text: text}}
+}
+
+func TestCommandCooldowns(t *testing.T) {
+	r := twitcheventsub.NewCommandRouter(twitcheventsub.NewClient(testSecret, "http://localhost/eventsub"))
+	runs := map[string]int{}
+	var denied []error
+	r.OnDenied(func(cmd twitcheventsub.CommandContext, err error) {
+		denied = append(denied, err)
+	})
+	handler := func(ctx context.Context, cmd twitcheventsub.CommandContext) error {
+		runs[cmd.Command]++
+		return nil
+	}
+	if err := r.Add(twitcheventsub.Command{Name: "lurk", Cooldown: time.Hour, Handler: handler}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Add(twitcheventsub.Command{Name: "hug", UserCooldown: time.Hour, Handler: handler}); err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range []twitcheventsub.ChannelChatMessageEvent{
+		chatMessage("1", "10", "!lurk"),
+		chatMessage("1", "11", "!LURK"), // channel cooldown, whoever runs it
+		chatMessage("2", "10", "!lurk"), // other channel
+		chatMessage("1", "10", "!hug"),
+		chatMessage("1", "10", "!hug"), // user cooldown
+		chatMessage("1", "11", "!hug"),
+	} {
+		if err := r.Handle(context.Background(), e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if runs["lurk"] != 2 || runs["hug"] != 2 {
+		t.Errorf("runs = %v, want lurk and hug twice", runs)
+	}
+	if len(denied) != 2 || !errors.Is(denied[0], twitcheventsub.ErrCommandCooldown) ||
+		!errors.Is(denied[1], twitcheventsub.ErrCommandCooldown) {
+		t.Errorf("denied = %v, want two cooldowns", denied)
+	}
+}
+
+func TestCommandRouterOnClient(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	var reported error
+	c.OnError(func(err error) {
+		reported = err
+	})
+	twitcheventsub.Handle(c, twitcheventsub.ChannelChatMessage,
+		func(ctx context.Context, e twitcheventsub.ChannelChatMessageEvent) error {
+			return nil
+		})
+	r := twitcheventsub.NewCommandRouter(c)
+	if !errors.Is(reported, twitcheventsub.ErrHandlerReplaced) {
+		t.Errorf("reported %v, want ErrHandlerReplaced", reported)
+	}
+	var args []string
+	if err := r.Add(twitcheventsub.Command{Name: "so", Handler: func(ctx context.Context, cmd twitcheventsub.CommandContext) error {
+		args = cmd.Args
+		return nil
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	f := eventsubtest.NewChannelChatMessageEvent().WithMessage(twitcheventsub.Message{Text: "!so streamer"}).Fixture()
+	if status := process(t, c, f); status != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", status, http.StatusNoContent)
+	}
+	if !reflect.DeepEqual(args, []string{"streamer"}) {
+		t.Errorf("args = %q, want [streamer]", args)
+	}
+}
+
+func TestCommandRouterOnDeniedWhileHandling(t *testing.T) {
+	r := twitcheventsub.NewCommandRouter(twitcheventsub.NewClient(testSecret, "http://localhost/eventsub"))
+	if err := r.Add(twitcheventsub.Command{Name: "mod", Permission: twitcheventsub.PermissionModerator,
+		Handler: func(ctx context.Context, cmd twitcheventsub.CommandContext) error {
+			return nil
+		}}); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 100 {
+			r.Handle(context.Background(), chatMessage("1", "2", "!mod"))
+		}
+	}()
+	for range 100 {
+		r.OnDenied(func(cmd twitcheventsub.CommandContext, err error) {})
+	}
+	<-done
+}
@@ -0,0 +1,141 @@
+package twitcheventsub
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// EventSink records every verified notification before it is dispatched. When Record fails HandleEvent responds
+// with a 500 so Twitch redelivers the notification, which keeps the record complete. ctx carries the handler
+// deadline, shared with the publishers and the sync handlers.
+type EventSink interface {
+	Record(ctx context.Context, env Envelope) error
+}
+
+// SetEventSink makes the client record notifications into s.
+func (c *Client) SetEventSink(s EventSink) {
+	c.sink = s
+}
+
+// FileSink is an EventSink that appends every envelope as a JSON line to a file.
+type FileSink struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+// NewFileSink opens path for appending, creating it if needed.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{f: f}, nil
+}
+
+func (s *FileSink) Record(ctx context.Context, env Envelope) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.f.Write(append(b, '\n'))
+	return err
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
+
+// writeFileAtomic replaces path with data: the data is synced to a temporary file that is then renamed over path,
+// and the directory is synced so the rename itself survives a power loss.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// Replay reads envelopes written by a FileSink from r and dispatches them to the client listeners and handlers
+// in order, synchronously and without recording them again. Only envelopes accepted by filter are replayed, a nil
+// filter accepts all of them. With speed above zero the time between envelopes is reproduced, scaled by speed so 2
+// replays twice as fast, with zero they are replayed as fast as the handlers allow. Replay stops at the first
+// handler error.
+func (c *Client) Replay(ctx context.Context, r io.Reader, filter func(env Envelope) bool, speed float64) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	var last time.Time
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var env Envelope
+		if err := json.Unmarshal(scanner.Bytes(), &env); err != nil {
+			return fmt.Errorf("error decoding line %d: %w", line, err)
+		}
+		if filter != nil && !filter(env) {
+			continue
+		}
+		if speed > 0 && !last.IsZero() {
+			if wait := time.Duration(float64(env.ReceivedAt.Sub(last)) / speed); wait > 0 {
+				t := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					t.Stop()
+					return ctx.Err()
+				case <-t.C:
+				}
+			}
+		}
+		last = env.ReceivedAt
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := c.replay(ctx, env); err != nil {
+			return fmt.Errorf("replay of message %s: %w", env.MessageID, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// replay runs the listeners and handlers for a recorded envelope.
+func (c *Client) replay(ctx context.Context, env Envelope) error {
+	var data Response
+	if err := json.Unmarshal(env.Body, &data); err != nil {
+		return fmt.Errorf("error decoding body: %w", err)
+	}
+	return c.dispatch(ctx, env, data, newNotificationEvent(data))
+}
@@ -0,0 +1,179 @@
+package twitcheventsub_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+type countingPublisher struct {
+	calls int
+}
+
+func (p *countingPublisher) Publish(ctx context.Context, env twitcheventsub.Envelope, event any) error {
+	p.calls++
+	return nil
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink, err := twitcheventsub.NewFileSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetEventSink(sink)
+	fixtures := []eventsubtest.Fixture{
+		eventsubtest.NewChannelFollowEvent().WithUserID("1").Fixture(),
+		eventsubtest.NewChannelCheerEvent().WithBits(100).Fixture(),
+		eventsubtest.NewChannelFollowEvent().WithUserID("2").Fixture(),
+	}
+	for _, f := range fixtures {
+		if status := process(t, c, f); status != http.StatusNoContent {
+			t.Fatalf("status = %d, want %d", status, http.StatusNoContent)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var types []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var env twitcheventsub.Envelope
+		if err := json.Unmarshal(scanner.Bytes(), &env); err != nil {
+			t.Fatal(err)
+		}
+		if env.MessageID == "" || env.ReceivedAt.IsZero() || len(env.Payload()) == 0 {
+			t.Errorf("incomplete envelope %+v", env)
+		}
+		types = append(types, env.Subscription.Type)
+	}
+	if want := []string{"channel.follow", "channel.cheer", "channel.follow"}; !slices.Equal(types, want) {
+		t.Errorf("recorded %v, want %v", types, want)
+	}
+}
+
+type blockingSink struct{}
+
+func (blockingSink) Record(ctx context.Context, env twitcheventsub.Envelope) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestSinkHandlerDeadline(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetHandlerTimeout(50 * time.Millisecond)
+	c.SetEventSink(blockingSink{})
+	start := time.Now()
+	if status := process(t, c, eventsubtest.NewChannelFollowEvent().Fixture()); status != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d", status, http.StatusInternalServerError)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("answered after %s, want the 50ms handler timeout", d)
+	}
+}
+
+// eventLog writes one envelope per fixture, received interval apart.
+func eventLog(t *testing.T, interval time.Duration, fixtures ...eventsubtest.Fixture) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i, f := range fixtures {
+		body, err := f.Body()
+		if err != nil {
+			t.Fatal(err)
+		}
+		at := start.Add(time.Duration(i) * interval)
+		b, err := json.Marshal(twitcheventsub.Envelope{MessageID: eventsubtest.NewMessageID(), MessageTimestamp: at,
+			ReceivedAt: at, Subscription: f.Subscription, Body: body})
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf.Write(append(b, '\n'))
+	}
+	return &buf
+}
+
+func TestReplay(t *testing.T) {
+	log := eventLog(t, time.Minute,
+		eventsubtest.NewChannelFollowEvent().WithUserID("1").Fixture(),
+		eventsubtest.NewChannelCheerEvent().WithBits(1).Fixture(),
+		eventsubtest.NewChannelFollowEvent().WithUserID("2").Fixture(),
+		eventsubtest.NewChannelFollowEvent().WithUserID("3").Fixture(),
+	)
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	publisher := &countingPublisher{}
+	c.AddPublisher(publisher)
+	var listened int
+	c.AddListener(func(ctx context.Context, env twitcheventsub.Envelope) error {
+		listened++
+		return nil
+	})
+	var followers []string
+	c.OnChannelFollow(func(event twitcheventsub.ChannelFollowEvent) {
+		followers = append(followers, event.UserID)
+	})
+	cheered := false
+	c.OnChannelCheer(func(event twitcheventsub.ChannelCheerEvent) {
+		cheered = true
+	})
+	// follows received before the last minute
+	until := time.Date(2026, 1, 1, 12, 2, 30, 0, time.UTC)
+	filter := func(env twitcheventsub.Envelope) bool {
+		return env.Subscription.Type == "channel.follow" && env.ReceivedAt.Before(until)
+	}
+	if err := c.Replay(context.Background(), log, filter, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(followers, []string{"1", "2"}) || cheered {
+		t.Errorf("replayed follows %v and cheer %t, want [1 2] and no cheer", followers, cheered)
+	}
+	if listened != 2 {
+		t.Errorf("listeners called %d times, want 2", listened)
+	}
+	if publisher.calls != 0 {
+		t.Errorf("publishers called %d times during a replay", publisher.calls)
+	}
+}
+
+func TestReplaySpeed(t *testing.T) {
+	fixture := eventsubtest.NewChannelFollowEvent().Fixture()
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	for _, tt := range []struct {
+		speed    float64
+		min, max time.Duration
+	}{
+		{speed: 0, min: 0, max: 100 * time.Millisecond},
+		{speed: 2, min: 200 * time.Millisecond, max: 400 * time.Millisecond},
+	} {
+		// three envelopes 200ms apart
+		log := eventLog(t, 200*time.Millisecond, fixture, fixture, fixture)
+		start := time.Now()
+		if err := c.Replay(context.Background(), log, nil, tt.speed); err != nil {
+			t.Fatal(err)
+		}
+		if d := time.Since(start); d < tt.min || d > tt.max {
+			t.Errorf("speed %v: replay took %s, want between %s and %s", tt.speed, d, tt.min, tt.max)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := c.Replay(ctx, eventLog(t, time.Hour, fixture, fixture), nil, 1); err != context.DeadlineExceeded {
+		t.Errorf("Replay = %v, want the context error", err)
+	}
+}
@@ -0,0 +1,321 @@
+package twitcheventsub
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// eventType decodes the events of a subscription type and runs the On functions registered for it.
+type eventType struct {
+	decode func(raw json.RawMessage) (any, error)
+	// handle returns the On functions registered on c for the decoded event, nil when there are none.
+	handle func(c *Client) func(event any)
+}
+
+// eventTypes holds every subscription type with an On function, DecodeEvent and parseNotification use it.
+var eventTypes = map[string]eventType{
+	"automod.message.hold": on(func(c *Client) func(AutomodMessageHoldEvent) {
+		return c.onAutomodMessageHold
+	}),
+	"automod.message.update": on(func(c *Client) func(AutomodMessageUpdateEvent) {
+		return c.onAutomodMessageUpdate
+	}),
+	"automod.settings.update": on(func(c *Client) func(AutomodSettingsUpdateEvent) {
+		return c.onAutomodSettingsUpdate
+	}),
+	"automod.terms.update": on(func(c *Client) func(AutomodTermsUpdateEvent) {
+		return c.onAutomodTermsUpdate
+	}),
+	"channel.update": on(func(c *Client) func(ChannelUpdateEvent) {
+		return c.onChannelUpdate
+	}),
+	"channel.follow": on(func(c *Client) func(ChannelFollowEvent) {
+		return c.onChannelFollow
+	}),
+	"channel.ad_break.begin": on(func(c *Client) func(ChannelAdBreakBeginEvent) {
+		return c.onChannelAdBreakBegin
+	}),
+	"channel.chat.clear": on(func(c *Client) func(ChannelChatClearEvent) {
+		return c.onChannelChatClear
+	}),
+	"channel.chat.clear_user_messages": on(func(c *Client) func(ChannelChatClearUserMessagesEvent) {
+		return c.onChannelChatClearUserMessages
+	}),
+	"channel.chat.message": on(func(c *Client) func(ChannelChatMessageEvent) {
+		return c.onChannelChatMessage
+	}),
+	"channel.chat.message_delete": on(func(c *Client) func(ChannelChatMessageDeleteEvent) {
+		return c.onChannelChatMessageDelete
+	}),
+	"channel.chat.notification": on(func(c *Client) func(ChannelChatNotificationEvent) {
+		return c.onChannelChatNotification
+	}),
+	"channel.chat_settings.update": on(func(c *Client) func(ChannelChatSettingsUpdateEvent) {
+		return c.onChannelChatSettingsUpdate
+	}),
+	"channel.chat.user_message_hold": on(func(c *Client) func(ChannelChatUserMessageHoldEvent) {
+		return c.onChannelChatUserMessageHold
+	}),
+	"channel.chat.user_message_update": on(func(c *Client) func(ChannelChatUserMessageUpdateEvent) {
+		return c.onChannelChatUserMessageUpdate
+	}),
+	"channel.subscribe": on(func(c *Client) func(ChannelSubscribeEvent) {
+		return c.onChannelSubscribe
+	}),
+	"channel.subscription.end": on(func(c *Client) func(ChannelSubscriptionEndEvent) {
+		return c.onChannelSubscriptionEnd
+	}),
+	"channel.subscription.gift": on(func(c *Client) func(ChannelSubscriptionGiftEvent) {
+		return c.onChannelSubscriptionGift
+	}),
+	"channel.subscription.message": on(func(c *Client) func(ChannelSubscriptionMessageEvent) {
+		return c.onChannelSubscriptionMessage
+	}),
+	"channel.cheer": on(func(c *Client) func(ChannelCheerEvent) {
+		return c.onChannelCheer
+	}),
+	"channel.raid": on(func(c *Client) func(ChannelRaidEvent) {
+		return c.onChannelRaid
+	}),
+	"channel.ban": on(func(c *Client) func(ChannelBanEvent) {
+		return c.onChannelBan
+	}),
+	"channel.unban": on(func(c *Client) func(ChannelUnbanEvent) {
+		return c.onChannelUnban
+	}),
+	"channel.unban_request.create": on(func(c *Client) func(ChannelUnbanRequestCreateEvent) {
+		return c.onChannelUnbanRequestCreate
+	}),
+	"channel.unban_request.resolve": on(func(c *Client) func(ChannelUnbanRequestResolveEvent) {
+		return c.onChannelUnbanRequestResolve
+	}),
+	"channel.moderate": on(func(c *Client) func(ChannelModerateEventV2) {
+		return c.onChannelModerate
+	}),
+	"channel.moderator.add": on(func(c *Client) func(ChannelModeratorAddEvent) {
+		return c.onChannelModeratorAdd
+	}),
+	"channel.moderator.remove": on(func(c *Client) func(ChannelModeratorRemoveEvent) {
+		return c.onChannelModeratorRemove
+	}),
+	"channel.guest_star_session.begin": on(func(c *Client) func(ChannelGuestStarSessionBeginEvent) {
+		return c.onChannelGuestStarSessionBegin
+	}),
+	"channel.guest_star_session.end": on(func(c *Client) func(ChannelGuestStarSessionEndEvent) {
+		return c.onChannelGuestStarSessionEnd
+	}),
+	"channel.guest_star_guest.update": on(func(c *Client) func(ChannelGuestStarGuestUpdateEvent) {
+		return c.onChannelGuestStarGuestUpdate
+	}),
+	"channel.guest_star_settings.update": on(func(c *Client) func(ChannelGuestStarSettingsUpdateEvent) {
+		return c.onChannelGuestStarSettingsUpdate
+	}),
+	"channel.channel_points_automatic_reward_redemption.add": on(func(c *Client) func(ChannelPointsAutomaticRewardRedemptionAddEvent) {
+		return c.onChannelPointsAutomaticRewardRedemptionAddV2
+	}),
+	"channel.channel_points_custom_reward.add": on(func(c *Client) func(ChannelPointsCustomRewardAddEvent) {
+		return c.onChannelPointsCustomRewardAdd
+	}),
+	"channel.channel_points_custom_reward.update": on(func(c *Client) func(ChannelPointsCustomRewardUpdateEvent) {
+		return c.onChannelPointsCustomRewardUpdate
+	}),
+	"channel.channel_points_custom_reward.remove": on(func(c *Client) func(ChannelPointsCustomRewardRemoveEvent) {
+		return c.onChannelPointsCustomRewardRemove
+	}),
+	"channel.channel_points_custom_reward_redemption.add": on(func(c *Client) func(ChannelPointsCustomRewardRedemptionAddEvent) {
+		return c.onChannelPointsCustomRewardRedemptionAdd
+	}),
+	"channel.channel_points_custom_reward_redemption.update": on(func(c *Client) func(ChannelPointsCustomRewardRedemptionUpdateEvent) {
+		return c.onChannelPointsCustomRewardRedemptionUpdate
+	}),
+	"channel.poll.begin": on(func(c *Client) func(ChannelPollBeginEvent) {
+		return c.onChannelPollBegin
+	}),
+	"channel.poll.progress": on(func(c *Client) func(ChannelPollProgressEvent) {
+		return c.onChannelPollProgress
+	}),
+	"channel.poll.end": on(func(c *Client) func(ChannelPollEndEvent) {
+		return c.onChannelPollEnd
+	}),
+	"channel.prediction.begin": on(func(c *Client) func(ChannelPredictionBeginEvent) {
+		return c.onChannelPredictionBegin
+	}),
+	"channel.prediction.progress": on(func(c *Client) func(ChannelPredictionProgressEvent) {
+		return c.onChannelPredictionProgress
+	}),
+	"channel.prediction.lock": on(func(c *Client) func(ChannelPredictionLockEvent) {
+		return c.onChannelPredictionLock
+	}),
+	"channel.prediction.end": on(func(c *Client) func(ChannelPredictionEndEvent) {
+		return c.onChannelPredictionEnd
+	}),
+	"channel.vip.add": on(func(c *Client) func(ChannelVIPAddEvent) {
+		return c.onChannelVIPAdd
+	}),
+	"channel.vip.remove": on(func(c *Client) func(ChannelVIPRemoveEvent) {
+		return c.onChannelVIPRemove
+	}),
+	"channel.charity_campaign.donate": on(func(c *Client) func(CharityCampaignDonateEvent) {
+		return c.onCharityCampaignDonate
+	}),
+	"channel.charity_campaign.start": on(func(c *Client) func(CharityCampaignStartEvent) {
+		return c.onCharityCampaignStart
+	}),
+	"channel.charity_campaign.progress": on(func(c *Client) func(CharityCampaignProgressEvent) {
+		return c.onCharityCampaignProgress
+	}),
+	"channel.charity_campaign.stop": on(func(c *Client) func(CharityCampaignStopEvent) {
+		return c.onCharityCampaignStop
+	}),
+	"conduit.shard.disabled": on(func(c *Client) func(ConduitShardDisabledEvent) {
+		return c.onConduitShardDisabled
+	}),
+	"drop.entitlement.grant": {decode: decode[[]DropEntitlementGrantEvent], handle: handleDropEntitlementGrant},
+	"extension.bits_transaction.create": on(func(c *Client) func(ExtensionBitsTransactionCreateEvent) {
+		return c.onExtensionBitsTransactionCreate
+	}),
+	"channel.goal.begin": on(func(c *Client) func(ChannelGoalBeginEvent) {
+		return c.onChannelGoalBegin
+	}),
+	"channel.goal.progress": on(func(c *Client) func(ChannelGoalProgressEvent) {
+		return c.onChannelGoalProgress
+	}),
+	"channel.goal.end": on(func(c *Client) func(ChannelGoalEndEvent) {
+		return c.onChannelGoalEnd
+	}),
+	"channel.hype_train.begin": on(func(c *Client) func(ChannelHypeTrainBeginEventV2) {
+		return c.onChannelHypeTrainBegin
+	}),
+	"channel.hype_train.progress": on(func(c *Client) func(ChannelHypeTrainProgressEventV2) {
+		return c.onChannelHypeTrainProgress
+	}),
+	"channel.hype_train.end": on(func(c *Client) func(ChannelHypeTrainEndEventV2) {
+		return c.onChannelHypeTrainEnd
+	}),
+	"channel.shield_mode.begin": on(func(c *Client) func(ChannelShieldModeBeginEvent) {
+		return c.onChannelShieldModeBegin
+	}),
+	"channel.shield_mode.end": on(func(c *Client) func(ChannelShieldModeEndEvent) {
+		return c.onChannelShieldModeEnd
+	}),
+	"channel.shoutout.create": on(func(c *Client) func(ChannelShoutOutCreateEvent) {
+		return c.onChannelShoutOutCreate
+	}),
+	"channel.shoutout.receive": on(func(c *Client) func(ChannelShoutOutReceivedEvent) {
+		return c.onChannelShoutOutReceived
+	}),
+	"stream.online": on(func(c *Client) func(StreamOnlineEvent) {
+		return c.onStreamOnline
+	}),
+	"stream.offline": on(func(c *Client) func(StreamOfflineEvent) {
+		return c.onStreamOffline
+	}),
+	"user.authorization.grant": on(func(c *Client) func(UserAuthorizationGrantEvent) {
+		return c.onUserAuthorizationGrant
+	}),
+	"user.authorization.revoke": on(func(c *Client) func(UserAuthorizationRevokeEvent) {
+		return c.onUserAuthorizationRevoke
+	}),
+	"user.update": on(func(c *Client) func(UserUpdateEvent) {
+		return c.onUserUpdate
+	}),
+	"user.whisper.message": on(func(c *Client) func(UserWhisperMessageEvent) {
+		return c.onUserWhisperMessage
+	}),
+	"channel.suspicious_user.update": on(func(c *Client) func(ChannelSuspiciousUserUpdateEvent) {
+		return c.onChannelSuspiciousUserUpdate
+	}),
+	"channel.bits.use": on(func(c *Client) func(ChannelBitsUseEvent) {
+		return c.onChannelBitsUse
+	}),
+	"channel.suspicious_user.message": on(func(c *Client) func(ChannelSuspiciousUserMessageEvent) {
+		return c.onChannelSuspiciousUserMessage
+	}),
+	"channel.warning.acknowledge": on(func(c *Client) func(ChannelWarningAcknowledgeEvent) {
+		return c.onChannelWarningAcknowledge
+	}),
+	"channel.warning.send": on(func(c *Client) func(ChannelWarningSendEvent) {
+		return c.onChannelWarningSend
+	}),
+}
+
+// on builds the eventType of an event struct from the getter of its On function.
+func on[T any](handler func(c *Client) func(T)) eventType {
+	return eventType{decode: decode[T], handle: func(c *Client) func(event any) {
+		h := handler(c)
+		if h == nil {
+			return nil
+		}
+		return func(event any) {
+			h(event.(T))
+		}
+	}}
+}
+
+// handleDropEntitlementGrant runs the batch handler and then the per grant one.
+func handleDropEntitlementGrant(c *Client) func(event any) {
+	if c.onDropEntitlementGrant == nil && c.onDropEntitlementGrantBatch == nil {
+		return nil
+	}
+	return func(event any) {
+		e := event.([]DropEntitlementGrantEvent)
+		if c.onDropEntitlementGrantBatch != nil {
+			c.onDropEntitlementGrantBatch(e)
+		}
+		if c.onDropEntitlementGrant != nil {
+			for _, grant := range e {
+				c.onDropEntitlementGrant(grant)
+			}
+		}
+	}
+}
+
+func decode[T any](raw json.RawMessage) (any, error) {
+	var e T
+	err := json.Unmarshal(raw, &e)
+	return e, err
+}
+
+// DecodeEvent decodes the event of a notification into the struct the matching On function receives, like
+// ChannelCheerEvent for channel.cheer, or []DropEntitlementGrantEvent for batched types. Unknown types are
+// returned as json.RawMessage.
+func DecodeEvent(sub Subscription, raw json.RawMessage) (any, error) {
+	t, ok := eventTypes[sub.Type]
+	if !ok {
+		return raw, nil
+	}
+	e, err := t.decode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s event: %w", sub.Type, err)
+	}
+	return e, nil
+}
+
+// notificationEvent decodes the event of a notification once, for the publishers and the On functions.
+type notificationEvent struct {
+	sub     Subscription
+	raw     json.RawMessage
+	decoded bool
+	event   any
+	err     error
+}
+
+func newNotificationEvent(data Response) *notificationEvent {
+	raw := data.Event
+	if len(raw) == 0 {
+		raw = data.Events
+	}
+	return &notificationEvent{sub: data.Subscription, raw: raw}
+}
+
+// decode returns the event decoded like DecodeEvent does, without the error wrapping.
+func (n *notificationEvent) decode() (any, error) {
+	if !n.decoded {
+		n.decoded = true
+		n.event = json.RawMessage(n.raw)
+		if t, ok := eventTypes[n.sub.Type]; ok {
+			n.event, n.err = t.decode(n.raw)
+		}
+	}
+	return n.event, n.err
+}
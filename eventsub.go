@@ -1,6 +1,7 @@
 package twitcheventsub
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
@@ -8,7 +9,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"sync"
+	"time"
 )
 
 const (
@@ -16,23 +20,49 @@ const (
 	headerTimestamp = "Twitch-Eventsub-Message-Timestamp"
 	headerSignature = "Twitch-Eventsub-Message-Signature"
 	headerType      = "Twitch-Eventsub-Message-Type"
+	headerRetry     = "Twitch-Eventsub-Message-Retry"
+	headerSubType   = "Twitch-Eventsub-Subscription-Type"
+	headerSubVer    = "Twitch-Eventsub-Subscription-Version"
 	headerChallenge = "webhook_callback_verification"
+	contentType     = "Content-Type"
+	textPlain       = "text/plain"
 	notification    = "notification"
 	revocation      = "revocation"
 	hmacPrefix      = "sha256="
 	parseError      = "parseNotificationError"
+
+	defaultHandlerTimeout = 2 * time.Second
+	maxHandlerTimeout     = 5 * time.Second
 )
 
 type Client struct {
-	secret      string
-	callback    string
-	secretBytes []byte
-	debug       bool
+	secret   string
+	callback string
+	baseUrl  string
+	debug    bool
+	sync     bool
+	timeout  time.Duration
+
+	//Verification tracking
+	inflight      sync.WaitGroup
+	mu            sync.Mutex
+	verifications map[string]*verification
 
 	//Notification handling
-	onError   func(err error)
-	onRevoked func(sub Subscription)
-	onDebug   func(msg string)
+	router      *Router
+	sink        EventSink
+	listeners   []func(ctx context.Context, env Envelope) error
+	resolver    func(sub Subscription) (string, error)
+	handlers    map[string]func(ctx context.Context, sub Subscription, event json.RawMessage) error
+	metrics     Metrics
+	tracer      Tracer
+	logger      *slog.Logger
+	lag         *lagTracker
+	publishers  []Publisher
+	onError     func(err error)
+	onRevoked   func(sub Subscription)
+	onDebug     func(msg string)
+	onChallenge func(sub Subscription) error
 
 	//Events
 	onAutomodMessageHold                          func(event AutomodMessageHoldEvent)
@@ -88,6 +118,7 @@ type Client struct {
 	onCharityCampaignStop                         func(event CharityCampaignStopEvent)
 	onConduitShardDisabled                        func(event ConduitShardDisabledEvent)
 	onDropEntitlementGrant                        func(event DropEntitlementGrantEvent)
+	onDropEntitlementGrantBatch                   func(events []DropEntitlementGrantEvent)
 	onExtensionBitsTransactionCreate              func(event ExtensionBitsTransactionCreateEvent)
 	onChannelGoalBegin                            func(event ChannelGoalBeginEvent)
 	onChannelGoalProgress                         func(event ChannelGoalProgressEvent)
@@ -113,955 +144,336 @@ type Client struct {
 }
 
 func NewClient(secret, callback string) *Client {
-	return &Client{secret: secret,
-		secretBytes: []byte(secret), callback: callback, debug: false}
+	c := &Client{secret: secret,
+		callback: callback, baseUrl: defaultBaseUrl, debug: false, timeout: defaultHandlerTimeout,
+		handlers:      map[string]func(ctx context.Context, sub Subscription, event json.RawMessage) error{},
+		verifications: map[string]*verification{},
+		onError:       func(err error) {}, onRevoked: func(sub Subscription) {}, onDebug: func(msg string) {},
+		onChallenge: func(sub Subscription) error { return nil }, metrics: nopMetrics{},
+		tracer: nopTracer{}, lag: newLagTracker()}
+	c.logger = slog.New(&debugHandler{client: c})
+	return c
+}
+
+// HandleEvent serves webhook messages over net/http, see Process.
+func (c *Client) HandleEvent(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	if c.router != nil {
+		ctx = c.router.withPath(ctx, req)
+	}
+	status, res := c.ProcessReader(ctx, req.Header, req.Body)
+	if len(res) > 0 {
+		w.Header().Set(contentType, textPlain)
+	}
+	w.WriteHeader(status)
+	w.Write(res)
 }
 
-func (c *Client) HandleEvent(w http.ResponseWriter, req *http.Request) {
-	if c.debug {
-		c.onDebug("Received eventsub message")
+// Process verifies and handles a webhook message given its headers and body, it returns the status code and
+// body of the response to send to Twitch. The body is only set when answering a challenge and must be sent as
+// text/plain. Process holds the logic of HandleEvent so frameworks other than net/http only need to convert
+// their request and response.
+func (c *Client) Process(headers http.Header, body []byte) (status int, responseBody []byte) {
+	return c.ProcessContext(context.Background(), headers, body)
+}
+
+// ProcessContext is Process with a context, its values reach the handlers and its cancellation stops
+// waiting for them in sync mode.
+func (c *Client) ProcessContext(ctx context.Context, headers http.Header, body []byte) (status int, responseBody []byte) {
+	return c.process(ctx, headers, body, nil)
+}
+
+// ProcessReader is ProcessContext reading the body from r, in an eventsub.read span of the delivery trace.
+// A body that cannot be read is answered with a 400.
+func (c *Client) ProcessReader(ctx context.Context, headers http.Header, r io.Reader) (status int, responseBody []byte) {
+	return c.process(ctx, headers, nil, r)
+}
+
+// process handles a webhook message, reading its body from r first when r is not nil.
+func (c *Client) process(ctx context.Context, headers http.Header, body []byte, r io.Reader) (status int, responseBody []byte) {
+	start := time.Now()
+	msgType := headers.Get(headerType)
+	c.logger.Debug("eventsub message received", "message_id", headers.Get(headerId), "message_type", msgType)
+	ctx, span := c.tracer.Start(ctx, SpanDelivery, Attribute{AttrMessageID, headers.Get(headerId)},
+		Attribute{AttrMessageType, msgType}, Attribute{AttrSubscriptionType, headers.Get(headerSubType)})
+	outcome := OutcomeOK
+	var data Response
+	var verified *Subscription
+	defer func() {
+		msgType, subType, version := messageLabels(msgType, verified)
+		c.metrics.Message(msgType, subType, version, outcome)
+		c.logDelivery(ctx, headers.Get(headerId), msgType, verified, outcome, status, time.Since(start))
+		span.SetAttributes(Attribute{AttrOutcome, outcome})
+		span.End()
+	}()
+	if r != nil {
+		if err := c.span(ctx, SpanRead, func(ctx context.Context) (err error) {
+			body, err = io.ReadAll(r)
+			return err
+		}); err != nil {
+			c.onError(err)
+			outcome = OutcomeBadRequest
+			return http.StatusBadRequest, nil
+		}
 	}
-	body, err := io.ReadAll(req.Body)
-	if err != nil {
+	if err := c.span(ctx, SpanDecode, func(ctx context.Context) error {
+		return json.Unmarshal(body, &data)
+	}); err != nil {
 		c.onError(err)
-		w.WriteHeader(http.StatusBadRequest)
-		return
+		outcome = OutcomeBadRequest
+		return http.StatusBadRequest, nil
 	}
-	signature := c.getHmac(req.Header.Get(headerId), req.Header.Get(headerTimestamp), body)
-	if signature != req.Header.Get(headerSignature) {
-		c.onError(errors.New("signatures do not match"))
-		w.WriteHeader(http.StatusForbidden)
-		return
+	span.SetAttributes(Attribute{AttrSubscriptionID, data.Subscription.Id})
+	var secretErr error
+	err := c.span(ctx, SpanVerify, func(ctx context.Context) error {
+		secret, err := c.secretFor(ctx, data.Subscription)
+		if err != nil {
+			secretErr = err
+			return err
+		}
+		signature := Sign(secret, headers.Get(headerId), headers.Get(headerTimestamp), body)
+		if !hmac.Equal([]byte(signature), []byte(headers.Get(headerSignature))) {
+			return errors.New("signatures do not match")
+		}
+		return nil
+	})
+	if secretErr != nil {
+		c.onError(fmt.Errorf("unable to resolve secret for subscription %s: %w", data.Subscription.Id, secretErr))
+		outcome = OutcomeUnknownSecret
+		return http.StatusForbidden, nil
 	}
-	var data Response
-	if err := json.Unmarshal(body, &data); err != nil {
+	if err != nil {
 		c.onError(err)
-		w.WriteHeader(http.StatusBadRequest)
-		return
+		outcome = OutcomeInvalidSignature
+		return http.StatusForbidden, nil
 	}
-	switch req.Header.Get(headerType) {
+	verified = &data.Subscription
+	switch msgType {
 	case headerChallenge:
-		if c.debug {
-			c.onDebug("Received challenge message")
-		}
-		w.Write([]byte(data.Challenge))
+		c.logger.Debug("challenge received", "subscription_id", data.Subscription.Id, "type", data.Subscription.Type)
+		if err := c.onChallenge(data.Subscription); err != nil {
+			c.onError(fmt.Errorf("challenge refused for subscription %s: %w", data.Subscription.Id, err))
+			c.resolveVerification(data.Subscription.Id, fmt.Errorf("%w: challenge refused: %w", ErrVerificationFailed, err))
+			outcome = OutcomeRefused
+			return http.StatusForbidden, nil
+		}
+		c.resolveVerification(data.Subscription.Id, nil)
+		return http.StatusOK, []byte(data.Challenge)
 	case notification:
-		go c.parseNotification(data)
+		timestamp, _ := time.Parse(time.RFC3339Nano, headers.Get(headerTimestamp))
+		env := Envelope{MessageID: headers.Get(headerId), MessageTimestamp: timestamp, ReceivedAt: time.Now(),
+			Subscription: data.Subscription, Body: body}
+		event := newNotificationEvent(data)
+		// Recording, publishing and the sync handlers share one deadline so the answer still reaches Twitch in time.
+		deadline, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+		if c.sink != nil {
+			if err := c.sink.Record(deadline, env); err != nil {
+				c.onError(fmt.Errorf("unable to record message %s: %w", env.MessageID, err))
+				outcome = OutcomeSinkError
+				return http.StatusInternalServerError, nil
+			}
+		}
+		sub := data.Subscription
+		if lag, stale := c.lag.observe(env); stale {
+			c.logger.Debug("stale notification skipped", "message_id", env.MessageID, "subscription_id", sub.Id,
+				"type", sub.Type, "broadcaster_id", BroadcasterKey(sub), "latency", lag)
+			outcome = OutcomeStale
+			return http.StatusNoContent, nil
+		}
+		if len(c.publishers) > 0 {
+			if err := c.span(deadline, SpanPublish, func(ctx context.Context) error {
+				return c.publish(ctx, env, event)
+			}); err != nil {
+				c.onError(err)
+				outcome = OutcomePublishError
+				return http.StatusInternalServerError, nil
+			}
+		}
+		if c.sync {
+			start := time.Now()
+			status = c.runSync(deadline, func(ctx context.Context) error {
+				return c.span(ctx, SpanDispatch, func(ctx context.Context) error {
+					return c.dispatch(ctx, env, data, event)
+				})
+			})
+			c.metrics.Handled(sub.Type, sub.Version, handlerOutcome(status), time.Since(start))
+			return status, nil
+		}
+		ctx := context.WithoutCancel(ctx)
+		c.async(ctx, func() {
+			start := time.Now()
+			err := c.span(ctx, SpanDispatch, func(ctx context.Context) error {
+				return c.dispatch(ctx, env, data, event)
+			})
+			handled := OutcomeOK
+			if err != nil {
+				handled = OutcomeError
+				c.onError(err)
+				c.logger.Error("notification handlers failed", "message_id", env.MessageID, "subscription_id", sub.Id,
+					"type", sub.Type, "broadcaster_id", BroadcasterKey(sub), "error", err)
+			}
+			c.metrics.Handled(sub.Type, sub.Version, handled, time.Since(start))
+		})
+		return http.StatusNoContent, nil
 	case revocation:
-		go c.onRevoked(data.Subscription)
+		c.revokeVerification(data.Subscription.Id, fmt.Errorf("%w: %s", ErrVerificationFailed, data.Subscription.Status))
+		if c.sync {
+			ctx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			return c.runSync(ctx, func(ctx context.Context) error {
+				c.revoked(ctx, data.Subscription)
+				return nil
+			}), nil
+		}
+		c.async(ctx, func() {
+			c.revoked(ctx, data.Subscription)
+		})
+		return http.StatusNoContent, nil
+	default:
+		c.onError(fmt.Errorf("unknown message type: %s", msgType))
+		outcome = OutcomeUnknownType
+		return http.StatusBadRequest, nil
 	}
 }
 
-func (c *Client) getHmac(id, timestamp string, body []byte) string {
-	message := []byte(id + timestamp)
-	message = append(message, body...)
-	hash := hmac.New(sha256.New, c.secretBytes)
-	hash.Write(message)
-	return hmacPrefix + hex.EncodeToString(hash.Sum(nil))
-}
+// invocationKey is the context key of the WaitGroup counting the handlers started by one Lambda invocation.
+type invocationKey struct{}
 
-func (c *Client) parseNotification(data Response) {
-	if c.debug {
-		c.onDebug(fmt.Sprintf("Received notification of type: %s", data.Subscription.Type))
+// track counts a running handler for Shutdown and for the invocation ctx belongs to, if any, the returned
+// function marks it done.
+func (c *Client) track(ctx context.Context) func() {
+	c.inflight.Add(1)
+	wg, _ := ctx.Value(invocationKey{}).(*sync.WaitGroup)
+	if wg != nil {
+		wg.Add(1)
 	}
-	switch data.Subscription.Type {
-	case "automod.message.hold":
-		if c.onAutomodMessageHold == nil {
-			break
-		}
-		var e AutomodMessageHoldEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[automod.message.hold][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onAutomodMessageHold(e)
-
-	case "automod.message.update":
-		if c.onAutomodMessageUpdate == nil {
-			break
-		}
-		var e AutomodMessageUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[automod.message.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onAutomodMessageUpdate(e)
-
-	case "automod.settings.update":
-		if c.onAutomodSettingsUpdate == nil {
-			break
-		}
-		var e AutomodSettingsUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[automod.settings.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onAutomodSettingsUpdate(e)
-
-	case "automod.terms.update":
-		if c.onAutomodTermsUpdate == nil {
-			break
-		}
-		var e AutomodTermsUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[automod.terms.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onAutomodTermsUpdate(e)
-
-	case "channel.update":
-		if c.onChannelUpdate == nil {
-			break
-		}
-		var e ChannelUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelUpdate(e)
-
-	case "channel.follow":
-		if c.onChannelFollow == nil {
-			break
-		}
-		var e ChannelFollowEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.follow][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelFollow(e)
-
-	case "channel.ad_break.begin":
-		if c.onChannelAdBreakBegin == nil {
-			break
-		}
-		var e ChannelAdBreakBeginEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.ad_break.begin][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelAdBreakBegin(e)
-
-	case "channel.chat.clear":
-		if c.onChannelChatClear == nil {
-			break
-		}
-		var e ChannelChatClearEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.chat.clear][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelChatClear(e)
-
-	case "channel.chat.clear_user_messages":
-		if c.onChannelChatClearUserMessages == nil {
-			break
-		}
-		var e ChannelChatClearUserMessagesEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.chat.clear_user_messages][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelChatClearUserMessages(e)
-
-	case "channel.chat.message":
-		if c.onChannelChatMessage == nil {
-			break
-		}
-		var e ChannelChatMessageEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.chat.message][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelChatMessage(e)
-
-	case "channel.chat.message_delete":
-		if c.onChannelChatMessageDelete == nil {
-			break
-		}
-		var e ChannelChatMessageDeleteEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.chat.message_delete][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelChatMessageDelete(e)
-
-	case "channel.chat.notification":
-		if c.onChannelChatNotification == nil {
-			break
-		}
-		var e ChannelChatNotificationEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.chat.notification][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelChatNotification(e)
-
-	case "channel.chat_settings.update":
-		if c.onChannelChatSettingsUpdate == nil {
-			break
-		}
-		var e ChannelChatSettingsUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.chat_settings.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelChatSettingsUpdate(e)
-
-	case "channel.chat.user_message_hold":
-		if c.onChannelChatUserMessageHold == nil {
-			break
-		}
-		var e ChannelChatUserMessageHoldEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.chat.user_message_hold][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelChatUserMessageHold(e)
-
-	case "channel.chat.user_message_update":
-		if c.onChannelChatUserMessageUpdate == nil {
-			break
-		}
-		var e ChannelChatUserMessageUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.chat.user_message_update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelChatUserMessageUpdate(e)
-
-	case "channel.subscribe":
-		if c.onChannelSubscribe == nil {
-			break
-		}
-		var e ChannelSubscribeEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.subscribe][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelSubscribe(e)
-
-	case "channel.subscription.end":
-		if c.onChannelSubscriptionEnd == nil {
-			break
-		}
-		var e ChannelSubscriptionEndEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.subscription.end][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelSubscriptionEnd(e)
-
-	case "channel.subscription.gift":
-		if c.onChannelSubscriptionGift == nil {
-			break
-		}
-		var e ChannelSubscriptionGiftEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.subscription.gift][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelSubscriptionGift(e)
-
-	case "channel.subscription.message":
-		if c.onChannelSubscriptionMessage == nil {
-			break
-		}
-		var e ChannelSubscriptionMessageEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.subscription.message][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelSubscriptionMessage(e)
-
-	case "channel.cheer":
-		if c.onChannelCheer == nil {
-			break
-		}
-		var e ChannelCheerEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.cheer][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelCheer(e)
-
-	case "channel.raid":
-		if c.onChannelRaid == nil {
-			break
-		}
-		var e ChannelRaidEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.raid][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelRaid(e)
-
-	case "channel.ban":
-		if c.onChannelBan == nil {
-			break
-		}
-		var e ChannelBanEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.ban][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelBan(e)
-
-	case "channel.unban":
-		if c.onChannelUnban == nil {
-			break
-		}
-		var e ChannelUnbanEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.unban][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelUnban(e)
-
-	case "channel.unban_request.create":
-		if c.onChannelUnbanRequestCreate == nil {
-			break
-		}
-		var e ChannelUnbanRequestCreateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.unban_request.create][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelUnbanRequestCreate(e)
-
-	case "channel.unban_request.resolve":
-		if c.onChannelUnbanRequestResolve == nil {
-			break
-		}
-		var e ChannelUnbanRequestResolveEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.unban_request.resolve][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelUnbanRequestResolve(e)
-
-	case "channel.moderate":
-		if c.onChannelModerate == nil {
-			break
-		}
-		var e ChannelModerateEventV2
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.moderate][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelModerate(e)
-
-	case "channel.moderator.add":
-		if c.onChannelModeratorAdd == nil {
-			break
-		}
-		var e ChannelModeratorAddEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.moderator.add][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelModeratorAdd(e)
-
-	case "channel.moderator.remove":
-		if c.onChannelModeratorRemove == nil {
-			break
-		}
-		var e ChannelModeratorRemoveEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.moderator.remove][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelModeratorRemove(e)
-
-	case "channel.guest_star_session.begin":
-		if c.onChannelGuestStarSessionBegin == nil {
-			break
-		}
-		var e ChannelGuestStarSessionBeginEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.guest_star_session.begin][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelGuestStarSessionBegin(e)
-
-	case "channel.guest_star_session.end":
-		if c.onChannelGuestStarSessionEnd == nil {
-			break
-		}
-		var e ChannelGuestStarSessionEndEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.guest_star_session.end][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelGuestStarSessionEnd(e)
-
-	case "channel.guest_star_guest.update":
-		if c.onChannelGuestStarGuestUpdate == nil {
-			break
-		}
-		var e ChannelGuestStarGuestUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.guest_star_guest.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelGuestStarGuestUpdate(e)
-
-	case "channel.guest_star_settings.update":
-		if c.onChannelGuestStarSettingsUpdate == nil {
-			break
-		}
-		var e ChannelGuestStarSettingsUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.guest_star_settings.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelGuestStarSettingsUpdate(e)
-
-	case "channel.channel_points_automatic_reward_redemption.add":
-		if c.onChannelPointsAutomaticRewardRedemptionAddV2 == nil {
-			break
-		}
-		var e ChannelPointsAutomaticRewardRedemptionAddEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.channel_points_automatic_reward_redemption.add][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPointsAutomaticRewardRedemptionAddV2(e)
-
-	case "channel.channel_points_custom_reward.add":
-		if c.onChannelPointsCustomRewardAdd == nil {
-			break
-		}
-		var e ChannelPointsCustomRewardAddEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.channel_points_custom_reward.add][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPointsCustomRewardAdd(e)
-
-	case "channel.channel_points_custom_reward.update":
-		if c.onChannelPointsCustomRewardUpdate == nil {
-			break
-		}
-		var e ChannelPointsCustomRewardUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.channel_points_custom_reward.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPointsCustomRewardUpdate(e)
-
-	case "channel.channel_points_custom_reward.remove":
-		if c.onChannelPointsCustomRewardRemove == nil {
-			break
-		}
-		var e ChannelPointsCustomRewardRemoveEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.channel_points_custom_reward.remove][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPointsCustomRewardRemove(e)
-
-	case "channel.channel_points_custom_reward_redemption.add":
-		if c.onChannelPointsCustomRewardRedemptionAdd == nil {
-			break
-		}
-		var e ChannelPointsCustomRewardRedemptionAddEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.channel_points_custom_reward_redemption.add][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPointsCustomRewardRedemptionAdd(e)
-
-	case "channel.channel_points_custom_reward_redemption.update":
-		if c.onChannelPointsCustomRewardRedemptionUpdate == nil {
-			break
+	return func() {
+		if wg != nil {
+			wg.Done()
 		}
-		var e ChannelPointsCustomRewardRedemptionUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.channel_points_custom_reward_redemption.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPointsCustomRewardRedemptionUpdate(e)
-
-	case "channel.poll.begin":
-		if c.onChannelPollBegin == nil {
-			break
-		}
-		var e ChannelPollBeginEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.poll.begin][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPollBegin(e)
-
-	case "channel.poll.progress":
-		if c.onChannelPollProgress == nil {
-			break
-		}
-		var e ChannelPollProgressEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.poll.progress][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPollProgress(e)
-
-	case "channel.poll.end":
-		if c.onChannelPollEnd == nil {
-			break
-		}
-		var e ChannelPollEndEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.poll.end][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPollEnd(e)
-
-	case "channel.prediction.begin":
-		if c.onChannelPredictionBegin == nil {
-			break
-		}
-		var e ChannelPredictionBeginEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.prediction.begin][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPredictionBegin(e)
-
-	case "channel.prediction.progress":
-		if c.onChannelPredictionProgress == nil {
-			break
-		}
-		var e ChannelPredictionProgressEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.prediction.progress][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPredictionProgress(e)
-
-	case "channel.prediction.lock":
-		if c.onChannelPredictionLock == nil {
-			break
-		}
-		var e ChannelPredictionLockEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.prediction.lock][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPredictionLock(e)
-
-	case "channel.prediction.end":
-		if c.onChannelPredictionEnd == nil {
-			break
-		}
-		var e ChannelPredictionEndEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.prediction.end][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelPredictionEnd(e)
-
-	case "channel.vip.add":
-		if c.onChannelVIPAdd == nil {
-			break
-		}
-		var e ChannelVIPAddEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.vip.add][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelVIPAdd(e)
-
-	case "channel.vip.remove":
-		if c.onChannelVIPRemove == nil {
-			break
-		}
-		var e ChannelVIPRemoveEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.vip.remove][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelVIPRemove(e)
-
-	case "channel.charity_campaign.donate":
-		if c.onCharityCampaignDonate == nil {
-			break
-		}
-		var e CharityCampaignDonateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.charity_campaign.donate][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onCharityCampaignDonate(e)
-
-	case "channel.charity_campaign.start":
-		if c.onCharityCampaignStart == nil {
-			break
-		}
-		var e CharityCampaignStartEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.charity_campaign.start][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onCharityCampaignStart(e)
-
-	case "channel.charity_campaign.progress":
-		if c.onCharityCampaignProgress == nil {
-			break
-		}
-		var e CharityCampaignProgressEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.charity_campaign.progress][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onCharityCampaignProgress(e)
-
-	case "channel.charity_campaign.stop":
-		if c.onCharityCampaignStop == nil {
-			break
-		}
-		var e CharityCampaignStopEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.charity_campaign.stop][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onCharityCampaignStop(e)
-
-	case "conduit.shard.disabled":
-		if c.onConduitShardDisabled == nil {
-			break
-		}
-		var e ConduitShardDisabledEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[conduit.shard.disabled][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onConduitShardDisabled(e)
-
-	case "drop.entitlement.grant":
-		if c.onDropEntitlementGrant == nil {
-			break
-		}
-		var e DropEntitlementGrantEvent
-		err := json.Unmarshal(data.Events, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[drop.entitlement.grant][%s]: %s", parseError, string(data.Events), err.Error()))
-			break
-		}
-		c.onDropEntitlementGrant(e)
-
-	case "extension.bits_transaction.create":
-		if c.onExtensionBitsTransactionCreate == nil {
-			break
-		}
-		var e ExtensionBitsTransactionCreateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[extension.bits_transaction.create][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onExtensionBitsTransactionCreate(e)
-
-	case "channel.goal.begin":
-		if c.onChannelGoalBegin == nil {
-			break
-		}
-		var e ChannelGoalBeginEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.goal.begin][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelGoalBegin(e)
-
-	case "channel.goal.progress":
-		if c.onChannelGoalProgress == nil {
-			break
-		}
-		var e ChannelGoalProgressEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.goal.progress][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelGoalProgress(e)
-
-	case "channel.goal.end":
-		if c.onChannelGoalEnd == nil {
-			break
-		}
-		var e ChannelGoalEndEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.goal.end][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelGoalEnd(e)
-
-	case "channel.hype_train.begin":
-		if c.onChannelHypeTrainBegin == nil {
-			break
-		}
-		var e ChannelHypeTrainBeginEventV2
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.hype_train.begin][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelHypeTrainBegin(e)
-
-	case "channel.hype_train.progress":
-		if c.onChannelHypeTrainProgress == nil {
-			break
-		}
-		var e ChannelHypeTrainProgressEventV2
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.hype_train.progress][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelHypeTrainProgress(e)
-
-	case "channel.hype_train.end":
-		if c.onChannelHypeTrainEnd == nil {
-			break
-		}
-		var e ChannelHypeTrainEndEventV2
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.hype_train.end][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelHypeTrainEnd(e)
-
-	case "channel.shield_mode.begin":
-		if c.onChannelShieldModeBegin == nil {
-			break
-		}
-		var e ChannelShieldModeBeginEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.shield_mode.begin][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelShieldModeBegin(e)
-
-	case "channel.shield_mode.end":
-		if c.onChannelShieldModeEnd == nil {
-			break
-		}
-		var e ChannelShieldModeEndEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.shield_mode.end][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelShieldModeEnd(e)
-
-	case "channel.shoutout.create":
-		if c.onChannelShoutOutCreate == nil {
-			break
-		}
-		var e ChannelShoutOutCreateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.shoutout.create][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelShoutOutCreate(e)
+		c.inflight.Done()
+	}
+}
 
-	case "channel.shoutout.receive":
-		if c.onChannelShoutOutReceived == nil {
-			break
-		}
-		var e ChannelShoutOutReceivedEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.shoutout.receive][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelShoutOutReceived(e)
+// async runs f in its own goroutine, Shutdown waits for it.
+func (c *Client) async(ctx context.Context, f func()) {
+	done := c.track(ctx)
+	go func() {
+		defer done()
+		f()
+	}()
+}
+
+// Shutdown waits until the running handlers have returned or ctx is done, including the ones of already
+// acknowledged messages and the sync ones past their timeout. It should be called after the HTTP server has
+// stopped accepting requests.
+func (c *Client) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		c.inflight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
 
-	case "stream.online":
-		if c.onStreamOnline == nil {
-			break
-		}
-		var e StreamOnlineEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[stream.online][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onStreamOnline(e)
+// runSync runs f until the deadline of ctx and maps its outcome to the status code Twitch should receive,
+// a 5xx makes Twitch redeliver the message.
+func (c *Client) runSync(ctx context.Context, f func(ctx context.Context) error) int {
+	done := make(chan error, 1)
+	finished := c.track(ctx)
+	go func() {
+		defer finished()
+		done <- f(ctx)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			c.onError(err)
+			return http.StatusInternalServerError
+		}
+		return http.StatusNoContent
+	case <-ctx.Done():
+		c.onError(fmt.Errorf("handlers did not finish within %s: %w", c.timeout, ctx.Err()))
+		return http.StatusServiceUnavailable
+	}
+}
 
-	case "stream.offline":
-		if c.onStreamOffline == nil {
-			break
-		}
-		var e StreamOfflineEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[stream.offline][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onStreamOffline(e)
+// Sign returns the Twitch-Eventsub-Message-Signature value of a message signed with secret.
+func Sign(secret, id, timestamp string, body []byte) string {
+	message := []byte(id + timestamp)
+	message = append(message, body...)
+	hash := hmac.New(sha256.New, []byte(secret))
+	hash.Write(message)
+	return hmacPrefix + hex.EncodeToString(hash.Sum(nil))
+}
 
-	case "user.authorization.grant":
-		if c.onUserAuthorizationGrant == nil {
-			break
-		}
-		var e UserAuthorizationGrantEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[user.authorization.grant][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onUserAuthorizationGrant(e)
+// parseFailed reports an event that could not be decoded.
+func (c *Client) parseFailed(sub Subscription, raw json.RawMessage, err error) {
+	c.metrics.ParseError(sub.Type, sub.Version)
+	c.onError(fmt.Errorf("%s[%s][%s]: %s", parseError, sub.Type, string(raw), err.Error()))
+}
 
-	case "user.authorization.revoke":
-		if c.onUserAuthorizationRevoke == nil {
-			break
+// dispatch runs the listeners and then the handlers for a notification.
+func (c *Client) dispatch(ctx context.Context, env Envelope, data Response, event *notificationEvent) error {
+	for _, l := range c.listeners {
+		if err := l(ctx, env); err != nil {
+			return err
 		}
-		var e UserAuthorizationRevokeEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[user.authorization.revoke][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onUserAuthorizationRevoke(e)
+	}
+	return c.parseNotification(ctx, data, event)
+}
 
-	case "user.update":
-		if c.onUserUpdate == nil {
-			break
-		}
-		var e UserUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[user.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
+func (c *Client) revoked(ctx context.Context, sub Subscription) {
+	if c.router != nil {
+		if t, ok := c.router.route(ctx, sub); ok {
+			t.onRevoked(sub)
+			return
 		}
-		c.onUserUpdate(e)
+	}
+	c.onRevoked(sub)
+}
 
-	case "user.whisper.message":
-		if c.onUserWhisperMessage == nil {
-			break
-		}
-		var e UserWhisperMessageEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[user.whisper.message][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onUserWhisperMessage(e)
-	case "channel.suspicious_user.update":
-		if c.onChannelSuspiciousUserUpdate == nil {
-			break
-		}
-		var e ChannelSuspiciousUserUpdateEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.suspicious_user.update][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelSuspiciousUserUpdate(e)
-	case "channel.bits.use":
-		if c.onChannelBitsUse == nil {
-			break
+func (c *Client) parseNotification(ctx context.Context, data Response, event *notificationEvent) error {
+	if c.router != nil {
+		if t, ok := c.router.route(ctx, data.Subscription); ok {
+			return t.parseNotification(ctx, data, event)
 		}
-		var e ChannelBitsUseEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.bits.use][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelBitsUse(e)
-	case "channel.suspicious_user.message":
-		if c.onChannelSuspiciousUserMessage == nil {
-			break
-		}
-		var e ChannelSuspiciousUserMessageEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.suspicious_user.message][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelSuspiciousUserMessage(e)
-	case "channel.warning.acknowledge":
-		if c.onChannelWarningAcknowledge == nil {
-			break
-		}
-		var e ChannelWarningAcknowledgeEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.warning.acknowledge][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
-		}
-		c.onChannelWarningAcknowledge(e)
-	case "channel.warning.send":
-		if c.onChannelWarningSend == nil {
-			break
+	}
+	c.logger.Debug("notification received", "subscription_id", data.Subscription.Id, "type", data.Subscription.Type,
+		"broadcaster_id", BroadcasterKey(data.Subscription))
+	h, handled := c.handlers[data.Subscription.Type]
+	if handled {
+		if err := h(ctx, data.Subscription, event.raw); err != nil {
+			return err
 		}
-		var e ChannelWarningSendEvent
-		err := json.Unmarshal(data.Event, &e)
-		if err != nil {
-			c.onError(fmt.Errorf("%s[channel.warning.send][%s]: %s", parseError, string(data.Event), err.Error()))
-			break
+	}
+	t, ok := eventTypes[data.Subscription.Type]
+	if !ok {
+		if !handled {
+			c.onError(fmt.Errorf("%s[default][%s]: Unable to parse event", parseError, string(data.Event)))
 		}
-		c.onChannelWarningSend(e)
-	default:
-		c.onError(fmt.Errorf("%s[default][%s]: Unable to parse event", parseError, string(data.Event)))
+		return nil
+	}
+	f := t.handle(c)
+	// an event the publishers already decoded is reported even without an On function
+	if f == nil && !event.decoded {
+		return nil
+	}
+	e, err := event.decode()
+	if err != nil {
+		c.parseFailed(data.Subscription, event.raw, err)
+		return nil
+	}
+	if f != nil {
+		f(e)
 	}
+	return nil
 }
 
 func (c *Client) OnError(f func(err error)) {
@@ -1072,10 +484,18 @@ func (c *Client) OnRevoked(f func(sub Subscription)) {
 	c.onRevoked = f
 }
 
+// OnDebug receives the debug records formatted as text when SetDebug is enabled and no logger was set
+// with SetLogger.
 func (c *Client) OnDebug(f func(msg string)) {
 	c.onDebug = f
 }
 
+// OnChallenge is called before answering a webhook_callback_verification message,
+// returning an error refuses the challenge and Twitch marks the subscription as failed.
+func (c *Client) OnChallenge(f func(sub Subscription) error) {
+	c.onChallenge = f
+}
+
 func (c *Client) OnAutomodMessageHold(f func(event AutomodMessageHoldEvent)) {
 	c.onAutomodMessageHold = f
 }
@@ -1284,10 +704,16 @@ func (c *Client) OnConduitShardDisabled(f func(event ConduitShardDisabledEvent))
 	c.onConduitShardDisabled = f
 }
 
+// OnDropEntitlementGrant is called once for every entitlement in a drop.entitlement.grant notification.
 func (c *Client) OnDropEntitlementGrant(f func(event DropEntitlementGrantEvent)) {
 	c.onDropEntitlementGrant = f
 }
 
+// OnDropEntitlementGrantBatch is called once per drop.entitlement.grant notification with all of its entitlements.
+func (c *Client) OnDropEntitlementGrantBatch(f func(events []DropEntitlementGrantEvent)) {
+	c.onDropEntitlementGrantBatch = f
+}
+
 func (c *Client) OnExtensionBitsTransactionCreate(f func(event ExtensionBitsTransactionCreateEvent)) {
 	c.onExtensionBitsTransactionCreate = f
 }
@@ -1376,6 +802,54 @@ func (c *Client) OnChannelWarningSend(f func(event ChannelWarningSendEvent)) {
 	c.onChannelWarningSend = f
 }
 
+// SetDebug enables the records sent to OnDebug, it has no effect on a logger set with SetLogger.
 func (c *Client) SetDebug(b bool) {
 	c.debug = b
 }
+
+// ErrHandlerReplaced is reported to OnError when Handle replaces the handler of a subscription type.
+var ErrHandlerReplaced = errors.New("handler replaced")
+
+// Handle registers an error returning handler for the given subscription type, it runs before the handler
+// registered with the matching On function. Batched types like drop.entitlement.grant decode the events list,
+// so T must be a slice such as []DropEntitlementGrantEvent. In sync mode a non nil error makes HandleEvent respond with a 5xx
+// so Twitch redelivers the notification, ctx expires when the handler deadline is reached. An event that cannot be
+// decoded into T is reported to OnError and acknowledged without calling f, like with the On functions, as a
+// redelivery would fail the same way. A type has a single Handle handler, replacing one reports
+// ErrHandlerReplaced to OnError and logs a warning.
+func Handle[T any](c *Client, event EventType, f func(ctx context.Context, event T) error) {
+	if _, ok := c.handlers[string(event)]; ok {
+		c.onError(fmt.Errorf("%w: %s", ErrHandlerReplaced, event))
+		c.logger.Warn("handler replaced", "type", string(event))
+	}
+	c.handlers[string(event)] = func(ctx context.Context, sub Subscription, raw json.RawMessage) error {
+		var e T
+		if err := json.Unmarshal(raw, &e); err != nil {
+			// the same body would fail again, it is acknowledged
+			c.parseFailed(sub, raw, err)
+			return nil
+		}
+		return f(ctx, e)
+	}
+}
+
+// AddListener registers f to receive every notification before the handlers, whatever its type. Listeners are
+// how components like SSEServer follow the client, in sync mode an error makes HandleEvent respond with a 5xx.
+func (c *Client) AddListener(f func(ctx context.Context, env Envelope) error) {
+	c.listeners = append(c.listeners, f)
+}
+
+// SetHandlerTimeout sets how long HandleEvent waits for the publishers and, in sync mode, the handlers of a
+// notification together before responding with a 5xx, it is capped so the response always reaches Twitch before its callback timeout.
+func (c *Client) SetHandlerTimeout(d time.Duration) {
+	if d <= 0 {
+		d = defaultHandlerTimeout
+	}
+	c.timeout = min(d, maxHandlerTimeout)
+}
+
+// SetSyncMode makes HandleEvent run handlers before acknowledging a notification or revocation,
+// so Twitch only receives a 2xx once they have finished.
+func (c *Client) SetSyncMode(b bool) {
+	c.sync = b
+}
@@ -0,0 +1,167 @@
+package twitcheventsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+const testSecret = "test-secret-value"
+
+// process sends the fixture to c as a signed notification and returns the status code.
+func process(t *testing.T, c *twitcheventsub.Client, f eventsubtest.Fixture) int {
+	t.Helper()
+	body, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := eventsubtest.NewRequest("http://localhost/eventsub", testSecret, eventsubtest.MessageTypeNotification,
+		f.Subscription, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, _ := c.Process(req.Header, body)
+	return status
+}
+
+// registerAll registers a handler with every On function taking an event, events collects the events handled.
+func registerAll(c *twitcheventsub.Client, events *[]any) {
+	v := reflect.ValueOf(c)
+	for i := 0; i < v.NumMethod(); i++ {
+		m := v.Type().Method(i)
+		switch m.Name {
+		case "OnError", "OnRevoked", "OnDebug", "OnChallenge":
+			continue
+		}
+		if !strings.HasPrefix(m.Name, "On") || m.Type.NumIn() != 2 || m.Type.In(1).Kind() != reflect.Func {
+			continue
+		}
+		f := m.Type.In(1)
+		if f.NumIn() != 1 || f.NumOut() != 0 {
+			continue
+		}
+		v.Method(i).Call([]reflect.Value{reflect.MakeFunc(f, func(args []reflect.Value) []reflect.Value {
+			*events = append(*events, args[0].Interface())
+			return nil
+		})})
+	}
+}
+
+func decodeJSON(t *testing.T, b []byte) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestFixturesRoundTrip(t *testing.T) {
+	fixtures := eventsubtest.Fixtures()
+	if len(fixtures) == 0 {
+		t.Fatal("no fixtures")
+	}
+	for _, f := range fixtures {
+		t.Run(f.Subscription.Type+".v"+f.Subscription.Version, func(t *testing.T) {
+			c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+			c.SetSyncMode(true)
+			c.OnError(func(err error) {
+				if strings.Contains(err.Error(), "parseNotificationError") {
+					t.Error(err)
+				}
+			})
+			var events []any
+			registerAll(c, &events)
+			if status := process(t, c, f); status != http.StatusNoContent {
+				t.Fatalf("status = %d, want %d", status, http.StatusNoContent)
+			}
+			if len(events) == 0 {
+				t.Fatal("no handler was called")
+			}
+			// every field of the fixture made it into the struct and back, batched types are also handled entry
+			// by entry
+			want := []any{decodeJSON(t, f.Payload())}
+			if entries, ok := want[0].([]any); ok && len(f.Events) > 0 {
+				want = append(want, entries...)
+			}
+			for _, event := range events {
+				b, err := json.Marshal(event)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := decodeJSON(t, b); !slices.ContainsFunc(want, func(w any) bool { return reflect.DeepEqual(got, w) }) {
+					t.Errorf("re-encoded event differs from the fixture:\n got %s\nwant %s", b, f.Payload())
+				}
+			}
+		})
+	}
+}
+
+func TestBuilderRoundTrip(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	bits := 0
+	c.OnChannelCheer(func(event twitcheventsub.ChannelCheerEvent) {
+		bits = event.Bits
+	})
+	if status := process(t, c, eventsubtest.NewChannelCheerEvent().WithBits(500).Fixture()); status != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", status, http.StatusNoContent)
+	}
+	if bits != 500 {
+		t.Errorf("bits = %d, want 500", bits)
+	}
+}
+
+func TestHandleEvent(t *testing.T) {
+	eventsubtest.TestAdapter(t, func(c *twitcheventsub.Client) http.Handler {
+		return http.HandlerFunc(c.HandleEvent)
+	})
+}
+
+func TestHandle(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	var reported []error
+	c.OnError(func(err error) {
+		reported = append(reported, err)
+	})
+	var handled []string
+	failure := errors.New("database unavailable")
+	twitcheventsub.Handle(c, twitcheventsub.Follow, func(ctx context.Context, event twitcheventsub.ChannelFollowEvent) error {
+		handled = append(handled, event.BroadcasterUserID)
+		if event.BroadcasterUserID == "fail" {
+			return failure
+		}
+		return nil
+	})
+
+	if status := process(t, c, follow(t, "1")); status != http.StatusNoContent {
+		t.Errorf("handled: status = %d, want %d", status, http.StatusNoContent)
+	}
+	if status := process(t, c, follow(t, "fail")); status != http.StatusInternalServerError {
+		t.Errorf("handler error: status = %d, want %d so Twitch redelivers", status, http.StatusInternalServerError)
+	}
+	// a redelivery would not decode either, the notification is acknowledged and reported
+	malformed, err := follow(t, "2").WithField("followed_at", 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reported = nil
+	if status := process(t, c, malformed); status != http.StatusNoContent {
+		t.Errorf("malformed event: status = %d, want %d", status, http.StatusNoContent)
+	}
+	if len(reported) != 1 {
+		t.Errorf("malformed event reported %d times, want once", len(reported))
+	}
+	if got := strings.Join(handled, ","); got != "1,fail" {
+		t.Errorf("handled %s, want 1,fail", got)
+	}
+}
@@ -0,0 +1,15 @@
+// Package eventsubchi serves twitcheventsub webhooks with chi. chi routes plain net/http handlers, so
+// Client.HandleEvent already works with it, Mount only saves the method wiring.
+package eventsubchi
+
+import (
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/go-chi/chi/v5"
+)
+
+// Mount routes the POST requests to pattern to c:
+//
+//	eventsubchi.Mount(r, "/eventsub", client)
+func Mount(r chi.Router, pattern string, c *twitcheventsub.Client) {
+	r.Post(pattern, c.HandleEvent)
+}
@@ -0,0 +1,18 @@
+package eventsubchi
+
+import (
+	"net/http"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+	"github.com/go-chi/chi/v5"
+)
+
+func TestMount(t *testing.T) {
+	eventsubtest.TestAdapter(t, func(c *twitcheventsub.Client) http.Handler {
+		r := chi.NewRouter()
+		Mount(r, "/eventsub", c)
+		return r
+	})
+}
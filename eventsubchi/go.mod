@@ -0,0 +1,10 @@
+module github.com/Aiuzu42/go-twitch-eventsub/eventsubchi
+
+go 1.23.0
+
+replace github.com/Aiuzu42/go-twitch-eventsub => ../
+
+require (
+	github.com/Aiuzu42/go-twitch-eventsub v0.0.0-00010101000000-000000000000
+	github.com/go-chi/chi/v5 v5.1.0
+)
@@ -0,0 +1,21 @@
+// Package eventsubecho serves twitcheventsub webhooks with echo.
+package eventsubecho
+
+import (
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler returns an echo handler that passes webhook messages to c, register it on the path of the callback:
+//
+//	e.POST("/eventsub", eventsubecho.Handler(client))
+func Handler(c *twitcheventsub.Client) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		status, res := c.ProcessReader(req.Context(), req.Header, req.Body)
+		if len(res) > 0 {
+			return ctx.Blob(status, "text/plain", res)
+		}
+		return ctx.NoContent(status)
+	}
+}
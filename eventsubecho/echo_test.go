@@ -0,0 +1,18 @@
+package eventsubecho
+
+import (
+	"net/http"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+	"github.com/labstack/echo/v4"
+)
+
+func TestHandler(t *testing.T) {
+	eventsubtest.TestAdapter(t, func(c *twitcheventsub.Client) http.Handler {
+		e := echo.New()
+		e.POST("/eventsub", Handler(c))
+		return e
+	})
+}
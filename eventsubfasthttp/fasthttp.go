@@ -0,0 +1,29 @@
+// Package eventsubfasthttp serves twitcheventsub webhooks with fasthttp.
+package eventsubfasthttp
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/valyala/fasthttp"
+)
+
+// Handler returns a fasthttp handler that passes webhook messages to c. It answers every request it gets, so
+// route only the callback path to it.
+func Handler(c *twitcheventsub.Client) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		headers := http.Header{}
+		ctx.Request.Header.VisitAll(func(key, value []byte) {
+			headers.Add(string(key), string(value))
+		})
+		// fasthttp reuses the RequestCtx and its body once the handler returns, asynchronous handlers outlive it
+		status, res := c.ProcessContext(context.Background(), headers, bytes.Clone(ctx.PostBody()))
+		ctx.SetStatusCode(status)
+		if len(res) > 0 {
+			ctx.SetContentType("text/plain")
+			ctx.SetBody(res)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package eventsubfasthttp
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+	"github.com/valyala/fasthttp"
+)
+
+// serve runs h on req and copies its response to w, then resets the RequestCtx like fasthttp does once a
+// handler returns.
+func serve(h fasthttp.RequestHandler, w http.ResponseWriter, req *http.Request) {
+	var ctx fasthttp.RequestCtx
+	ctx.Request.Header.SetMethod(req.Method)
+	ctx.Request.SetRequestURI(req.URL.String())
+	for key, values := range req.Header {
+		for _, v := range values {
+			ctx.Request.Header.Add(key, v)
+		}
+	}
+	body, _ := io.ReadAll(req.Body)
+	ctx.Request.SetBody(body)
+	h(&ctx)
+	ctx.Response.Header.VisitAll(func(key, value []byte) {
+		w.Header().Add(string(key), string(value))
+	})
+	w.WriteHeader(ctx.Response.StatusCode())
+	w.Write(ctx.Response.Body())
+	ctx.Request.SetBody(make([]byte, len(body)))
+	ctx.Request.Reset()
+}
+
+func TestHandler(t *testing.T) {
+	eventsubtest.TestAdapter(t, func(c *twitcheventsub.Client) http.Handler {
+		h := Handler(c)
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			serve(h, w, req)
+		})
+	})
+}
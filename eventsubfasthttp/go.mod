@@ -0,0 +1,16 @@
+module github.com/Aiuzu42/go-twitch-eventsub/eventsubfasthttp
+
+go 1.23.0
+
+replace github.com/Aiuzu42/go-twitch-eventsub => ../
+
+require (
+	github.com/Aiuzu42/go-twitch-eventsub v0.0.0-00010101000000-000000000000
+	github.com/valyala/fasthttp v1.55.0
+)
+
+require (
+	github.com/andybalholm/brotli v1.1.0 // indirect
+	github.com/klauspost/compress v1.17.9 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+)
@@ -0,0 +1,21 @@
+// Package eventsubgin serves twitcheventsub webhooks with gin.
+package eventsubgin
+
+import (
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler returns a gin handler that passes webhook messages to c, register it on the path of the callback:
+//
+//	r.POST("/eventsub", eventsubgin.Handler(client))
+func Handler(c *twitcheventsub.Client) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		status, res := c.ProcessReader(ctx.Request.Context(), ctx.Request.Header, ctx.Request.Body)
+		if len(res) > 0 {
+			ctx.Data(status, "text/plain", res)
+			return
+		}
+		ctx.Status(status)
+	}
+}
@@ -0,0 +1,19 @@
+package eventsubgin
+
+import (
+	"net/http"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+	"github.com/gin-gonic/gin"
+)
+
+func TestHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	eventsubtest.TestAdapter(t, func(c *twitcheventsub.Client) http.Handler {
+		r := gin.New()
+		r.POST("/eventsub", Handler(c))
+		return r
+	})
+}
@@ -0,0 +1,16323 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: eventsub.proto
+
+package eventsubgrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscribeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Types          []string               `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	BroadcasterIds []string               `protobuf:"bytes,2,rep,name=broadcaster_ids,json=broadcasterIds,proto3" json:"broadcaster_ids,omitempty"`
+	ResumeAfter    string                 `protobuf:"bytes,3,opt,name=resume_after,json=resumeAfter,proto3" json:"resume_after,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_eventsub_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetBroadcasterIds() []string {
+	if x != nil {
+		return x.BroadcasterIds
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetResumeAfter() string {
+	if x != nil {
+		return x.ResumeAfter
+	}
+	return ""
+}
+
+type Event struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Sequence         uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	MessageId        string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	SubscriptionId   string                 `protobuf:"bytes,3,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Type             string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Version          string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	BroadcasterId    string                 `protobuf:"bytes,6,opt,name=broadcaster_id,json=broadcasterId,proto3" json:"broadcaster_id,omitempty"`
+	MessageTimestamp string                 `protobuf:"bytes,7,opt,name=message_timestamp,json=messageTimestamp,proto3" json:"message_timestamp,omitempty"`
+	ReceivedAt       string                 `protobuf:"bytes,8,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Json             string                 `protobuf:"bytes,9,opt,name=json,proto3" json:"json,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Event_AutomodMessageHold
+	//	*Event_AutomodMessageUpdate
+	//	*Event_AutomodSettingsUpdate
+	//	*Event_AutomodTermsUpdate
+	//	*Event_ChannelUpdate
+	//	*Event_ChannelFollow
+	//	*Event_ChannelAdBreakBegin
+	//	*Event_ChannelChatClear
+	//	*Event_ChannelChatClearUserMessages
+	//	*Event_ChannelChatMessage
+	//	*Event_ChannelChatMessageDelete
+	//	*Event_ChannelChatNotification
+	//	*Event_ChannelChatSettingsUpdate
+	//	*Event_ChannelChatUserMessageHold
+	//	*Event_ChannelChatUserMessageUpdate
+	//	*Event_ChannelSubscribe
+	//	*Event_ChannelSubscriptionEnd
+	//	*Event_ChannelSubscriptionGift
+	//	*Event_ChannelSubscriptionMessage
+	//	*Event_ChannelCheer
+	//	*Event_ChannelRaid
+	//	*Event_ChannelBan
+	//	*Event_ChannelUnban
+	//	*Event_ChannelUnbanRequestCreate
+	//	*Event_ChannelUnbanRequestResolve
+	//	*Event_ChannelModerate
+	//	*Event_ChannelModeratorAdd
+	//	*Event_ChannelModeratorRemove
+	//	*Event_ChannelGuestStarSessionBegin
+	//	*Event_ChannelGuestStarSessionEnd
+	//	*Event_ChannelGuestStarGuestUpdate
+	//	*Event_ChannelGuestStarSettingsUpdate
+	//	*Event_ChannelChannelPointsAutomaticRewardRedemptionAdd
+	//	*Event_ChannelChannelPointsCustomRewardAdd
+	//	*Event_ChannelChannelPointsCustomRewardUpdate
+	//	*Event_ChannelChannelPointsCustomRewardRemove
+	//	*Event_ChannelChannelPointsCustomRewardRedemptionAdd
+	//	*Event_ChannelChannelPointsCustomRewardRedemptionUpdate
+	//	*Event_ChannelPollBegin
+	//	*Event_ChannelPollProgress
+	//	*Event_ChannelPollEnd
+	//	*Event_ChannelPredictionBegin
+	//	*Event_ChannelPredictionProgress
+	//	*Event_ChannelPredictionLock
+	//	*Event_ChannelPredictionEnd
+	//	*Event_ChannelVipAdd
+	//	*Event_ChannelVipRemove
+	//	*Event_ChannelCharityCampaignDonate
+	//	*Event_ChannelCharityCampaignStart
+	//	*Event_ChannelCharityCampaignProgress
+	//	*Event_ChannelCharityCampaignStop
+	//	*Event_ConduitShardDisabled
+	//	*Event_DropEntitlementGrant
+	//	*Event_ExtensionBitsTransactionCreate
+	//	*Event_ChannelGoalBegin
+	//	*Event_ChannelGoalProgress
+	//	*Event_ChannelGoalEnd
+	//	*Event_ChannelHypeTrainBegin
+	//	*Event_ChannelHypeTrainProgress
+	//	*Event_ChannelHypeTrainEnd
+	//	*Event_ChannelShieldModeBegin
+	//	*Event_ChannelShieldModeEnd
+	//	*Event_ChannelShoutoutCreate
+	//	*Event_ChannelShoutoutReceive
+	//	*Event_StreamOnline
+	//	*Event_StreamOffline
+	//	*Event_UserAuthorizationGrant
+	//	*Event_UserAuthorizationRevoke
+	//	*Event_UserUpdate
+	//	*Event_UserWhisperMessage
+	//	*Event_ChannelSuspiciousUserUpdate
+	//	*Event_ChannelBitsUse
+	//	*Event_ChannelSuspiciousUserMessage
+	//	*Event_ChannelWarningAcknowledge
+	//	*Event_ChannelWarningSend
+	Payload       isEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_eventsub_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Event) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Event) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *Event) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Event) GetBroadcasterId() string {
+	if x != nil {
+		return x.BroadcasterId
+	}
+	return ""
+}
+
+func (x *Event) GetMessageTimestamp() string {
+	if x != nil {
+		return x.MessageTimestamp
+	}
+	return ""
+}
+
+func (x *Event) GetReceivedAt() string {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return ""
+}
+
+func (x *Event) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+func (x *Event) GetPayload() isEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetAutomodMessageHold() *AutomodMessageHoldEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_AutomodMessageHold); ok {
+			return x.AutomodMessageHold
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetAutomodMessageUpdate() *AutomodMessageUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_AutomodMessageUpdate); ok {
+			return x.AutomodMessageUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetAutomodSettingsUpdate() *AutomodSettingsUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_AutomodSettingsUpdate); ok {
+			return x.AutomodSettingsUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetAutomodTermsUpdate() *AutomodTermsUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_AutomodTermsUpdate); ok {
+			return x.AutomodTermsUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelUpdate() *ChannelUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelUpdate); ok {
+			return x.ChannelUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelFollow() *ChannelFollowEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelFollow); ok {
+			return x.ChannelFollow
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelAdBreakBegin() *ChannelAdBreakBeginEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelAdBreakBegin); ok {
+			return x.ChannelAdBreakBegin
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChatClear() *ChannelChatClearEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChatClear); ok {
+			return x.ChannelChatClear
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChatClearUserMessages() *ChannelChatClearUserMessagesEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChatClearUserMessages); ok {
+			return x.ChannelChatClearUserMessages
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChatMessage() *ChannelChatMessageEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChatMessage); ok {
+			return x.ChannelChatMessage
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChatMessageDelete() *ChannelChatMessageDeleteEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChatMessageDelete); ok {
+			return x.ChannelChatMessageDelete
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChatNotification() *ChannelChatNotificationEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChatNotification); ok {
+			return x.ChannelChatNotification
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChatSettingsUpdate() *ChannelChatSettingsUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChatSettingsUpdate); ok {
+			return x.ChannelChatSettingsUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChatUserMessageHold() *ChannelChatUserMessageHoldEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChatUserMessageHold); ok {
+			return x.ChannelChatUserMessageHold
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChatUserMessageUpdate() *ChannelChatUserMessageUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChatUserMessageUpdate); ok {
+			return x.ChannelChatUserMessageUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelSubscribe() *ChannelSubscribeEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelSubscribe); ok {
+			return x.ChannelSubscribe
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelSubscriptionEnd() *ChannelSubscriptionEndEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelSubscriptionEnd); ok {
+			return x.ChannelSubscriptionEnd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelSubscriptionGift() *ChannelSubscriptionGiftEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelSubscriptionGift); ok {
+			return x.ChannelSubscriptionGift
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelSubscriptionMessage() *ChannelSubscriptionMessageEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelSubscriptionMessage); ok {
+			return x.ChannelSubscriptionMessage
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelCheer() *ChannelCheerEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelCheer); ok {
+			return x.ChannelCheer
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelRaid() *ChannelRaidEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelRaid); ok {
+			return x.ChannelRaid
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelBan() *ChannelBanEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelBan); ok {
+			return x.ChannelBan
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelUnban() *ChannelUnbanEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelUnban); ok {
+			return x.ChannelUnban
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelUnbanRequestCreate() *ChannelUnbanRequestCreateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelUnbanRequestCreate); ok {
+			return x.ChannelUnbanRequestCreate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelUnbanRequestResolve() *ChannelUnbanRequestResolveEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelUnbanRequestResolve); ok {
+			return x.ChannelUnbanRequestResolve
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelModerate() *ChannelModerateEventV2 {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelModerate); ok {
+			return x.ChannelModerate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelModeratorAdd() *ChannelModeratorAddEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelModeratorAdd); ok {
+			return x.ChannelModeratorAdd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelModeratorRemove() *ChannelModeratorRemoveEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelModeratorRemove); ok {
+			return x.ChannelModeratorRemove
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelGuestStarSessionBegin() *ChannelGuestStarSessionBeginEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelGuestStarSessionBegin); ok {
+			return x.ChannelGuestStarSessionBegin
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelGuestStarSessionEnd() *ChannelGuestStarSessionEndEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelGuestStarSessionEnd); ok {
+			return x.ChannelGuestStarSessionEnd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelGuestStarGuestUpdate() *ChannelGuestStarGuestUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelGuestStarGuestUpdate); ok {
+			return x.ChannelGuestStarGuestUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelGuestStarSettingsUpdate() *ChannelGuestStarSettingsUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelGuestStarSettingsUpdate); ok {
+			return x.ChannelGuestStarSettingsUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChannelPointsAutomaticRewardRedemptionAdd() *ChannelPointsAutomaticRewardRedemptionAddEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChannelPointsAutomaticRewardRedemptionAdd); ok {
+			return x.ChannelChannelPointsAutomaticRewardRedemptionAdd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChannelPointsCustomRewardAdd() *ChannelPointsCustomRewardAddEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChannelPointsCustomRewardAdd); ok {
+			return x.ChannelChannelPointsCustomRewardAdd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChannelPointsCustomRewardUpdate() *ChannelPointsCustomRewardUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChannelPointsCustomRewardUpdate); ok {
+			return x.ChannelChannelPointsCustomRewardUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChannelPointsCustomRewardRemove() *ChannelPointsCustomRewardRemoveEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChannelPointsCustomRewardRemove); ok {
+			return x.ChannelChannelPointsCustomRewardRemove
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChannelPointsCustomRewardRedemptionAdd() *ChannelPointsCustomRewardRedemptionAddEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChannelPointsCustomRewardRedemptionAdd); ok {
+			return x.ChannelChannelPointsCustomRewardRedemptionAdd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelChannelPointsCustomRewardRedemptionUpdate() *ChannelPointsCustomRewardRedemptionUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelChannelPointsCustomRewardRedemptionUpdate); ok {
+			return x.ChannelChannelPointsCustomRewardRedemptionUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelPollBegin() *ChannelPollBeginEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelPollBegin); ok {
+			return x.ChannelPollBegin
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelPollProgress() *ChannelPollProgressEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelPollProgress); ok {
+			return x.ChannelPollProgress
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelPollEnd() *ChannelPollEndEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelPollEnd); ok {
+			return x.ChannelPollEnd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelPredictionBegin() *ChannelPredictionBeginEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelPredictionBegin); ok {
+			return x.ChannelPredictionBegin
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelPredictionProgress() *ChannelPredictionProgressEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelPredictionProgress); ok {
+			return x.ChannelPredictionProgress
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelPredictionLock() *ChannelPredictionLockEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelPredictionLock); ok {
+			return x.ChannelPredictionLock
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelPredictionEnd() *ChannelPredictionEndEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelPredictionEnd); ok {
+			return x.ChannelPredictionEnd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelVipAdd() *ChannelVIPAddEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelVipAdd); ok {
+			return x.ChannelVipAdd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelVipRemove() *ChannelVIPRemoveEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelVipRemove); ok {
+			return x.ChannelVipRemove
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelCharityCampaignDonate() *CharityCampaignDonateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelCharityCampaignDonate); ok {
+			return x.ChannelCharityCampaignDonate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelCharityCampaignStart() *CharityCampaignStartEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelCharityCampaignStart); ok {
+			return x.ChannelCharityCampaignStart
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelCharityCampaignProgress() *CharityCampaignProgressEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelCharityCampaignProgress); ok {
+			return x.ChannelCharityCampaignProgress
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelCharityCampaignStop() *CharityCampaignStopEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelCharityCampaignStop); ok {
+			return x.ChannelCharityCampaignStop
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetConduitShardDisabled() *ConduitShardDisabledEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ConduitShardDisabled); ok {
+			return x.ConduitShardDisabled
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetDropEntitlementGrant() *DropEntitlementGrantEvents {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_DropEntitlementGrant); ok {
+			return x.DropEntitlementGrant
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetExtensionBitsTransactionCreate() *ExtensionBitsTransactionCreateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ExtensionBitsTransactionCreate); ok {
+			return x.ExtensionBitsTransactionCreate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelGoalBegin() *ChannelGoalBeginEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelGoalBegin); ok {
+			return x.ChannelGoalBegin
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelGoalProgress() *ChannelGoalProgressEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelGoalProgress); ok {
+			return x.ChannelGoalProgress
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelGoalEnd() *ChannelGoalEndEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelGoalEnd); ok {
+			return x.ChannelGoalEnd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelHypeTrainBegin() *ChannelHypeTrainBeginEventV2 {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelHypeTrainBegin); ok {
+			return x.ChannelHypeTrainBegin
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelHypeTrainProgress() *ChannelHypeTrainProgressEventV2 {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelHypeTrainProgress); ok {
+			return x.ChannelHypeTrainProgress
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelHypeTrainEnd() *ChannelHypeTrainEndEventV2 {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelHypeTrainEnd); ok {
+			return x.ChannelHypeTrainEnd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelShieldModeBegin() *ChannelShieldModeBeginEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelShieldModeBegin); ok {
+			return x.ChannelShieldModeBegin
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelShieldModeEnd() *ChannelShieldModeEndEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelShieldModeEnd); ok {
+			return x.ChannelShieldModeEnd
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelShoutoutCreate() *ChannelShoutOutCreateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelShoutoutCreate); ok {
+			return x.ChannelShoutoutCreate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelShoutoutReceive() *ChannelShoutOutReceivedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelShoutoutReceive); ok {
+			return x.ChannelShoutoutReceive
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetStreamOnline() *StreamOnlineEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_StreamOnline); ok {
+			return x.StreamOnline
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetStreamOffline() *StreamOfflineEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_StreamOffline); ok {
+			return x.StreamOffline
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetUserAuthorizationGrant() *UserAuthorizationGrantEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_UserAuthorizationGrant); ok {
+			return x.UserAuthorizationGrant
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetUserAuthorizationRevoke() *UserAuthorizationRevokeEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_UserAuthorizationRevoke); ok {
+			return x.UserAuthorizationRevoke
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetUserUpdate() *UserUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_UserUpdate); ok {
+			return x.UserUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetUserWhisperMessage() *UserWhisperMessageEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_UserWhisperMessage); ok {
+			return x.UserWhisperMessage
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelSuspiciousUserUpdate() *ChannelSuspiciousUserUpdateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelSuspiciousUserUpdate); ok {
+			return x.ChannelSuspiciousUserUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelBitsUse() *ChannelBitsUseEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelBitsUse); ok {
+			return x.ChannelBitsUse
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelSuspiciousUserMessage() *ChannelSuspiciousUserMessageEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelSuspiciousUserMessage); ok {
+			return x.ChannelSuspiciousUserMessage
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelWarningAcknowledge() *ChannelWarningAcknowledgeEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelWarningAcknowledge); ok {
+			return x.ChannelWarningAcknowledge
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChannelWarningSend() *ChannelWarningSendEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChannelWarningSend); ok {
+			return x.ChannelWarningSend
+		}
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_AutomodMessageHold struct {
+	AutomodMessageHold *AutomodMessageHoldEvent `protobuf:"bytes,100,opt,name=automod_message_hold,json=automodMessageHold,proto3,oneof"`
+}
+
+type Event_AutomodMessageUpdate struct {
+	AutomodMessageUpdate *AutomodMessageUpdateEvent `protobuf:"bytes,101,opt,name=automod_message_update,json=automodMessageUpdate,proto3,oneof"`
+}
+
+type Event_AutomodSettingsUpdate struct {
+	AutomodSettingsUpdate *AutomodSettingsUpdateEvent `protobuf:"bytes,102,opt,name=automod_settings_update,json=automodSettingsUpdate,proto3,oneof"`
+}
+
+type Event_AutomodTermsUpdate struct {
+	AutomodTermsUpdate *AutomodTermsUpdateEvent `protobuf:"bytes,103,opt,name=automod_terms_update,json=automodTermsUpdate,proto3,oneof"`
+}
+
+type Event_ChannelUpdate struct {
+	ChannelUpdate *ChannelUpdateEvent `protobuf:"bytes,104,opt,name=channel_update,json=channelUpdate,proto3,oneof"`
+}
+
+type Event_ChannelFollow struct {
+	ChannelFollow *ChannelFollowEvent `protobuf:"bytes,105,opt,name=channel_follow,json=channelFollow,proto3,oneof"`
+}
+
+type Event_ChannelAdBreakBegin struct {
+	ChannelAdBreakBegin *ChannelAdBreakBeginEvent `protobuf:"bytes,106,opt,name=channel_ad_break_begin,json=channelAdBreakBegin,proto3,oneof"`
+}
+
+type Event_ChannelChatClear struct {
+	ChannelChatClear *ChannelChatClearEvent `protobuf:"bytes,107,opt,name=channel_chat_clear,json=channelChatClear,proto3,oneof"`
+}
+
+type Event_ChannelChatClearUserMessages struct {
+	ChannelChatClearUserMessages *ChannelChatClearUserMessagesEvent `protobuf:"bytes,108,opt,name=channel_chat_clear_user_messages,json=channelChatClearUserMessages,proto3,oneof"`
+}
+
+type Event_ChannelChatMessage struct {
+	ChannelChatMessage *ChannelChatMessageEvent `protobuf:"bytes,109,opt,name=channel_chat_message,json=channelChatMessage,proto3,oneof"`
+}
+
+type Event_ChannelChatMessageDelete struct {
+	ChannelChatMessageDelete *ChannelChatMessageDeleteEvent `protobuf:"bytes,110,opt,name=channel_chat_message_delete,json=channelChatMessageDelete,proto3,oneof"`
+}
+
+type Event_ChannelChatNotification struct {
+	ChannelChatNotification *ChannelChatNotificationEvent `protobuf:"bytes,111,opt,name=channel_chat_notification,json=channelChatNotification,proto3,oneof"`
+}
+
+type Event_ChannelChatSettingsUpdate struct {
+	ChannelChatSettingsUpdate *ChannelChatSettingsUpdateEvent `protobuf:"bytes,112,opt,name=channel_chat_settings_update,json=channelChatSettingsUpdate,proto3,oneof"`
+}
+
+type Event_ChannelChatUserMessageHold struct {
+	ChannelChatUserMessageHold *ChannelChatUserMessageHoldEvent `protobuf:"bytes,113,opt,name=channel_chat_user_message_hold,json=channelChatUserMessageHold,proto3,oneof"`
+}
+
+type Event_ChannelChatUserMessageUpdate struct {
+	ChannelChatUserMessageUpdate *ChannelChatUserMessageUpdateEvent `protobuf:"bytes,114,opt,name=channel_chat_user_message_update,json=channelChatUserMessageUpdate,proto3,oneof"`
+}
+
+type Event_ChannelSubscribe struct {
+	ChannelSubscribe *ChannelSubscribeEvent `protobuf:"bytes,115,opt,name=channel_subscribe,json=channelSubscribe,proto3,oneof"`
+}
+
+type Event_ChannelSubscriptionEnd struct {
+	ChannelSubscriptionEnd *ChannelSubscriptionEndEvent `protobuf:"bytes,116,opt,name=channel_subscription_end,json=channelSubscriptionEnd,proto3,oneof"`
+}
+
+type Event_ChannelSubscriptionGift struct {
+	ChannelSubscriptionGift *ChannelSubscriptionGiftEvent `protobuf:"bytes,117,opt,name=channel_subscription_gift,json=channelSubscriptionGift,proto3,oneof"`
+}
+
+type Event_ChannelSubscriptionMessage struct {
+	ChannelSubscriptionMessage *ChannelSubscriptionMessageEvent `protobuf:"bytes,118,opt,name=channel_subscription_message,json=channelSubscriptionMessage,proto3,oneof"`
+}
+
+type Event_ChannelCheer struct {
+	ChannelCheer *ChannelCheerEvent `protobuf:"bytes,119,opt,name=channel_cheer,json=channelCheer,proto3,oneof"`
+}
+
+type Event_ChannelRaid struct {
+	ChannelRaid *ChannelRaidEvent `protobuf:"bytes,120,opt,name=channel_raid,json=channelRaid,proto3,oneof"`
+}
+
+type Event_ChannelBan struct {
+	ChannelBan *ChannelBanEvent `protobuf:"bytes,121,opt,name=channel_ban,json=channelBan,proto3,oneof"`
+}
+
+type Event_ChannelUnban struct {
+	ChannelUnban *ChannelUnbanEvent `protobuf:"bytes,122,opt,name=channel_unban,json=channelUnban,proto3,oneof"`
+}
+
+type Event_ChannelUnbanRequestCreate struct {
+	ChannelUnbanRequestCreate *ChannelUnbanRequestCreateEvent `protobuf:"bytes,123,opt,name=channel_unban_request_create,json=channelUnbanRequestCreate,proto3,oneof"`
+}
+
+type Event_ChannelUnbanRequestResolve struct {
+	ChannelUnbanRequestResolve *ChannelUnbanRequestResolveEvent `protobuf:"bytes,124,opt,name=channel_unban_request_resolve,json=channelUnbanRequestResolve,proto3,oneof"`
+}
+
+type Event_ChannelModerate struct {
+	ChannelModerate *ChannelModerateEventV2 `protobuf:"bytes,125,opt,name=channel_moderate,json=channelModerate,proto3,oneof"`
+}
+
+type Event_ChannelModeratorAdd struct {
+	ChannelModeratorAdd *ChannelModeratorAddEvent `protobuf:"bytes,126,opt,name=channel_moderator_add,json=channelModeratorAdd,proto3,oneof"`
+}
+
+type Event_ChannelModeratorRemove struct {
+	ChannelModeratorRemove *ChannelModeratorRemoveEvent `protobuf:"bytes,127,opt,name=channel_moderator_remove,json=channelModeratorRemove,proto3,oneof"`
+}
+
+type Event_ChannelGuestStarSessionBegin struct {
+	ChannelGuestStarSessionBegin *ChannelGuestStarSessionBeginEvent `protobuf:"bytes,128,opt,name=channel_guest_star_session_begin,json=channelGuestStarSessionBegin,proto3,oneof"`
+}
+
+type Event_ChannelGuestStarSessionEnd struct {
+	ChannelGuestStarSessionEnd *ChannelGuestStarSessionEndEvent `protobuf:"bytes,129,opt,name=channel_guest_star_session_end,json=channelGuestStarSessionEnd,proto3,oneof"`
+}
+
+type Event_ChannelGuestStarGuestUpdate struct {
+	ChannelGuestStarGuestUpdate *ChannelGuestStarGuestUpdateEvent `protobuf:"bytes,130,opt,name=channel_guest_star_guest_update,json=channelGuestStarGuestUpdate,proto3,oneof"`
+}
+
+type Event_ChannelGuestStarSettingsUpdate struct {
+	ChannelGuestStarSettingsUpdate *ChannelGuestStarSettingsUpdateEvent `protobuf:"bytes,131,opt,name=channel_guest_star_settings_update,json=channelGuestStarSettingsUpdate,proto3,oneof"`
+}
+
+type Event_ChannelChannelPointsAutomaticRewardRedemptionAdd struct {
+	ChannelChannelPointsAutomaticRewardRedemptionAdd *ChannelPointsAutomaticRewardRedemptionAddEvent `protobuf:"bytes,132,opt,name=channel_channel_points_automatic_reward_redemption_add,json=channelChannelPointsAutomaticRewardRedemptionAdd,proto3,oneof"`
+}
+
+type Event_ChannelChannelPointsCustomRewardAdd struct {
+	ChannelChannelPointsCustomRewardAdd *ChannelPointsCustomRewardAddEvent `protobuf:"bytes,133,opt,name=channel_channel_points_custom_reward_add,json=channelChannelPointsCustomRewardAdd,proto3,oneof"`
+}
+
+type Event_ChannelChannelPointsCustomRewardUpdate struct {
+	ChannelChannelPointsCustomRewardUpdate *ChannelPointsCustomRewardUpdateEvent `protobuf:"bytes,134,opt,name=channel_channel_points_custom_reward_update,json=channelChannelPointsCustomRewardUpdate,proto3,oneof"`
+}
+
+type Event_ChannelChannelPointsCustomRewardRemove struct {
+	ChannelChannelPointsCustomRewardRemove *ChannelPointsCustomRewardRemoveEvent `protobuf:"bytes,135,opt,name=channel_channel_points_custom_reward_remove,json=channelChannelPointsCustomRewardRemove,proto3,oneof"`
+}
+
+type Event_ChannelChannelPointsCustomRewardRedemptionAdd struct {
+	ChannelChannelPointsCustomRewardRedemptionAdd *ChannelPointsCustomRewardRedemptionAddEvent `protobuf:"bytes,136,opt,name=channel_channel_points_custom_reward_redemption_add,json=channelChannelPointsCustomRewardRedemptionAdd,proto3,oneof"`
+}
+
+type Event_ChannelChannelPointsCustomRewardRedemptionUpdate struct {
+	ChannelChannelPointsCustomRewardRedemptionUpdate *ChannelPointsCustomRewardRedemptionUpdateEvent `protobuf:"bytes,137,opt,name=channel_channel_points_custom_reward_redemption_update,json=channelChannelPointsCustomRewardRedemptionUpdate,proto3,oneof"`
+}
+
+type Event_ChannelPollBegin struct {
+	ChannelPollBegin *ChannelPollBeginEvent `protobuf:"bytes,138,opt,name=channel_poll_begin,json=channelPollBegin,proto3,oneof"`
+}
+
+type Event_ChannelPollProgress struct {
+	ChannelPollProgress *ChannelPollProgressEvent `protobuf:"bytes,139,opt,name=channel_poll_progress,json=channelPollProgress,proto3,oneof"`
+}
+
+type Event_ChannelPollEnd struct {
+	ChannelPollEnd *ChannelPollEndEvent `protobuf:"bytes,140,opt,name=channel_poll_end,json=channelPollEnd,proto3,oneof"`
+}
+
+type Event_ChannelPredictionBegin struct {
+	ChannelPredictionBegin *ChannelPredictionBeginEvent `protobuf:"bytes,141,opt,name=channel_prediction_begin,json=channelPredictionBegin,proto3,oneof"`
+}
+
+type Event_ChannelPredictionProgress struct {
+	ChannelPredictionProgress *ChannelPredictionProgressEvent `protobuf:"bytes,142,opt,name=channel_prediction_progress,json=channelPredictionProgress,proto3,oneof"`
+}
+
+type Event_ChannelPredictionLock struct {
+	ChannelPredictionLock *ChannelPredictionLockEvent `protobuf:"bytes,143,opt,name=channel_prediction_lock,json=channelPredictionLock,proto3,oneof"`
+}
+
+type Event_ChannelPredictionEnd struct {
+	ChannelPredictionEnd *ChannelPredictionEndEvent `protobuf:"bytes,144,opt,name=channel_prediction_end,json=channelPredictionEnd,proto3,oneof"`
+}
+
+type Event_ChannelVipAdd struct {
+	ChannelVipAdd *ChannelVIPAddEvent `protobuf:"bytes,145,opt,name=channel_vip_add,json=channelVipAdd,proto3,oneof"`
+}
+
+type Event_ChannelVipRemove struct {
+	ChannelVipRemove *ChannelVIPRemoveEvent `protobuf:"bytes,146,opt,name=channel_vip_remove,json=channelVipRemove,proto3,oneof"`
+}
+
+type Event_ChannelCharityCampaignDonate struct {
+	ChannelCharityCampaignDonate *CharityCampaignDonateEvent `protobuf:"bytes,147,opt,name=channel_charity_campaign_donate,json=channelCharityCampaignDonate,proto3,oneof"`
+}
+
+type Event_ChannelCharityCampaignStart struct {
+	ChannelCharityCampaignStart *CharityCampaignStartEvent `protobuf:"bytes,148,opt,name=channel_charity_campaign_start,json=channelCharityCampaignStart,proto3,oneof"`
+}
+
+type Event_ChannelCharityCampaignProgress struct {
+	ChannelCharityCampaignProgress *CharityCampaignProgressEvent `protobuf:"bytes,149,opt,name=channel_charity_campaign_progress,json=channelCharityCampaignProgress,proto3,oneof"`
+}
+
+type Event_ChannelCharityCampaignStop struct {
+	ChannelCharityCampaignStop *CharityCampaignStopEvent `protobuf:"bytes,150,opt,name=channel_charity_campaign_stop,json=channelCharityCampaignStop,proto3,oneof"`
+}
+
+type Event_ConduitShardDisabled struct {
+	ConduitShardDisabled *ConduitShardDisabledEvent `protobuf:"bytes,151,opt,name=conduit_shard_disabled,json=conduitShardDisabled,proto3,oneof"`
+}
+
+type Event_DropEntitlementGrant struct {
+	DropEntitlementGrant *DropEntitlementGrantEvents `protobuf:"bytes,152,opt,name=drop_entitlement_grant,json=dropEntitlementGrant,proto3,oneof"`
+}
+
+type Event_ExtensionBitsTransactionCreate struct {
+	ExtensionBitsTransactionCreate *ExtensionBitsTransactionCreateEvent `protobuf:"bytes,153,opt,name=extension_bits_transaction_create,json=extensionBitsTransactionCreate,proto3,oneof"`
+}
+
+type Event_ChannelGoalBegin struct {
+	ChannelGoalBegin *ChannelGoalBeginEvent `protobuf:"bytes,154,opt,name=channel_goal_begin,json=channelGoalBegin,proto3,oneof"`
+}
+
+type Event_ChannelGoalProgress struct {
+	ChannelGoalProgress *ChannelGoalProgressEvent `protobuf:"bytes,155,opt,name=channel_goal_progress,json=channelGoalProgress,proto3,oneof"`
+}
+
+type Event_ChannelGoalEnd struct {
+	ChannelGoalEnd *ChannelGoalEndEvent `protobuf:"bytes,156,opt,name=channel_goal_end,json=channelGoalEnd,proto3,oneof"`
+}
+
+type Event_ChannelHypeTrainBegin struct {
+	ChannelHypeTrainBegin *ChannelHypeTrainBeginEventV2 `protobuf:"bytes,157,opt,name=channel_hype_train_begin,json=channelHypeTrainBegin,proto3,oneof"`
+}
+
+type Event_ChannelHypeTrainProgress struct {
+	ChannelHypeTrainProgress *ChannelHypeTrainProgressEventV2 `protobuf:"bytes,158,opt,name=channel_hype_train_progress,json=channelHypeTrainProgress,proto3,oneof"`
+}
+
+type Event_ChannelHypeTrainEnd struct {
+	ChannelHypeTrainEnd *ChannelHypeTrainEndEventV2 `protobuf:"bytes,159,opt,name=channel_hype_train_end,json=channelHypeTrainEnd,proto3,oneof"`
+}
+
+type Event_ChannelShieldModeBegin struct {
+	ChannelShieldModeBegin *ChannelShieldModeBeginEvent `protobuf:"bytes,160,opt,name=channel_shield_mode_begin,json=channelShieldModeBegin,proto3,oneof"`
+}
+
+type Event_ChannelShieldModeEnd struct {
+	ChannelShieldModeEnd *ChannelShieldModeEndEvent `protobuf:"bytes,161,opt,name=channel_shield_mode_end,json=channelShieldModeEnd,proto3,oneof"`
+}
+
+type Event_ChannelShoutoutCreate struct {
+	ChannelShoutoutCreate *ChannelShoutOutCreateEvent `protobuf:"bytes,162,opt,name=channel_shoutout_create,json=channelShoutoutCreate,proto3,oneof"`
+}
+
+type Event_ChannelShoutoutReceive struct {
+	ChannelShoutoutReceive *ChannelShoutOutReceivedEvent `protobuf:"bytes,163,opt,name=channel_shoutout_receive,json=channelShoutoutReceive,proto3,oneof"`
+}
+
+type Event_StreamOnline struct {
+	StreamOnline *StreamOnlineEvent `protobuf:"bytes,164,opt,name=stream_online,json=streamOnline,proto3,oneof"`
+}
+
+type Event_StreamOffline struct {
+	StreamOffline *StreamOfflineEvent `protobuf:"bytes,165,opt,name=stream_offline,json=streamOffline,proto3,oneof"`
+}
+
+type Event_UserAuthorizationGrant struct {
+	UserAuthorizationGrant *UserAuthorizationGrantEvent `protobuf:"bytes,166,opt,name=user_authorization_grant,json=userAuthorizationGrant,proto3,oneof"`
+}
+
+type Event_UserAuthorizationRevoke struct {
+	UserAuthorizationRevoke *UserAuthorizationRevokeEvent `protobuf:"bytes,167,opt,name=user_authorization_revoke,json=userAuthorizationRevoke,proto3,oneof"`
+}
+
+type Event_UserUpdate struct {
+	UserUpdate *UserUpdateEvent `protobuf:"bytes,168,opt,name=user_update,json=userUpdate,proto3,oneof"`
+}
+
+type Event_UserWhisperMessage struct {
+	UserWhisperMessage *UserWhisperMessageEvent `protobuf:"bytes,169,opt,name=user_whisper_message,json=userWhisperMessage,proto3,oneof"`
+}
+
+type Event_ChannelSuspiciousUserUpdate struct {
+	ChannelSuspiciousUserUpdate *ChannelSuspiciousUserUpdateEvent `protobuf:"bytes,170,opt,name=channel_suspicious_user_update,json=channelSuspiciousUserUpdate,proto3,oneof"`
+}
+
+type Event_ChannelBitsUse struct {
+	ChannelBitsUse *ChannelBitsUseEvent `protobuf:"bytes,171,opt,name=channel_bits_use,json=channelBitsUse,proto3,oneof"`
+}
+
+type Event_ChannelSuspiciousUserMessage struct {
+	ChannelSuspiciousUserMessage *ChannelSuspiciousUserMessageEvent `protobuf:"bytes,172,opt,name=channel_suspicious_user_message,json=channelSuspiciousUserMessage,proto3,oneof"`
+}
+
+type Event_ChannelWarningAcknowledge struct {
+	ChannelWarningAcknowledge *ChannelWarningAcknowledgeEvent `protobuf:"bytes,173,opt,name=channel_warning_acknowledge,json=channelWarningAcknowledge,proto3,oneof"`
+}
+
+type Event_ChannelWarningSend struct {
+	ChannelWarningSend *ChannelWarningSendEvent `protobuf:"bytes,174,opt,name=channel_warning_send,json=channelWarningSend,proto3,oneof"`
+}
+
+func (*Event_AutomodMessageHold) isEvent_Payload() {}
+
+func (*Event_AutomodMessageUpdate) isEvent_Payload() {}
+
+func (*Event_AutomodSettingsUpdate) isEvent_Payload() {}
+
+func (*Event_AutomodTermsUpdate) isEvent_Payload() {}
+
+func (*Event_ChannelUpdate) isEvent_Payload() {}
+
+func (*Event_ChannelFollow) isEvent_Payload() {}
+
+func (*Event_ChannelAdBreakBegin) isEvent_Payload() {}
+
+func (*Event_ChannelChatClear) isEvent_Payload() {}
+
+func (*Event_ChannelChatClearUserMessages) isEvent_Payload() {}
+
+func (*Event_ChannelChatMessage) isEvent_Payload() {}
+
+func (*Event_ChannelChatMessageDelete) isEvent_Payload() {}
+
+func (*Event_ChannelChatNotification) isEvent_Payload() {}
+
+func (*Event_ChannelChatSettingsUpdate) isEvent_Payload() {}
+
+func (*Event_ChannelChatUserMessageHold) isEvent_Payload() {}
+
+func (*Event_ChannelChatUserMessageUpdate) isEvent_Payload() {}
+
+func (*Event_ChannelSubscribe) isEvent_Payload() {}
+
+func (*Event_ChannelSubscriptionEnd) isEvent_Payload() {}
+
+func (*Event_ChannelSubscriptionGift) isEvent_Payload() {}
+
+func (*Event_ChannelSubscriptionMessage) isEvent_Payload() {}
+
+func (*Event_ChannelCheer) isEvent_Payload() {}
+
+func (*Event_ChannelRaid) isEvent_Payload() {}
+
+func (*Event_ChannelBan) isEvent_Payload() {}
+
+func (*Event_ChannelUnban) isEvent_Payload() {}
+
+func (*Event_ChannelUnbanRequestCreate) isEvent_Payload() {}
+
+func (*Event_ChannelUnbanRequestResolve) isEvent_Payload() {}
+
+func (*Event_ChannelModerate) isEvent_Payload() {}
+
+func (*Event_ChannelModeratorAdd) isEvent_Payload() {}
+
+func (*Event_ChannelModeratorRemove) isEvent_Payload() {}
+
+func (*Event_ChannelGuestStarSessionBegin) isEvent_Payload() {}
+
+func (*Event_ChannelGuestStarSessionEnd) isEvent_Payload() {}
+
+func (*Event_ChannelGuestStarGuestUpdate) isEvent_Payload() {}
+
+func (*Event_ChannelGuestStarSettingsUpdate) isEvent_Payload() {}
+
+func (*Event_ChannelChannelPointsAutomaticRewardRedemptionAdd) isEvent_Payload() {}
+
+func (*Event_ChannelChannelPointsCustomRewardAdd) isEvent_Payload() {}
+
+func (*Event_ChannelChannelPointsCustomRewardUpdate) isEvent_Payload() {}
+
+func (*Event_ChannelChannelPointsCustomRewardRemove) isEvent_Payload() {}
+
+func (*Event_ChannelChannelPointsCustomRewardRedemptionAdd) isEvent_Payload() {}
+
+func (*Event_ChannelChannelPointsCustomRewardRedemptionUpdate) isEvent_Payload() {}
+
+func (*Event_ChannelPollBegin) isEvent_Payload() {}
+
+func (*Event_ChannelPollProgress) isEvent_Payload() {}
+
+func (*Event_ChannelPollEnd) isEvent_Payload() {}
+
+func (*Event_ChannelPredictionBegin) isEvent_Payload() {}
+
+func (*Event_ChannelPredictionProgress) isEvent_Payload() {}
+
+func (*Event_ChannelPredictionLock) isEvent_Payload() {}
+
+func (*Event_ChannelPredictionEnd) isEvent_Payload() {}
+
+func (*Event_ChannelVipAdd) isEvent_Payload() {}
+
+func (*Event_ChannelVipRemove) isEvent_Payload() {}
+
+func (*Event_ChannelCharityCampaignDonate) isEvent_Payload() {}
+
+func (*Event_ChannelCharityCampaignStart) isEvent_Payload() {}
+
+func (*Event_ChannelCharityCampaignProgress) isEvent_Payload() {}
+
+func (*Event_ChannelCharityCampaignStop) isEvent_Payload() {}
+
+func (*Event_ConduitShardDisabled) isEvent_Payload() {}
+
+func (*Event_DropEntitlementGrant) isEvent_Payload() {}
+
+func (*Event_ExtensionBitsTransactionCreate) isEvent_Payload() {}
+
+func (*Event_ChannelGoalBegin) isEvent_Payload() {}
+
+func (*Event_ChannelGoalProgress) isEvent_Payload() {}
+
+func (*Event_ChannelGoalEnd) isEvent_Payload() {}
+
+func (*Event_ChannelHypeTrainBegin) isEvent_Payload() {}
+
+func (*Event_ChannelHypeTrainProgress) isEvent_Payload() {}
+
+func (*Event_ChannelHypeTrainEnd) isEvent_Payload() {}
+
+func (*Event_ChannelShieldModeBegin) isEvent_Payload() {}
+
+func (*Event_ChannelShieldModeEnd) isEvent_Payload() {}
+
+func (*Event_ChannelShoutoutCreate) isEvent_Payload() {}
+
+func (*Event_ChannelShoutoutReceive) isEvent_Payload() {}
+
+func (*Event_StreamOnline) isEvent_Payload() {}
+
+func (*Event_StreamOffline) isEvent_Payload() {}
+
+func (*Event_UserAuthorizationGrant) isEvent_Payload() {}
+
+func (*Event_UserAuthorizationRevoke) isEvent_Payload() {}
+
+func (*Event_UserUpdate) isEvent_Payload() {}
+
+func (*Event_UserWhisperMessage) isEvent_Payload() {}
+
+func (*Event_ChannelSuspiciousUserUpdate) isEvent_Payload() {}
+
+func (*Event_ChannelBitsUse) isEvent_Payload() {}
+
+func (*Event_ChannelSuspiciousUserMessage) isEvent_Payload() {}
+
+func (*Event_ChannelWarningAcknowledge) isEvent_Payload() {}
+
+func (*Event_ChannelWarningSend) isEvent_Payload() {}
+
+type DropEntitlementGrantEvents struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Events        []*DropEntitlementGrantEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropEntitlementGrantEvents) Reset() {
+	*x = DropEntitlementGrantEvents{}
+	mi := &file_eventsub_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropEntitlementGrantEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropEntitlementGrantEvents) ProtoMessage() {}
+
+func (x *DropEntitlementGrantEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropEntitlementGrantEvents.ProtoReflect.Descriptor instead.
+func (*DropEntitlementGrantEvents) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DropEntitlementGrantEvents) GetEvents() []*DropEntitlementGrantEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AutomodMessageHoldEvent struct {
+	state                protoimpl.MessageState     `protogen:"open.v1"`
+	BroadcasterUserId    string                     `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                     `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                     `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	UserId               string                     `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName             string                     `protobuf:"bytes,5,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserLogin            string                     `protobuf:"bytes,6,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	MessageId            string                     `protobuf:"bytes,7,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Message              *AutomodMessageHoldMessage `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Level                int64                      `protobuf:"varint,9,opt,name=level,proto3" json:"level,omitempty"`
+	Category             string                     `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	HeldAt               string                     `protobuf:"bytes,11,opt,name=held_at,json=heldAt,proto3" json:"held_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AutomodMessageHoldEvent) Reset() {
+	*x = AutomodMessageHoldEvent{}
+	mi := &file_eventsub_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutomodMessageHoldEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodMessageHoldEvent) ProtoMessage() {}
+
+func (x *AutomodMessageHoldEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodMessageHoldEvent.ProtoReflect.Descriptor instead.
+func (*AutomodMessageHoldEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AutomodMessageHoldEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *AutomodMessageHoldEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *AutomodMessageHoldEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *AutomodMessageHoldEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AutomodMessageHoldEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *AutomodMessageHoldEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *AutomodMessageHoldEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *AutomodMessageHoldEvent) GetMessage() *AutomodMessageHoldMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *AutomodMessageHoldEvent) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AutomodMessageHoldEvent) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AutomodMessageHoldEvent) GetHeldAt() string {
+	if x != nil {
+		return x.HeldAt
+	}
+	return ""
+}
+
+type AutomodMessageUpdateEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	UserId               string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName             string                 `protobuf:"bytes,5,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,6,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,7,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,8,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,9,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	MessageId            string                 `protobuf:"bytes,10,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Message              string                 `protobuf:"bytes,11,opt,name=message,proto3" json:"message,omitempty"`
+	Level                int64                  `protobuf:"varint,12,opt,name=level,proto3" json:"level,omitempty"`
+	Category             string                 `protobuf:"bytes,13,opt,name=category,proto3" json:"category,omitempty"`
+	Status               string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	HeldAt               string                 `protobuf:"bytes,15,opt,name=held_at,json=heldAt,proto3" json:"held_at,omitempty"`
+	Fragments            *Fragment              `protobuf:"bytes,16,opt,name=fragments,proto3" json:"fragments,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AutomodMessageUpdateEvent) Reset() {
+	*x = AutomodMessageUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutomodMessageUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodMessageUpdateEvent) ProtoMessage() {}
+
+func (x *AutomodMessageUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodMessageUpdateEvent.ProtoReflect.Descriptor instead.
+func (*AutomodMessageUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AutomodMessageUpdateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AutomodMessageUpdateEvent) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetHeldAt() string {
+	if x != nil {
+		return x.HeldAt
+	}
+	return ""
+}
+
+func (x *AutomodMessageUpdateEvent) GetFragments() *Fragment {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+type AutomodSettingsUpdateEvent struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Data          []*AutomodSettingsUpdateEventData `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutomodSettingsUpdateEvent) Reset() {
+	*x = AutomodSettingsUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutomodSettingsUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodSettingsUpdateEvent) ProtoMessage() {}
+
+func (x *AutomodSettingsUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodSettingsUpdateEvent.ProtoReflect.Descriptor instead.
+func (*AutomodSettingsUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AutomodSettingsUpdateEvent) GetData() []*AutomodSettingsUpdateEventData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AutomodTermsUpdateEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,4,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,5,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,6,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	Action               string                 `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	FromAutomod          bool                   `protobuf:"varint,8,opt,name=from_automod,json=fromAutomod,proto3" json:"from_automod,omitempty"`
+	Terms                []string               `protobuf:"bytes,9,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AutomodTermsUpdateEvent) Reset() {
+	*x = AutomodTermsUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutomodTermsUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodTermsUpdateEvent) ProtoMessage() {}
+
+func (x *AutomodTermsUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodTermsUpdateEvent.ProtoReflect.Descriptor instead.
+func (*AutomodTermsUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AutomodTermsUpdateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *AutomodTermsUpdateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *AutomodTermsUpdateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *AutomodTermsUpdateEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *AutomodTermsUpdateEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *AutomodTermsUpdateEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *AutomodTermsUpdateEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AutomodTermsUpdateEvent) GetFromAutomod() bool {
+	if x != nil {
+		return x.FromAutomod
+	}
+	return false
+}
+
+func (x *AutomodTermsUpdateEvent) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type ChannelUpdateEvent struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId           string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin        string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName         string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Title                       string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Language                    string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	CategoryId                  string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName                string                 `protobuf:"bytes,7,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	ContentClassificationLabels []string               `protobuf:"bytes,8,rep,name=content_classification_labels,json=contentClassificationLabels,proto3" json:"content_classification_labels,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ChannelUpdateEvent) Reset() {
+	*x = ChannelUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelUpdateEvent) ProtoMessage() {}
+
+func (x *ChannelUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChannelUpdateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelUpdateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelUpdateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelUpdateEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelUpdateEvent) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ChannelUpdateEvent) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ChannelUpdateEvent) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *ChannelUpdateEvent) GetContentClassificationLabels() []string {
+	if x != nil {
+		return x.ContentClassificationLabels
+	}
+	return nil
+}
+
+type ChannelFollowEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	FollowedAt           string                 `protobuf:"bytes,7,opt,name=followed_at,json=followedAt,proto3" json:"followed_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelFollowEvent) Reset() {
+	*x = ChannelFollowEvent{}
+	mi := &file_eventsub_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelFollowEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelFollowEvent) ProtoMessage() {}
+
+func (x *ChannelFollowEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelFollowEvent.ProtoReflect.Descriptor instead.
+func (*ChannelFollowEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChannelFollowEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelFollowEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelFollowEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelFollowEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelFollowEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelFollowEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelFollowEvent) GetFollowedAt() string {
+	if x != nil {
+		return x.FollowedAt
+	}
+	return ""
+}
+
+type ChannelAdBreakBeginEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DurationSeconds      string                 `protobuf:"bytes,1,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	IsAutomatic          string                 `protobuf:"bytes,3,opt,name=is_automatic,json=isAutomatic,proto3" json:"is_automatic,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	RequesterUserId      string                 `protobuf:"bytes,7,opt,name=requester_user_id,json=requesterUserId,proto3" json:"requester_user_id,omitempty"`
+	RequesterUserLogin   string                 `protobuf:"bytes,8,opt,name=requester_user_login,json=requesterUserLogin,proto3" json:"requester_user_login,omitempty"`
+	RequesterUserName    string                 `protobuf:"bytes,9,opt,name=requester_user_name,json=requesterUserName,proto3" json:"requester_user_name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelAdBreakBeginEvent) Reset() {
+	*x = ChannelAdBreakBeginEvent{}
+	mi := &file_eventsub_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelAdBreakBeginEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelAdBreakBeginEvent) ProtoMessage() {}
+
+func (x *ChannelAdBreakBeginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelAdBreakBeginEvent.ProtoReflect.Descriptor instead.
+func (*ChannelAdBreakBeginEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChannelAdBreakBeginEvent) GetDurationSeconds() string {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return ""
+}
+
+func (x *ChannelAdBreakBeginEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelAdBreakBeginEvent) GetIsAutomatic() string {
+	if x != nil {
+		return x.IsAutomatic
+	}
+	return ""
+}
+
+func (x *ChannelAdBreakBeginEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelAdBreakBeginEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelAdBreakBeginEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelAdBreakBeginEvent) GetRequesterUserId() string {
+	if x != nil {
+		return x.RequesterUserId
+	}
+	return ""
+}
+
+func (x *ChannelAdBreakBeginEvent) GetRequesterUserLogin() string {
+	if x != nil {
+		return x.RequesterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelAdBreakBeginEvent) GetRequesterUserName() string {
+	if x != nil {
+		return x.RequesterUserName
+	}
+	return ""
+}
+
+type ChannelChatClearEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelChatClearEvent) Reset() {
+	*x = ChannelChatClearEvent{}
+	mi := &file_eventsub_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelChatClearEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelChatClearEvent) ProtoMessage() {}
+
+func (x *ChannelChatClearEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelChatClearEvent.ProtoReflect.Descriptor instead.
+func (*ChannelChatClearEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChannelChatClearEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatClearEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatClearEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+type ChannelChatClearUserMessagesEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	TargetUserId         string                 `protobuf:"bytes,4,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	TargetUserName       string                 `protobuf:"bytes,5,opt,name=target_user_name,json=targetUserName,proto3" json:"target_user_name,omitempty"`
+	TargetUserLogin      string                 `protobuf:"bytes,6,opt,name=target_user_login,json=targetUserLogin,proto3" json:"target_user_login,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelChatClearUserMessagesEvent) Reset() {
+	*x = ChannelChatClearUserMessagesEvent{}
+	mi := &file_eventsub_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelChatClearUserMessagesEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelChatClearUserMessagesEvent) ProtoMessage() {}
+
+func (x *ChannelChatClearUserMessagesEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelChatClearUserMessagesEvent.ProtoReflect.Descriptor instead.
+func (*ChannelChatClearUserMessagesEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChannelChatClearUserMessagesEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatClearUserMessagesEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatClearUserMessagesEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatClearUserMessagesEvent) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatClearUserMessagesEvent) GetTargetUserName() string {
+	if x != nil {
+		return x.TargetUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatClearUserMessagesEvent) GetTargetUserLogin() string {
+	if x != nil {
+		return x.TargetUserLogin
+	}
+	return ""
+}
+
+type ChannelChatMessageEvent struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId           string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin        string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName         string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	ChatterUserId               string                 `protobuf:"bytes,4,opt,name=chatter_user_id,json=chatterUserId,proto3" json:"chatter_user_id,omitempty"`
+	ChatterUserLogin            string                 `protobuf:"bytes,5,opt,name=chatter_user_login,json=chatterUserLogin,proto3" json:"chatter_user_login,omitempty"`
+	ChatterUserName             string                 `protobuf:"bytes,6,opt,name=chatter_user_name,json=chatterUserName,proto3" json:"chatter_user_name,omitempty"`
+	MessageId                   string                 `protobuf:"bytes,7,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Message                     *Message               `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Color                       string                 `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+	Badges                      []*Badges              `protobuf:"bytes,10,rep,name=badges,proto3" json:"badges,omitempty"`
+	MessageType                 string                 `protobuf:"bytes,11,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+	Cheer                       *MessageCheer          `protobuf:"bytes,12,opt,name=cheer,proto3" json:"cheer,omitempty"`
+	Reply                       *Reply                 `protobuf:"bytes,13,opt,name=reply,proto3" json:"reply,omitempty"`
+	ChannelPointsCustomRewardId *string                `protobuf:"bytes,14,opt,name=channel_points_custom_reward_id,json=channelPointsCustomRewardId,proto3,oneof" json:"channel_points_custom_reward_id,omitempty"`
+	SourceBroadcasterUserId     *string                `protobuf:"bytes,15,opt,name=source_broadcaster_user_id,json=sourceBroadcasterUserId,proto3,oneof" json:"source_broadcaster_user_id,omitempty"`
+	SourceBroadcasterUserName   *string                `protobuf:"bytes,16,opt,name=source_broadcaster_user_name,json=sourceBroadcasterUserName,proto3,oneof" json:"source_broadcaster_user_name,omitempty"`
+	SourceBroadcasterUserLogin  *string                `protobuf:"bytes,17,opt,name=source_broadcaster_user_login,json=sourceBroadcasterUserLogin,proto3,oneof" json:"source_broadcaster_user_login,omitempty"`
+	SourceMessageId             *string                `protobuf:"bytes,18,opt,name=source_message_id,json=sourceMessageId,proto3,oneof" json:"source_message_id,omitempty"`
+	SourceBadges                []*Badges              `protobuf:"bytes,19,rep,name=source_badges,json=sourceBadges,proto3" json:"source_badges,omitempty"`
+	IsSourceOnly                *bool                  `protobuf:"varint,20,opt,name=is_source_only,json=isSourceOnly,proto3,oneof" json:"is_source_only,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ChannelChatMessageEvent) Reset() {
+	*x = ChannelChatMessageEvent{}
+	mi := &file_eventsub_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelChatMessageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelChatMessageEvent) ProtoMessage() {}
+
+func (x *ChannelChatMessageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelChatMessageEvent.ProtoReflect.Descriptor instead.
+func (*ChannelChatMessageEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ChannelChatMessageEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetChatterUserId() string {
+	if x != nil {
+		return x.ChatterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetChatterUserLogin() string {
+	if x != nil {
+		return x.ChatterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetChatterUserName() string {
+	if x != nil {
+		return x.ChatterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ChannelChatMessageEvent) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetBadges() []*Badges {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+func (x *ChannelChatMessageEvent) GetMessageType() string {
+	if x != nil {
+		return x.MessageType
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetCheer() *MessageCheer {
+	if x != nil {
+		return x.Cheer
+	}
+	return nil
+}
+
+func (x *ChannelChatMessageEvent) GetReply() *Reply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *ChannelChatMessageEvent) GetChannelPointsCustomRewardId() string {
+	if x != nil && x.ChannelPointsCustomRewardId != nil {
+		return *x.ChannelPointsCustomRewardId
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetSourceBroadcasterUserId() string {
+	if x != nil && x.SourceBroadcasterUserId != nil {
+		return *x.SourceBroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetSourceBroadcasterUserName() string {
+	if x != nil && x.SourceBroadcasterUserName != nil {
+		return *x.SourceBroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetSourceBroadcasterUserLogin() string {
+	if x != nil && x.SourceBroadcasterUserLogin != nil {
+		return *x.SourceBroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetSourceMessageId() string {
+	if x != nil && x.SourceMessageId != nil {
+		return *x.SourceMessageId
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageEvent) GetSourceBadges() []*Badges {
+	if x != nil {
+		return x.SourceBadges
+	}
+	return nil
+}
+
+func (x *ChannelChatMessageEvent) GetIsSourceOnly() bool {
+	if x != nil && x.IsSourceOnly != nil {
+		return *x.IsSourceOnly
+	}
+	return false
+}
+
+type ChannelChatMessageDeleteEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	TargetUserId         string                 `protobuf:"bytes,4,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	TargetUserName       string                 `protobuf:"bytes,5,opt,name=target_user_name,json=targetUserName,proto3" json:"target_user_name,omitempty"`
+	TargetUserLogin      string                 `protobuf:"bytes,6,opt,name=target_user_login,json=targetUserLogin,proto3" json:"target_user_login,omitempty"`
+	MessageId            string                 `protobuf:"bytes,7,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelChatMessageDeleteEvent) Reset() {
+	*x = ChannelChatMessageDeleteEvent{}
+	mi := &file_eventsub_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelChatMessageDeleteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelChatMessageDeleteEvent) ProtoMessage() {}
+
+func (x *ChannelChatMessageDeleteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelChatMessageDeleteEvent.ProtoReflect.Descriptor instead.
+func (*ChannelChatMessageDeleteEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChannelChatMessageDeleteEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageDeleteEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageDeleteEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageDeleteEvent) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageDeleteEvent) GetTargetUserName() string {
+	if x != nil {
+		return x.TargetUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageDeleteEvent) GetTargetUserLogin() string {
+	if x != nil {
+		return x.TargetUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatMessageDeleteEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type ChannelChatNotificationEvent struct {
+	state                protoimpl.MessageState        `protogen:"open.v1"`
+	BroadcasterUserId    string                        `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                        `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                        `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	ChatterUserId        string                        `protobuf:"bytes,4,opt,name=chatter_user_id,json=chatterUserId,proto3" json:"chatter_user_id,omitempty"`
+	ChatterUserLogin     string                        `protobuf:"bytes,5,opt,name=chatter_user_login,json=chatterUserLogin,proto3" json:"chatter_user_login,omitempty"`
+	ChatterUserName      string                        `protobuf:"bytes,6,opt,name=chatter_user_name,json=chatterUserName,proto3" json:"chatter_user_name,omitempty"`
+	ChatterIsAnonymous   bool                          `protobuf:"varint,7,opt,name=chatter_is_anonymous,json=chatterIsAnonymous,proto3" json:"chatter_is_anonymous,omitempty"`
+	Color                string                        `protobuf:"bytes,8,opt,name=color,proto3" json:"color,omitempty"`
+	Badges               []*Badges                     `protobuf:"bytes,9,rep,name=badges,proto3" json:"badges,omitempty"`
+	SystemMessage        string                        `protobuf:"bytes,10,opt,name=system_message,json=systemMessage,proto3" json:"system_message,omitempty"`
+	MessageId            string                        `protobuf:"bytes,11,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Message              *NotificationMessage          `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+	NoticeType           string                        `protobuf:"bytes,13,opt,name=notice_type,json=noticeType,proto3" json:"notice_type,omitempty"`
+	Sub                  *NotificationSub              `protobuf:"bytes,14,opt,name=sub,proto3" json:"sub,omitempty"`
+	Resub                *NotificationResub            `protobuf:"bytes,15,opt,name=resub,proto3" json:"resub,omitempty"`
+	SubGift              *NotificationSubGift          `protobuf:"bytes,16,opt,name=sub_gift,json=subGift,proto3" json:"sub_gift,omitempty"`
+	CommunitySubGift     *NotificationCommunitySubGift `protobuf:"bytes,17,opt,name=community_sub_gift,json=communitySubGift,proto3" json:"community_sub_gift,omitempty"`
+	GiftPaidUpgrade      *NotificationGiftPaidUpgrade  `protobuf:"bytes,18,opt,name=gift_paid_upgrade,json=giftPaidUpgrade,proto3" json:"gift_paid_upgrade,omitempty"`
+	PrimePaidUpgrade     *NotificationPrimePaidUpgrade `protobuf:"bytes,19,opt,name=prime_paid_upgrade,json=primePaidUpgrade,proto3" json:"prime_paid_upgrade,omitempty"`
+	PayItForward         *NotificationPayItForward     `protobuf:"bytes,20,opt,name=pay_it_forward,json=payItForward,proto3" json:"pay_it_forward,omitempty"`
+	Raid                 *NotificationRaid             `protobuf:"bytes,21,opt,name=raid,proto3" json:"raid,omitempty"`
+	Unraid               *structpb.Value               `protobuf:"bytes,22,opt,name=unraid,proto3" json:"unraid,omitempty"`
+	Announcement         *NotificationAnnouncement     `protobuf:"bytes,23,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	BitsBadgeTier        *NotificationBitsBadgeTier    `protobuf:"bytes,24,opt,name=bits_badge_tier,json=bitsBadgeTier,proto3" json:"bits_badge_tier,omitempty"`
+	CharityDonation      *NotificationCharityDonation  `protobuf:"bytes,25,opt,name=charity_donation,json=charityDonation,proto3" json:"charity_donation,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelChatNotificationEvent) Reset() {
+	*x = ChannelChatNotificationEvent{}
+	mi := &file_eventsub_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelChatNotificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelChatNotificationEvent) ProtoMessage() {}
+
+func (x *ChannelChatNotificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelChatNotificationEvent.ProtoReflect.Descriptor instead.
+func (*ChannelChatNotificationEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChannelChatNotificationEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatNotificationEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatNotificationEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatNotificationEvent) GetChatterUserId() string {
+	if x != nil {
+		return x.ChatterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatNotificationEvent) GetChatterUserLogin() string {
+	if x != nil {
+		return x.ChatterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatNotificationEvent) GetChatterUserName() string {
+	if x != nil {
+		return x.ChatterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatNotificationEvent) GetChatterIsAnonymous() bool {
+	if x != nil {
+		return x.ChatterIsAnonymous
+	}
+	return false
+}
+
+func (x *ChannelChatNotificationEvent) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *ChannelChatNotificationEvent) GetBadges() []*Badges {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetSystemMessage() string {
+	if x != nil {
+		return x.SystemMessage
+	}
+	return ""
+}
+
+func (x *ChannelChatNotificationEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChannelChatNotificationEvent) GetMessage() *NotificationMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetNoticeType() string {
+	if x != nil {
+		return x.NoticeType
+	}
+	return ""
+}
+
+func (x *ChannelChatNotificationEvent) GetSub() *NotificationSub {
+	if x != nil {
+		return x.Sub
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetResub() *NotificationResub {
+	if x != nil {
+		return x.Resub
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetSubGift() *NotificationSubGift {
+	if x != nil {
+		return x.SubGift
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetCommunitySubGift() *NotificationCommunitySubGift {
+	if x != nil {
+		return x.CommunitySubGift
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetGiftPaidUpgrade() *NotificationGiftPaidUpgrade {
+	if x != nil {
+		return x.GiftPaidUpgrade
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetPrimePaidUpgrade() *NotificationPrimePaidUpgrade {
+	if x != nil {
+		return x.PrimePaidUpgrade
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetPayItForward() *NotificationPayItForward {
+	if x != nil {
+		return x.PayItForward
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetRaid() *NotificationRaid {
+	if x != nil {
+		return x.Raid
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetUnraid() *structpb.Value {
+	if x != nil {
+		return x.Unraid
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetAnnouncement() *NotificationAnnouncement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetBitsBadgeTier() *NotificationBitsBadgeTier {
+	if x != nil {
+		return x.BitsBadgeTier
+	}
+	return nil
+}
+
+func (x *ChannelChatNotificationEvent) GetCharityDonation() *NotificationCharityDonation {
+	if x != nil {
+		return x.CharityDonation
+	}
+	return nil
+}
+
+type ChannelChatSettingsUpdateEvent struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId           string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin        string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName         string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	EmoteMode                   bool                   `protobuf:"varint,4,opt,name=emote_mode,json=emoteMode,proto3" json:"emote_mode,omitempty"`
+	FollowerMode                bool                   `protobuf:"varint,5,opt,name=follower_mode,json=followerMode,proto3" json:"follower_mode,omitempty"`
+	FollowerModeDurationMinutes *int64                 `protobuf:"varint,6,opt,name=follower_mode_duration_minutes,json=followerModeDurationMinutes,proto3,oneof" json:"follower_mode_duration_minutes,omitempty"`
+	SlowMode                    bool                   `protobuf:"varint,7,opt,name=slow_mode,json=slowMode,proto3" json:"slow_mode,omitempty"`
+	SlowModeWaitTimeSeconds     int64                  `protobuf:"varint,8,opt,name=slow_mode_wait_time_seconds,json=slowModeWaitTimeSeconds,proto3" json:"slow_mode_wait_time_seconds,omitempty"`
+	SubscriberMode              bool                   `protobuf:"varint,9,opt,name=subscriber_mode,json=subscriberMode,proto3" json:"subscriber_mode,omitempty"`
+	UniqueChatMode              bool                   `protobuf:"varint,10,opt,name=unique_chat_mode,json=uniqueChatMode,proto3" json:"unique_chat_mode,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ChannelChatSettingsUpdateEvent) Reset() {
+	*x = ChannelChatSettingsUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelChatSettingsUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelChatSettingsUpdateEvent) ProtoMessage() {}
+
+func (x *ChannelChatSettingsUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelChatSettingsUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelChatSettingsUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChannelChatSettingsUpdateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatSettingsUpdateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatSettingsUpdateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatSettingsUpdateEvent) GetEmoteMode() bool {
+	if x != nil {
+		return x.EmoteMode
+	}
+	return false
+}
+
+func (x *ChannelChatSettingsUpdateEvent) GetFollowerMode() bool {
+	if x != nil {
+		return x.FollowerMode
+	}
+	return false
+}
+
+func (x *ChannelChatSettingsUpdateEvent) GetFollowerModeDurationMinutes() int64 {
+	if x != nil && x.FollowerModeDurationMinutes != nil {
+		return *x.FollowerModeDurationMinutes
+	}
+	return 0
+}
+
+func (x *ChannelChatSettingsUpdateEvent) GetSlowMode() bool {
+	if x != nil {
+		return x.SlowMode
+	}
+	return false
+}
+
+func (x *ChannelChatSettingsUpdateEvent) GetSlowModeWaitTimeSeconds() int64 {
+	if x != nil {
+		return x.SlowModeWaitTimeSeconds
+	}
+	return 0
+}
+
+func (x *ChannelChatSettingsUpdateEvent) GetSubscriberMode() bool {
+	if x != nil {
+		return x.SubscriberMode
+	}
+	return false
+}
+
+func (x *ChannelChatSettingsUpdateEvent) GetUniqueChatMode() bool {
+	if x != nil {
+		return x.UniqueChatMode
+	}
+	return false
+}
+
+type ChannelChatUserMessageHoldEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	UserId               string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,5,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,6,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	MessageId            string                 `protobuf:"bytes,7,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Message              *Message               `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelChatUserMessageHoldEvent) Reset() {
+	*x = ChannelChatUserMessageHoldEvent{}
+	mi := &file_eventsub_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelChatUserMessageHoldEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelChatUserMessageHoldEvent) ProtoMessage() {}
+
+func (x *ChannelChatUserMessageHoldEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelChatUserMessageHoldEvent.ProtoReflect.Descriptor instead.
+func (*ChannelChatUserMessageHoldEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChannelChatUserMessageHoldEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageHoldEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageHoldEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageHoldEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageHoldEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageHoldEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageHoldEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageHoldEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ChannelChatUserMessageUpdateEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	UserId               string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,5,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,6,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Status               string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	MessageId            string                 `protobuf:"bytes,8,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Message              *Message               `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) Reset() {
+	*x = ChannelChatUserMessageUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelChatUserMessageUpdateEvent) ProtoMessage() {}
+
+func (x *ChannelChatUserMessageUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelChatUserMessageUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelChatUserMessageUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChannelChatUserMessageUpdateEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ChannelSubscribeEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Tier                 string                 `protobuf:"bytes,7,opt,name=tier,proto3" json:"tier,omitempty"`
+	IsGift               bool                   `protobuf:"varint,8,opt,name=is_gift,json=isGift,proto3" json:"is_gift,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelSubscribeEvent) Reset() {
+	*x = ChannelSubscribeEvent{}
+	mi := &file_eventsub_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelSubscribeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelSubscribeEvent) ProtoMessage() {}
+
+func (x *ChannelSubscribeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelSubscribeEvent.ProtoReflect.Descriptor instead.
+func (*ChannelSubscribeEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChannelSubscribeEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelSubscribeEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSubscribeEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelSubscribeEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelSubscribeEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSubscribeEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelSubscribeEvent) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *ChannelSubscribeEvent) GetIsGift() bool {
+	if x != nil {
+		return x.IsGift
+	}
+	return false
+}
+
+type ChannelSubscriptionEndEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Tier                 string                 `protobuf:"bytes,7,opt,name=tier,proto3" json:"tier,omitempty"`
+	IsGift               bool                   `protobuf:"varint,8,opt,name=is_gift,json=isGift,proto3" json:"is_gift,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelSubscriptionEndEvent) Reset() {
+	*x = ChannelSubscriptionEndEvent{}
+	mi := &file_eventsub_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelSubscriptionEndEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelSubscriptionEndEvent) ProtoMessage() {}
+
+func (x *ChannelSubscriptionEndEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelSubscriptionEndEvent.ProtoReflect.Descriptor instead.
+func (*ChannelSubscriptionEndEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ChannelSubscriptionEndEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionEndEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionEndEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionEndEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionEndEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionEndEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionEndEvent) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionEndEvent) GetIsGift() bool {
+	if x != nil {
+		return x.IsGift
+	}
+	return false
+}
+
+type ChannelSubscriptionGiftEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	UserLogin            *string                `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3,oneof" json:"user_login,omitempty"`
+	UserName             *string                `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Total                int64                  `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	Tier                 string                 `protobuf:"bytes,8,opt,name=tier,proto3" json:"tier,omitempty"`
+	CumulativeTotal      *int64                 `protobuf:"varint,9,opt,name=cumulative_total,json=cumulativeTotal,proto3,oneof" json:"cumulative_total,omitempty"`
+	IsAnonymous          bool                   `protobuf:"varint,10,opt,name=is_anonymous,json=isAnonymous,proto3" json:"is_anonymous,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelSubscriptionGiftEvent) Reset() {
+	*x = ChannelSubscriptionGiftEvent{}
+	mi := &file_eventsub_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelSubscriptionGiftEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelSubscriptionGiftEvent) ProtoMessage() {}
+
+func (x *ChannelSubscriptionGiftEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelSubscriptionGiftEvent.ProtoReflect.Descriptor instead.
+func (*ChannelSubscriptionGiftEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChannelSubscriptionGiftEvent) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionGiftEvent) GetUserLogin() string {
+	if x != nil && x.UserLogin != nil {
+		return *x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionGiftEvent) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionGiftEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionGiftEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionGiftEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionGiftEvent) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ChannelSubscriptionGiftEvent) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionGiftEvent) GetCumulativeTotal() int64 {
+	if x != nil && x.CumulativeTotal != nil {
+		return *x.CumulativeTotal
+	}
+	return 0
+}
+
+func (x *ChannelSubscriptionGiftEvent) GetIsAnonymous() bool {
+	if x != nil {
+		return x.IsAnonymous
+	}
+	return false
+}
+
+type ChannelSubscriptionMessageEvent struct {
+	state                protoimpl.MessageState     `protogen:"open.v1"`
+	UserId               string                     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                     `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                     `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	BroadcasterUserId    string                     `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                     `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                     `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Tier                 string                     `protobuf:"bytes,7,opt,name=tier,proto3" json:"tier,omitempty"`
+	Message              *SubscriptionMessageStruct `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	CumulativeMonths     int64                      `protobuf:"varint,9,opt,name=cumulative_months,json=cumulativeMonths,proto3" json:"cumulative_months,omitempty"`
+	StreakMonths         *int64                     `protobuf:"varint,10,opt,name=streak_months,json=streakMonths,proto3,oneof" json:"streak_months,omitempty"`
+	DurationMonths       int64                      `protobuf:"varint,11,opt,name=duration_months,json=durationMonths,proto3" json:"duration_months,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelSubscriptionMessageEvent) Reset() {
+	*x = ChannelSubscriptionMessageEvent{}
+	mi := &file_eventsub_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelSubscriptionMessageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelSubscriptionMessageEvent) ProtoMessage() {}
+
+func (x *ChannelSubscriptionMessageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelSubscriptionMessageEvent.ProtoReflect.Descriptor instead.
+func (*ChannelSubscriptionMessageEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetMessage() *SubscriptionMessageStruct {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetCumulativeMonths() int64 {
+	if x != nil {
+		return x.CumulativeMonths
+	}
+	return 0
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetStreakMonths() int64 {
+	if x != nil && x.StreakMonths != nil {
+		return *x.StreakMonths
+	}
+	return 0
+}
+
+func (x *ChannelSubscriptionMessageEvent) GetDurationMonths() int64 {
+	if x != nil {
+		return x.DurationMonths
+	}
+	return 0
+}
+
+type ChannelCheerEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	IsAnonymous          bool                   `protobuf:"varint,1,opt,name=is_anonymous,json=isAnonymous,proto3" json:"is_anonymous,omitempty"`
+	UserId               *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	UserLogin            *string                `protobuf:"bytes,3,opt,name=user_login,json=userLogin,proto3,oneof" json:"user_login,omitempty"`
+	UserName             *string                `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,5,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,6,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,7,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Message              string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Bits                 int64                  `protobuf:"varint,9,opt,name=bits,proto3" json:"bits,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelCheerEvent) Reset() {
+	*x = ChannelCheerEvent{}
+	mi := &file_eventsub_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelCheerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelCheerEvent) ProtoMessage() {}
+
+func (x *ChannelCheerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelCheerEvent.ProtoReflect.Descriptor instead.
+func (*ChannelCheerEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ChannelCheerEvent) GetIsAnonymous() bool {
+	if x != nil {
+		return x.IsAnonymous
+	}
+	return false
+}
+
+func (x *ChannelCheerEvent) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelCheerEvent) GetUserLogin() string {
+	if x != nil && x.UserLogin != nil {
+		return *x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelCheerEvent) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelCheerEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelCheerEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelCheerEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelCheerEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChannelCheerEvent) GetBits() int64 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+type ChannelRaidEvent struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	FromBroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=from_broadcaster_user_id,json=fromBroadcasterUserId,proto3" json:"from_broadcaster_user_id,omitempty"`
+	FromBroadcasterUserLogin string                 `protobuf:"bytes,2,opt,name=from_broadcaster_user_login,json=fromBroadcasterUserLogin,proto3" json:"from_broadcaster_user_login,omitempty"`
+	FromBroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=from_broadcaster_user_name,json=fromBroadcasterUserName,proto3" json:"from_broadcaster_user_name,omitempty"`
+	ToBroadcasterUserId      string                 `protobuf:"bytes,4,opt,name=to_broadcaster_user_id,json=toBroadcasterUserId,proto3" json:"to_broadcaster_user_id,omitempty"`
+	ToBroadcasterUserLogin   string                 `protobuf:"bytes,5,opt,name=to_broadcaster_user_login,json=toBroadcasterUserLogin,proto3" json:"to_broadcaster_user_login,omitempty"`
+	ToBroadcasterUserName    string                 `protobuf:"bytes,6,opt,name=to_broadcaster_user_name,json=toBroadcasterUserName,proto3" json:"to_broadcaster_user_name,omitempty"`
+	Viewers                  int64                  `protobuf:"varint,7,opt,name=viewers,proto3" json:"viewers,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ChannelRaidEvent) Reset() {
+	*x = ChannelRaidEvent{}
+	mi := &file_eventsub_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelRaidEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelRaidEvent) ProtoMessage() {}
+
+func (x *ChannelRaidEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelRaidEvent.ProtoReflect.Descriptor instead.
+func (*ChannelRaidEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ChannelRaidEvent) GetFromBroadcasterUserId() string {
+	if x != nil {
+		return x.FromBroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelRaidEvent) GetFromBroadcasterUserLogin() string {
+	if x != nil {
+		return x.FromBroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelRaidEvent) GetFromBroadcasterUserName() string {
+	if x != nil {
+		return x.FromBroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelRaidEvent) GetToBroadcasterUserId() string {
+	if x != nil {
+		return x.ToBroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelRaidEvent) GetToBroadcasterUserLogin() string {
+	if x != nil {
+		return x.ToBroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelRaidEvent) GetToBroadcasterUserName() string {
+	if x != nil {
+		return x.ToBroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelRaidEvent) GetViewers() int64 {
+	if x != nil {
+		return x.Viewers
+	}
+	return 0
+}
+
+type ChannelBanEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,7,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,8,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,9,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	Reason               string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	BannedAt             string                 `protobuf:"bytes,11,opt,name=banned_at,json=bannedAt,proto3" json:"banned_at,omitempty"`
+	EndsAt               *string                `protobuf:"bytes,12,opt,name=ends_at,json=endsAt,proto3,oneof" json:"ends_at,omitempty"`
+	IsPermanent          bool                   `protobuf:"varint,13,opt,name=is_permanent,json=isPermanent,proto3" json:"is_permanent,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelBanEvent) Reset() {
+	*x = ChannelBanEvent{}
+	mi := &file_eventsub_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelBanEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelBanEvent) ProtoMessage() {}
+
+func (x *ChannelBanEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelBanEvent.ProtoReflect.Descriptor instead.
+func (*ChannelBanEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChannelBanEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetBannedAt() string {
+	if x != nil {
+		return x.BannedAt
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetEndsAt() string {
+	if x != nil && x.EndsAt != nil {
+		return *x.EndsAt
+	}
+	return ""
+}
+
+func (x *ChannelBanEvent) GetIsPermanent() bool {
+	if x != nil {
+		return x.IsPermanent
+	}
+	return false
+}
+
+type ChannelUnbanEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,7,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,8,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,9,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelUnbanEvent) Reset() {
+	*x = ChannelUnbanEvent{}
+	mi := &file_eventsub_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelUnbanEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelUnbanEvent) ProtoMessage() {}
+
+func (x *ChannelUnbanEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelUnbanEvent.ProtoReflect.Descriptor instead.
+func (*ChannelUnbanEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChannelUnbanEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelUnbanEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelUnbanEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelUnbanEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelUnbanEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelUnbanEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelUnbanEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelUnbanEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelUnbanEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+type ChannelUnbanRequestCreateEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	UserId               string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,6,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,7,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Text                 string                 `protobuf:"bytes,8,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelUnbanRequestCreateEvent) Reset() {
+	*x = ChannelUnbanRequestCreateEvent{}
+	mi := &file_eventsub_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelUnbanRequestCreateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelUnbanRequestCreateEvent) ProtoMessage() {}
+
+func (x *ChannelUnbanRequestCreateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelUnbanRequestCreateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelUnbanRequestCreateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChannelUnbanRequestCreateEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestCreateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestCreateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestCreateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestCreateEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestCreateEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestCreateEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestCreateEvent) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestCreateEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ChannelUnbanRequestResolveEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,5,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,6,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,7,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	UserId               string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,9,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,10,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	ResolutionText       string                 `protobuf:"bytes,11,opt,name=resolution_text,json=resolutionText,proto3" json:"resolution_text,omitempty"`
+	Status               string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelUnbanRequestResolveEvent) Reset() {
+	*x = ChannelUnbanRequestResolveEvent{}
+	mi := &file_eventsub_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelUnbanRequestResolveEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelUnbanRequestResolveEvent) ProtoMessage() {}
+
+func (x *ChannelUnbanRequestResolveEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelUnbanRequestResolveEvent.ProtoReflect.Descriptor instead.
+func (*ChannelUnbanRequestResolveEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetResolutionText() string {
+	if x != nil {
+		return x.ResolutionText
+	}
+	return ""
+}
+
+func (x *ChannelUnbanRequestResolveEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ChannelModerateEventV2 struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId          string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin       string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName        string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	SourceBroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=source_broadcaster_user_id,json=sourceBroadcasterUserId,proto3" json:"source_broadcaster_user_id,omitempty"`
+	SourceBroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=source_broadcaster_user_login,json=sourceBroadcasterUserLogin,proto3" json:"source_broadcaster_user_login,omitempty"`
+	SourcebroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=sourcebroadcaster_user_name,json=sourcebroadcasterUserName,proto3" json:"sourcebroadcaster_user_name,omitempty"`
+	ModeratorUserId            string                 `protobuf:"bytes,7,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserLogin         string                 `protobuf:"bytes,8,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	ModeratorUserName          string                 `protobuf:"bytes,9,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	Action                     string                 `protobuf:"bytes,10,opt,name=action,proto3" json:"action,omitempty"`
+	Followers                  *FollowDurationMinutes `protobuf:"bytes,11,opt,name=followers,proto3" json:"followers,omitempty"`
+	Slow                       *Slow                  `protobuf:"bytes,12,opt,name=slow,proto3" json:"slow,omitempty"`
+	Vip                        *UserData              `protobuf:"bytes,13,opt,name=vip,proto3" json:"vip,omitempty"`
+	Unvip                      *UserData              `protobuf:"bytes,14,opt,name=unvip,proto3" json:"unvip,omitempty"`
+	Mod                        *UserData              `protobuf:"bytes,15,opt,name=mod,proto3" json:"mod,omitempty"`
+	Unmod                      *UserData              `protobuf:"bytes,16,opt,name=unmod,proto3" json:"unmod,omitempty"`
+	Ban                        *EventBan              `protobuf:"bytes,17,opt,name=ban,proto3" json:"ban,omitempty"`
+	Unban                      *UserData              `protobuf:"bytes,18,opt,name=unban,proto3" json:"unban,omitempty"`
+	Timeout                    *Timeout               `protobuf:"bytes,19,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Untimeout                  *UserData              `protobuf:"bytes,20,opt,name=untimeout,proto3" json:"untimeout,omitempty"`
+	Raid                       *EventRaid             `protobuf:"bytes,21,opt,name=raid,proto3" json:"raid,omitempty"`
+	Unraid                     *UserData              `protobuf:"bytes,22,opt,name=unraid,proto3" json:"unraid,omitempty"`
+	Delete                     *Delete                `protobuf:"bytes,23,opt,name=delete,proto3" json:"delete,omitempty"`
+	AutomodTerms               *AutoModTerms          `protobuf:"bytes,24,opt,name=automod_terms,json=automodTerms,proto3" json:"automod_terms,omitempty"`
+	UnbanRequest               *UnBanRequest          `protobuf:"bytes,25,opt,name=unban_request,json=unbanRequest,proto3" json:"unban_request,omitempty"`
+	Warn                       *WarnEvent             `protobuf:"bytes,26,opt,name=warn,proto3" json:"warn,omitempty"`
+	SharedChatBan              *EventBan              `protobuf:"bytes,27,opt,name=shared_chat_ban,json=sharedChatBan,proto3" json:"shared_chat_ban,omitempty"`
+	SharedChatUnban            *UserData              `protobuf:"bytes,28,opt,name=shared_chat_unban,json=sharedChatUnban,proto3" json:"shared_chat_unban,omitempty"`
+	SharedChatTimeout          *Timeout               `protobuf:"bytes,29,opt,name=shared_chat_timeout,json=sharedChatTimeout,proto3" json:"shared_chat_timeout,omitempty"`
+	SharedChatUntimeout        *UserData              `protobuf:"bytes,30,opt,name=shared_chat_untimeout,json=sharedChatUntimeout,proto3" json:"shared_chat_untimeout,omitempty"`
+	SharedChatDelete           *Delete                `protobuf:"bytes,31,opt,name=shared_chat_delete,json=sharedChatDelete,proto3" json:"shared_chat_delete,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ChannelModerateEventV2) Reset() {
+	*x = ChannelModerateEventV2{}
+	mi := &file_eventsub_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelModerateEventV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelModerateEventV2) ProtoMessage() {}
+
+func (x *ChannelModerateEventV2) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelModerateEventV2.ProtoReflect.Descriptor instead.
+func (*ChannelModerateEventV2) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ChannelModerateEventV2) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelModerateEventV2) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelModerateEventV2) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelModerateEventV2) GetSourceBroadcasterUserId() string {
+	if x != nil {
+		return x.SourceBroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelModerateEventV2) GetSourceBroadcasterUserLogin() string {
+	if x != nil {
+		return x.SourceBroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelModerateEventV2) GetSourcebroadcasterUserName() string {
+	if x != nil {
+		return x.SourcebroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelModerateEventV2) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelModerateEventV2) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelModerateEventV2) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelModerateEventV2) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ChannelModerateEventV2) GetFollowers() *FollowDurationMinutes {
+	if x != nil {
+		return x.Followers
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetSlow() *Slow {
+	if x != nil {
+		return x.Slow
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetVip() *UserData {
+	if x != nil {
+		return x.Vip
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetUnvip() *UserData {
+	if x != nil {
+		return x.Unvip
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetMod() *UserData {
+	if x != nil {
+		return x.Mod
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetUnmod() *UserData {
+	if x != nil {
+		return x.Unmod
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetBan() *EventBan {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetUnban() *UserData {
+	if x != nil {
+		return x.Unban
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetTimeout() *Timeout {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetUntimeout() *UserData {
+	if x != nil {
+		return x.Untimeout
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetRaid() *EventRaid {
+	if x != nil {
+		return x.Raid
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetUnraid() *UserData {
+	if x != nil {
+		return x.Unraid
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetDelete() *Delete {
+	if x != nil {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetAutomodTerms() *AutoModTerms {
+	if x != nil {
+		return x.AutomodTerms
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetUnbanRequest() *UnBanRequest {
+	if x != nil {
+		return x.UnbanRequest
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetWarn() *WarnEvent {
+	if x != nil {
+		return x.Warn
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetSharedChatBan() *EventBan {
+	if x != nil {
+		return x.SharedChatBan
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetSharedChatUnban() *UserData {
+	if x != nil {
+		return x.SharedChatUnban
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetSharedChatTimeout() *Timeout {
+	if x != nil {
+		return x.SharedChatTimeout
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetSharedChatUntimeout() *UserData {
+	if x != nil {
+		return x.SharedChatUntimeout
+	}
+	return nil
+}
+
+func (x *ChannelModerateEventV2) GetSharedChatDelete() *Delete {
+	if x != nil {
+		return x.SharedChatDelete
+	}
+	return nil
+}
+
+type ChannelModeratorAddEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelModeratorAddEvent) Reset() {
+	*x = ChannelModeratorAddEvent{}
+	mi := &file_eventsub_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelModeratorAddEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelModeratorAddEvent) ProtoMessage() {}
+
+func (x *ChannelModeratorAddEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelModeratorAddEvent.ProtoReflect.Descriptor instead.
+func (*ChannelModeratorAddEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChannelModeratorAddEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelModeratorAddEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelModeratorAddEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelModeratorAddEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelModeratorAddEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelModeratorAddEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+type ChannelModeratorRemoveEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelModeratorRemoveEvent) Reset() {
+	*x = ChannelModeratorRemoveEvent{}
+	mi := &file_eventsub_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelModeratorRemoveEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelModeratorRemoveEvent) ProtoMessage() {}
+
+func (x *ChannelModeratorRemoveEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelModeratorRemoveEvent.ProtoReflect.Descriptor instead.
+func (*ChannelModeratorRemoveEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChannelModeratorRemoveEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelModeratorRemoveEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelModeratorRemoveEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelModeratorRemoveEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelModeratorRemoveEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelModeratorRemoveEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+type ChannelGuestStarSessionBeginEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,4,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,5,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,6,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	SessionId            string                 `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelGuestStarSessionBeginEvent) Reset() {
+	*x = ChannelGuestStarSessionBeginEvent{}
+	mi := &file_eventsub_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelGuestStarSessionBeginEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelGuestStarSessionBeginEvent) ProtoMessage() {}
+
+func (x *ChannelGuestStarSessionBeginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelGuestStarSessionBeginEvent.ProtoReflect.Descriptor instead.
+func (*ChannelGuestStarSessionBeginEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChannelGuestStarSessionBeginEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionBeginEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionBeginEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionBeginEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionBeginEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionBeginEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionBeginEvent) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionBeginEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+type ChannelGuestStarSessionEndEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,4,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,5,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,6,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	SessionId            string                 `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt              string                 `protobuf:"bytes,9,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelGuestStarSessionEndEvent) Reset() {
+	*x = ChannelGuestStarSessionEndEvent{}
+	mi := &file_eventsub_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelGuestStarSessionEndEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelGuestStarSessionEndEvent) ProtoMessage() {}
+
+func (x *ChannelGuestStarSessionEndEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelGuestStarSessionEndEvent.ProtoReflect.Descriptor instead.
+func (*ChannelGuestStarSessionEndEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChannelGuestStarSessionEndEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionEndEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionEndEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionEndEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionEndEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionEndEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionEndEvent) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionEndEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSessionEndEvent) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+type ChannelGuestStarGuestUpdateEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	SessionId            string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ModeratorUserId      *string                `protobuf:"bytes,5,opt,name=moderator_user_id,json=moderatorUserId,proto3,oneof" json:"moderator_user_id,omitempty"`
+	ModeratorUserName    *string                `protobuf:"bytes,6,opt,name=moderator_user_name,json=moderatorUserName,proto3,oneof" json:"moderator_user_name,omitempty"`
+	ModeratorUserLogin   *string                `protobuf:"bytes,7,opt,name=moderator_user_login,json=moderatorUserLogin,proto3,oneof" json:"moderator_user_login,omitempty"`
+	GuestUserId          *string                `protobuf:"bytes,8,opt,name=guest_user_id,json=guestUserId,proto3,oneof" json:"guest_user_id,omitempty"`
+	GuestUserName        *string                `protobuf:"bytes,9,opt,name=guest_user_name,json=guestUserName,proto3,oneof" json:"guest_user_name,omitempty"`
+	GuestUserLogin       *string                `protobuf:"bytes,10,opt,name=guest_user_login,json=guestUserLogin,proto3,oneof" json:"guest_user_login,omitempty"`
+	SlotId               *string                `protobuf:"bytes,11,opt,name=slot_id,json=slotId,proto3,oneof" json:"slot_id,omitempty"`
+	State                *string                `protobuf:"bytes,12,opt,name=state,proto3,oneof" json:"state,omitempty"`
+	HostVideoEnabled     *bool                  `protobuf:"varint,13,opt,name=host_video_enabled,json=hostVideoEnabled,proto3,oneof" json:"host_video_enabled,omitempty"`
+	HostAudioEnabled     *bool                  `protobuf:"varint,14,opt,name=host_audio_enabled,json=hostAudioEnabled,proto3,oneof" json:"host_audio_enabled,omitempty"`
+	HostVolume           *int64                 `protobuf:"varint,15,opt,name=host_volume,json=hostVolume,proto3,oneof" json:"host_volume,omitempty"`
+	HostUserId           string                 `protobuf:"bytes,16,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
+	HostUserName         string                 `protobuf:"bytes,17,opt,name=host_user_name,json=hostUserName,proto3" json:"host_user_name,omitempty"`
+	HostUserLogin        string                 `protobuf:"bytes,18,opt,name=host_user_login,json=hostUserLogin,proto3" json:"host_user_login,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) Reset() {
+	*x = ChannelGuestStarGuestUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelGuestStarGuestUpdateEvent) ProtoMessage() {}
+
+func (x *ChannelGuestStarGuestUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelGuestStarGuestUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelGuestStarGuestUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetModeratorUserId() string {
+	if x != nil && x.ModeratorUserId != nil {
+		return *x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetModeratorUserName() string {
+	if x != nil && x.ModeratorUserName != nil {
+		return *x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetModeratorUserLogin() string {
+	if x != nil && x.ModeratorUserLogin != nil {
+		return *x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetGuestUserId() string {
+	if x != nil && x.GuestUserId != nil {
+		return *x.GuestUserId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetGuestUserName() string {
+	if x != nil && x.GuestUserName != nil {
+		return *x.GuestUserName
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetGuestUserLogin() string {
+	if x != nil && x.GuestUserLogin != nil {
+		return *x.GuestUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetSlotId() string {
+	if x != nil && x.SlotId != nil {
+		return *x.SlotId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetHostVideoEnabled() bool {
+	if x != nil && x.HostVideoEnabled != nil {
+		return *x.HostVideoEnabled
+	}
+	return false
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetHostAudioEnabled() bool {
+	if x != nil && x.HostAudioEnabled != nil {
+		return *x.HostAudioEnabled
+	}
+	return false
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetHostVolume() int64 {
+	if x != nil && x.HostVolume != nil {
+		return *x.HostVolume
+	}
+	return 0
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetHostUserId() string {
+	if x != nil {
+		return x.HostUserId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetHostUserName() string {
+	if x != nil {
+		return x.HostUserName
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarGuestUpdateEvent) GetHostUserLogin() string {
+	if x != nil {
+		return x.HostUserLogin
+	}
+	return ""
+}
+
+type ChannelGuestStarSettingsUpdateEvent struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId           string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName         string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin        string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	IsModeratorSendLiveEnabled  bool                   `protobuf:"varint,4,opt,name=is_moderator_send_live_enabled,json=isModeratorSendLiveEnabled,proto3" json:"is_moderator_send_live_enabled,omitempty"`
+	SlotCount                   int64                  `protobuf:"varint,5,opt,name=slot_count,json=slotCount,proto3" json:"slot_count,omitempty"`
+	IsBrowserSourceAudioEnabled bool                   `protobuf:"varint,6,opt,name=is_browser_source_audio_enabled,json=isBrowserSourceAudioEnabled,proto3" json:"is_browser_source_audio_enabled,omitempty"`
+	GroupLayout                 string                 `protobuf:"bytes,7,opt,name=group_layout,json=groupLayout,proto3" json:"group_layout,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ChannelGuestStarSettingsUpdateEvent) Reset() {
+	*x = ChannelGuestStarSettingsUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelGuestStarSettingsUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelGuestStarSettingsUpdateEvent) ProtoMessage() {}
+
+func (x *ChannelGuestStarSettingsUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelGuestStarSettingsUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelGuestStarSettingsUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChannelGuestStarSettingsUpdateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSettingsUpdateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSettingsUpdateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGuestStarSettingsUpdateEvent) GetIsModeratorSendLiveEnabled() bool {
+	if x != nil {
+		return x.IsModeratorSendLiveEnabled
+	}
+	return false
+}
+
+func (x *ChannelGuestStarSettingsUpdateEvent) GetSlotCount() int64 {
+	if x != nil {
+		return x.SlotCount
+	}
+	return 0
+}
+
+func (x *ChannelGuestStarSettingsUpdateEvent) GetIsBrowserSourceAudioEnabled() bool {
+	if x != nil {
+		return x.IsBrowserSourceAudioEnabled
+	}
+	return false
+}
+
+func (x *ChannelGuestStarSettingsUpdateEvent) GetGroupLayout() string {
+	if x != nil {
+		return x.GroupLayout
+	}
+	return ""
+}
+
+type ChannelPointsAutomaticRewardRedemptionAddEvent struct {
+	state                protoimpl.MessageState      `protogen:"open.v1"`
+	BroadcasterUserId    string                      `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                      `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                      `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	UserId               string                      `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName             string                      `protobuf:"bytes,5,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserLogin            string                      `protobuf:"bytes,6,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	Id                   string                      `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	Reward               *ChannelPointsReward        `protobuf:"bytes,8,opt,name=reward,proto3" json:"reward,omitempty"`
+	Message              *ChannelPointsRewardMessage `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	UserInput            string                      `protobuf:"bytes,10,opt,name=user_input,json=userInput,proto3" json:"user_input,omitempty"`
+	RedeemedAt           string                      `protobuf:"bytes,11,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) Reset() {
+	*x = ChannelPointsAutomaticRewardRedemptionAddEvent{}
+	mi := &file_eventsub_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsAutomaticRewardRedemptionAddEvent) ProtoMessage() {}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsAutomaticRewardRedemptionAddEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPointsAutomaticRewardRedemptionAddEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetReward() *ChannelPointsReward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetMessage() *ChannelPointsRewardMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetUserInput() string {
+	if x != nil {
+		return x.UserInput
+	}
+	return ""
+}
+
+func (x *ChannelPointsAutomaticRewardRedemptionAddEvent) GetRedeemedAt() string {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return ""
+}
+
+type ChannelPointsCustomRewardAddEvent struct {
+	state                             protoimpl.MessageState `protogen:"open.v1"`
+	Id                                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId                 string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin              string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName               string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	IsEnabled                         bool                   `protobuf:"varint,5,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	IsPaused                          bool                   `protobuf:"varint,6,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	IsInStock                         bool                   `protobuf:"varint,7,opt,name=is_in_stock,json=isInStock,proto3" json:"is_in_stock,omitempty"`
+	Title                             string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Cost                              int64                  `protobuf:"varint,9,opt,name=cost,proto3" json:"cost,omitempty"`
+	Prompt                            string                 `protobuf:"bytes,10,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	IsUserInputRequired               bool                   `protobuf:"varint,11,opt,name=is_user_input_required,json=isUserInputRequired,proto3" json:"is_user_input_required,omitempty"`
+	ShouldRedemptionsSkipRequestQueue bool                   `protobuf:"varint,12,opt,name=should_redemptions_skip_request_queue,json=shouldRedemptionsSkipRequestQueue,proto3" json:"should_redemptions_skip_request_queue,omitempty"`
+	CooldownExpiresAt                 *string                `protobuf:"bytes,13,opt,name=cooldown_expires_at,json=cooldownExpiresAt,proto3,oneof" json:"cooldown_expires_at,omitempty"`
+	RedemptionsRedeemedCurrentStream  *int64                 `protobuf:"varint,14,opt,name=redemptions_redeemed_current_stream,json=redemptionsRedeemedCurrentStream,proto3,oneof" json:"redemptions_redeemed_current_stream,omitempty"`
+	MaxPerStream                      *MaxPer                `protobuf:"bytes,15,opt,name=max_per_stream,json=maxPerStream,proto3" json:"max_per_stream,omitempty"`
+	MaxPerUserPerStream               *MaxPer                `protobuf:"bytes,16,opt,name=max_per_user_per_stream,json=maxPerUserPerStream,proto3" json:"max_per_user_per_stream,omitempty"`
+	GlobalCooldown                    *Cooldown              `protobuf:"bytes,17,opt,name=global_cooldown,json=globalCooldown,proto3" json:"global_cooldown,omitempty"`
+	BackgroundColor                   string                 `protobuf:"bytes,18,opt,name=background_color,json=backgroundColor,proto3" json:"background_color,omitempty"`
+	Image                             *Image                 `protobuf:"bytes,19,opt,name=image,proto3" json:"image,omitempty"`
+	DefaultImage                      *Image                 `protobuf:"bytes,20,opt,name=default_image,json=defaultImage,proto3" json:"default_image,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) Reset() {
+	*x = ChannelPointsCustomRewardAddEvent{}
+	mi := &file_eventsub_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsCustomRewardAddEvent) ProtoMessage() {}
+
+func (x *ChannelPointsCustomRewardAddEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsCustomRewardAddEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPointsCustomRewardAddEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetIsInStock() bool {
+	if x != nil {
+		return x.IsInStock
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetIsUserInputRequired() bool {
+	if x != nil {
+		return x.IsUserInputRequired
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetShouldRedemptionsSkipRequestQueue() bool {
+	if x != nil {
+		return x.ShouldRedemptionsSkipRequestQueue
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetCooldownExpiresAt() string {
+	if x != nil && x.CooldownExpiresAt != nil {
+		return *x.CooldownExpiresAt
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetRedemptionsRedeemedCurrentStream() int64 {
+	if x != nil && x.RedemptionsRedeemedCurrentStream != nil {
+		return *x.RedemptionsRedeemedCurrentStream
+	}
+	return 0
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetMaxPerStream() *MaxPer {
+	if x != nil {
+		return x.MaxPerStream
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetMaxPerUserPerStream() *MaxPer {
+	if x != nil {
+		return x.MaxPerUserPerStream
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetGlobalCooldown() *Cooldown {
+	if x != nil {
+		return x.GlobalCooldown
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetBackgroundColor() string {
+	if x != nil {
+		return x.BackgroundColor
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetImage() *Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardAddEvent) GetDefaultImage() *Image {
+	if x != nil {
+		return x.DefaultImage
+	}
+	return nil
+}
+
+type ChannelPointsCustomRewardUpdateEvent struct {
+	state                             protoimpl.MessageState `protogen:"open.v1"`
+	Id                                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId                 string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin              string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName               string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	IsEnabled                         bool                   `protobuf:"varint,5,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	IsPaused                          bool                   `protobuf:"varint,6,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	IsInStock                         bool                   `protobuf:"varint,7,opt,name=is_in_stock,json=isInStock,proto3" json:"is_in_stock,omitempty"`
+	Title                             string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Cost                              int64                  `protobuf:"varint,9,opt,name=cost,proto3" json:"cost,omitempty"`
+	Prompt                            string                 `protobuf:"bytes,10,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	IsUserInputRequired               bool                   `protobuf:"varint,11,opt,name=is_user_input_required,json=isUserInputRequired,proto3" json:"is_user_input_required,omitempty"`
+	ShouldRedemptionsSkipRequestQueue bool                   `protobuf:"varint,12,opt,name=should_redemptions_skip_request_queue,json=shouldRedemptionsSkipRequestQueue,proto3" json:"should_redemptions_skip_request_queue,omitempty"`
+	CooldownExpiresAt                 *string                `protobuf:"bytes,13,opt,name=cooldown_expires_at,json=cooldownExpiresAt,proto3,oneof" json:"cooldown_expires_at,omitempty"`
+	RedemptionsRedeemedCurrentStream  *int64                 `protobuf:"varint,14,opt,name=redemptions_redeemed_current_stream,json=redemptionsRedeemedCurrentStream,proto3,oneof" json:"redemptions_redeemed_current_stream,omitempty"`
+	MaxPerStream                      *MaxPer                `protobuf:"bytes,15,opt,name=max_per_stream,json=maxPerStream,proto3" json:"max_per_stream,omitempty"`
+	MaxPerUserPerStream               *MaxPer                `protobuf:"bytes,16,opt,name=max_per_user_per_stream,json=maxPerUserPerStream,proto3" json:"max_per_user_per_stream,omitempty"`
+	GlobalCooldown                    *Cooldown              `protobuf:"bytes,17,opt,name=global_cooldown,json=globalCooldown,proto3" json:"global_cooldown,omitempty"`
+	BackgroundColor                   string                 `protobuf:"bytes,18,opt,name=background_color,json=backgroundColor,proto3" json:"background_color,omitempty"`
+	Image                             *Image                 `protobuf:"bytes,19,opt,name=image,proto3" json:"image,omitempty"`
+	DefaultImage                      *Image                 `protobuf:"bytes,20,opt,name=default_image,json=defaultImage,proto3" json:"default_image,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) Reset() {
+	*x = ChannelPointsCustomRewardUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsCustomRewardUpdateEvent) ProtoMessage() {}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsCustomRewardUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPointsCustomRewardUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetIsInStock() bool {
+	if x != nil {
+		return x.IsInStock
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetIsUserInputRequired() bool {
+	if x != nil {
+		return x.IsUserInputRequired
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetShouldRedemptionsSkipRequestQueue() bool {
+	if x != nil {
+		return x.ShouldRedemptionsSkipRequestQueue
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetCooldownExpiresAt() string {
+	if x != nil && x.CooldownExpiresAt != nil {
+		return *x.CooldownExpiresAt
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetRedemptionsRedeemedCurrentStream() int64 {
+	if x != nil && x.RedemptionsRedeemedCurrentStream != nil {
+		return *x.RedemptionsRedeemedCurrentStream
+	}
+	return 0
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetMaxPerStream() *MaxPer {
+	if x != nil {
+		return x.MaxPerStream
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetMaxPerUserPerStream() *MaxPer {
+	if x != nil {
+		return x.MaxPerUserPerStream
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetGlobalCooldown() *Cooldown {
+	if x != nil {
+		return x.GlobalCooldown
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetBackgroundColor() string {
+	if x != nil {
+		return x.BackgroundColor
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetImage() *Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardUpdateEvent) GetDefaultImage() *Image {
+	if x != nil {
+		return x.DefaultImage
+	}
+	return nil
+}
+
+type ChannelPointsCustomRewardRemoveEvent struct {
+	state                             protoimpl.MessageState `protogen:"open.v1"`
+	Id                                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId                 string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin              string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName               string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	IsEnabled                         bool                   `protobuf:"varint,5,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	IsPaused                          bool                   `protobuf:"varint,6,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	IsInStock                         bool                   `protobuf:"varint,7,opt,name=is_in_stock,json=isInStock,proto3" json:"is_in_stock,omitempty"`
+	Title                             string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Cost                              int64                  `protobuf:"varint,9,opt,name=cost,proto3" json:"cost,omitempty"`
+	Prompt                            string                 `protobuf:"bytes,10,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	IsUserInputRequired               bool                   `protobuf:"varint,11,opt,name=is_user_input_required,json=isUserInputRequired,proto3" json:"is_user_input_required,omitempty"`
+	ShouldRedemptionsSkipRequestQueue bool                   `protobuf:"varint,12,opt,name=should_redemptions_skip_request_queue,json=shouldRedemptionsSkipRequestQueue,proto3" json:"should_redemptions_skip_request_queue,omitempty"`
+	CooldownExpiresAt                 *string                `protobuf:"bytes,13,opt,name=cooldown_expires_at,json=cooldownExpiresAt,proto3,oneof" json:"cooldown_expires_at,omitempty"`
+	RedemptionsRedeemedCurrentStream  *int64                 `protobuf:"varint,14,opt,name=redemptions_redeemed_current_stream,json=redemptionsRedeemedCurrentStream,proto3,oneof" json:"redemptions_redeemed_current_stream,omitempty"`
+	MaxPerStream                      *MaxPer                `protobuf:"bytes,15,opt,name=max_per_stream,json=maxPerStream,proto3" json:"max_per_stream,omitempty"`
+	MaxPerUserPerStream               *MaxPer                `protobuf:"bytes,16,opt,name=max_per_user_per_stream,json=maxPerUserPerStream,proto3" json:"max_per_user_per_stream,omitempty"`
+	GlobalCooldown                    *Cooldown              `protobuf:"bytes,17,opt,name=global_cooldown,json=globalCooldown,proto3" json:"global_cooldown,omitempty"`
+	BackgroundColor                   string                 `protobuf:"bytes,18,opt,name=background_color,json=backgroundColor,proto3" json:"background_color,omitempty"`
+	Image                             *Image                 `protobuf:"bytes,19,opt,name=image,proto3" json:"image,omitempty"`
+	DefaultImage                      *Image                 `protobuf:"bytes,20,opt,name=default_image,json=defaultImage,proto3" json:"default_image,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) Reset() {
+	*x = ChannelPointsCustomRewardRemoveEvent{}
+	mi := &file_eventsub_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsCustomRewardRemoveEvent) ProtoMessage() {}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsCustomRewardRemoveEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPointsCustomRewardRemoveEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetIsInStock() bool {
+	if x != nil {
+		return x.IsInStock
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetIsUserInputRequired() bool {
+	if x != nil {
+		return x.IsUserInputRequired
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetShouldRedemptionsSkipRequestQueue() bool {
+	if x != nil {
+		return x.ShouldRedemptionsSkipRequestQueue
+	}
+	return false
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetCooldownExpiresAt() string {
+	if x != nil && x.CooldownExpiresAt != nil {
+		return *x.CooldownExpiresAt
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetRedemptionsRedeemedCurrentStream() int64 {
+	if x != nil && x.RedemptionsRedeemedCurrentStream != nil {
+		return *x.RedemptionsRedeemedCurrentStream
+	}
+	return 0
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetMaxPerStream() *MaxPer {
+	if x != nil {
+		return x.MaxPerStream
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetMaxPerUserPerStream() *MaxPer {
+	if x != nil {
+		return x.MaxPerUserPerStream
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetGlobalCooldown() *Cooldown {
+	if x != nil {
+		return x.GlobalCooldown
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetBackgroundColor() string {
+	if x != nil {
+		return x.BackgroundColor
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetImage() *Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardRemoveEvent) GetDefaultImage() *Image {
+	if x != nil {
+		return x.DefaultImage
+	}
+	return nil
+}
+
+type ChannelPointsCustomRewardRedemptionAddEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	UserId               string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,6,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,7,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserInput            string                 `protobuf:"bytes,8,opt,name=user_input,json=userInput,proto3" json:"user_input,omitempty"`
+	Status               string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Reward               *Reward                `protobuf:"bytes,10,opt,name=reward,proto3" json:"reward,omitempty"`
+	RedeemedAt           string                 `protobuf:"bytes,11,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) Reset() {
+	*x = ChannelPointsCustomRewardRedemptionAddEvent{}
+	mi := &file_eventsub_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsCustomRewardRedemptionAddEvent) ProtoMessage() {}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsCustomRewardRedemptionAddEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPointsCustomRewardRedemptionAddEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetUserInput() string {
+	if x != nil {
+		return x.UserInput
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetReward() *Reward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardRedemptionAddEvent) GetRedeemedAt() string {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return ""
+}
+
+type ChannelPointsCustomRewardRedemptionUpdateEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	UserId               string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,6,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,7,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserInput            string                 `protobuf:"bytes,8,opt,name=user_input,json=userInput,proto3" json:"user_input,omitempty"`
+	Status               string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Reward               *Reward                `protobuf:"bytes,10,opt,name=reward,proto3" json:"reward,omitempty"`
+	RedeemedAt           string                 `protobuf:"bytes,11,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) Reset() {
+	*x = ChannelPointsCustomRewardRedemptionUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsCustomRewardRedemptionUpdateEvent) ProtoMessage() {}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsCustomRewardRedemptionUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPointsCustomRewardRedemptionUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetUserInput() string {
+	if x != nil {
+		return x.UserInput
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetReward() *Reward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *ChannelPointsCustomRewardRedemptionUpdateEvent) GetRedeemedAt() string {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return ""
+}
+
+type ChannelPollBeginEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Title                string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Choices              []*Choices             `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
+	ChannelPointsVoting  *ChannelPointsVoting   `protobuf:"bytes,7,opt,name=channel_points_voting,json=channelPointsVoting,proto3" json:"channel_points_voting,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndsAt               string                 `protobuf:"bytes,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelPollBeginEvent) Reset() {
+	*x = ChannelPollBeginEvent{}
+	mi := &file_eventsub_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPollBeginEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPollBeginEvent) ProtoMessage() {}
+
+func (x *ChannelPollBeginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPollBeginEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPollBeginEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ChannelPollBeginEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPollBeginEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPollBeginEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPollBeginEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPollBeginEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelPollBeginEvent) GetChoices() []*Choices {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *ChannelPollBeginEvent) GetChannelPointsVoting() *ChannelPointsVoting {
+	if x != nil {
+		return x.ChannelPointsVoting
+	}
+	return nil
+}
+
+func (x *ChannelPollBeginEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelPollBeginEvent) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+type ChannelPollProgressEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Title                string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Choices              []*Choices             `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
+	ChannelPointsVoting  *ChannelPointsVoting   `protobuf:"bytes,7,opt,name=channel_points_voting,json=channelPointsVoting,proto3" json:"channel_points_voting,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndsAt               string                 `protobuf:"bytes,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelPollProgressEvent) Reset() {
+	*x = ChannelPollProgressEvent{}
+	mi := &file_eventsub_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPollProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPollProgressEvent) ProtoMessage() {}
+
+func (x *ChannelPollProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPollProgressEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPollProgressEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ChannelPollProgressEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPollProgressEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPollProgressEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPollProgressEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPollProgressEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelPollProgressEvent) GetChoices() []*Choices {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *ChannelPollProgressEvent) GetChannelPointsVoting() *ChannelPointsVoting {
+	if x != nil {
+		return x.ChannelPointsVoting
+	}
+	return nil
+}
+
+func (x *ChannelPollProgressEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelPollProgressEvent) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+type ChannelPollEndEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Title                string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Choices              []*Choices             `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
+	ChannelPointsVoting  *ChannelPointsVoting   `protobuf:"bytes,7,opt,name=channel_points_voting,json=channelPointsVoting,proto3" json:"channel_points_voting,omitempty"`
+	Status               string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt              string                 `protobuf:"bytes,10,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelPollEndEvent) Reset() {
+	*x = ChannelPollEndEvent{}
+	mi := &file_eventsub_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPollEndEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPollEndEvent) ProtoMessage() {}
+
+func (x *ChannelPollEndEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPollEndEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPollEndEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ChannelPollEndEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPollEndEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPollEndEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPollEndEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPollEndEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelPollEndEvent) GetChoices() []*Choices {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *ChannelPollEndEvent) GetChannelPointsVoting() *ChannelPointsVoting {
+	if x != nil {
+		return x.ChannelPointsVoting
+	}
+	return nil
+}
+
+func (x *ChannelPollEndEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChannelPollEndEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelPollEndEvent) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+type ChannelPredictionBeginEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Title                string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Outcomes             []*Outcomes            `protobuf:"bytes,6,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	LocksAt              string                 `protobuf:"bytes,8,opt,name=locks_at,json=locksAt,proto3" json:"locks_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelPredictionBeginEvent) Reset() {
+	*x = ChannelPredictionBeginEvent{}
+	mi := &file_eventsub_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPredictionBeginEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPredictionBeginEvent) ProtoMessage() {}
+
+func (x *ChannelPredictionBeginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPredictionBeginEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPredictionBeginEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ChannelPredictionBeginEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPredictionBeginEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPredictionBeginEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPredictionBeginEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPredictionBeginEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelPredictionBeginEvent) GetOutcomes() []*Outcomes {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *ChannelPredictionBeginEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelPredictionBeginEvent) GetLocksAt() string {
+	if x != nil {
+		return x.LocksAt
+	}
+	return ""
+}
+
+type ChannelPredictionProgressEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Title                string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Outcomes             []*Outcomes            `protobuf:"bytes,6,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	LocksAt              string                 `protobuf:"bytes,8,opt,name=locks_at,json=locksAt,proto3" json:"locks_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelPredictionProgressEvent) Reset() {
+	*x = ChannelPredictionProgressEvent{}
+	mi := &file_eventsub_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPredictionProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPredictionProgressEvent) ProtoMessage() {}
+
+func (x *ChannelPredictionProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPredictionProgressEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPredictionProgressEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ChannelPredictionProgressEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPredictionProgressEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPredictionProgressEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPredictionProgressEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPredictionProgressEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelPredictionProgressEvent) GetOutcomes() []*Outcomes {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *ChannelPredictionProgressEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelPredictionProgressEvent) GetLocksAt() string {
+	if x != nil {
+		return x.LocksAt
+	}
+	return ""
+}
+
+type ChannelPredictionLockEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Title                string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Outcomes             []*Outcomes            `protobuf:"bytes,6,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	LockedAt             string                 `protobuf:"bytes,8,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelPredictionLockEvent) Reset() {
+	*x = ChannelPredictionLockEvent{}
+	mi := &file_eventsub_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPredictionLockEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPredictionLockEvent) ProtoMessage() {}
+
+func (x *ChannelPredictionLockEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPredictionLockEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPredictionLockEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ChannelPredictionLockEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPredictionLockEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPredictionLockEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPredictionLockEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPredictionLockEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelPredictionLockEvent) GetOutcomes() []*Outcomes {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *ChannelPredictionLockEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelPredictionLockEvent) GetLockedAt() string {
+	if x != nil {
+		return x.LockedAt
+	}
+	return ""
+}
+
+type ChannelPredictionEndEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Title                string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	WinningOutcomeId     string                 `protobuf:"bytes,6,opt,name=winning_outcome_id,json=winningOutcomeId,proto3" json:"winning_outcome_id,omitempty"`
+	Outcomes             []*Outcomes            `protobuf:"bytes,7,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	Status               string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt              string                 `protobuf:"bytes,10,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelPredictionEndEvent) Reset() {
+	*x = ChannelPredictionEndEvent{}
+	mi := &file_eventsub_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPredictionEndEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPredictionEndEvent) ProtoMessage() {}
+
+func (x *ChannelPredictionEndEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPredictionEndEvent.ProtoReflect.Descriptor instead.
+func (*ChannelPredictionEndEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ChannelPredictionEndEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelPredictionEndEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelPredictionEndEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelPredictionEndEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelPredictionEndEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelPredictionEndEvent) GetWinningOutcomeId() string {
+	if x != nil {
+		return x.WinningOutcomeId
+	}
+	return ""
+}
+
+func (x *ChannelPredictionEndEvent) GetOutcomes() []*Outcomes {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *ChannelPredictionEndEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChannelPredictionEndEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelPredictionEndEvent) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+type ChannelVIPAddEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelVIPAddEvent) Reset() {
+	*x = ChannelVIPAddEvent{}
+	mi := &file_eventsub_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelVIPAddEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelVIPAddEvent) ProtoMessage() {}
+
+func (x *ChannelVIPAddEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelVIPAddEvent.ProtoReflect.Descriptor instead.
+func (*ChannelVIPAddEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ChannelVIPAddEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelVIPAddEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelVIPAddEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelVIPAddEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelVIPAddEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelVIPAddEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+type ChannelVIPRemoveEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,6,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelVIPRemoveEvent) Reset() {
+	*x = ChannelVIPRemoveEvent{}
+	mi := &file_eventsub_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelVIPRemoveEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelVIPRemoveEvent) ProtoMessage() {}
+
+func (x *ChannelVIPRemoveEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelVIPRemoveEvent.ProtoReflect.Descriptor instead.
+func (*ChannelVIPRemoveEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ChannelVIPRemoveEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelVIPRemoveEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelVIPRemoveEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelVIPRemoveEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelVIPRemoveEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelVIPRemoveEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+type CharityCampaignDonateEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CampaignId           string                 `protobuf:"bytes,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,3,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,5,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	UserId               string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,7,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,8,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	CharityName          string                 `protobuf:"bytes,9,opt,name=charity_name,json=charityName,proto3" json:"charity_name,omitempty"`
+	CharityDescription   string                 `protobuf:"bytes,10,opt,name=charity_description,json=charityDescription,proto3" json:"charity_description,omitempty"`
+	CharityLogo          string                 `protobuf:"bytes,11,opt,name=charity_logo,json=charityLogo,proto3" json:"charity_logo,omitempty"`
+	CharityWebsite       string                 `protobuf:"bytes,12,opt,name=charity_website,json=charityWebsite,proto3" json:"charity_website,omitempty"`
+	Amount               *Amount                `protobuf:"bytes,13,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CharityCampaignDonateEvent) Reset() {
+	*x = CharityCampaignDonateEvent{}
+	mi := &file_eventsub_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharityCampaignDonateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharityCampaignDonateEvent) ProtoMessage() {}
+
+func (x *CharityCampaignDonateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharityCampaignDonateEvent.ProtoReflect.Descriptor instead.
+func (*CharityCampaignDonateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CharityCampaignDonateEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetCharityName() string {
+	if x != nil {
+		return x.CharityName
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetCharityDescription() string {
+	if x != nil {
+		return x.CharityDescription
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetCharityLogo() string {
+	if x != nil {
+		return x.CharityLogo
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetCharityWebsite() string {
+	if x != nil {
+		return x.CharityWebsite
+	}
+	return ""
+}
+
+func (x *CharityCampaignDonateEvent) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type CharityCampaignStartEvent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterId      string                 `protobuf:"bytes,2,opt,name=broadcaster_id,json=broadcasterId,proto3" json:"broadcaster_id,omitempty"`
+	BroadcasterName    string                 `protobuf:"bytes,3,opt,name=broadcaster_name,json=broadcasterName,proto3" json:"broadcaster_name,omitempty"`
+	BroadcasterLogin   string                 `protobuf:"bytes,4,opt,name=broadcaster_login,json=broadcasterLogin,proto3" json:"broadcaster_login,omitempty"`
+	CharityName        string                 `protobuf:"bytes,5,opt,name=charity_name,json=charityName,proto3" json:"charity_name,omitempty"`
+	CharityDescription string                 `protobuf:"bytes,6,opt,name=charity_description,json=charityDescription,proto3" json:"charity_description,omitempty"`
+	CharityLogo        string                 `protobuf:"bytes,7,opt,name=charity_logo,json=charityLogo,proto3" json:"charity_logo,omitempty"`
+	CharityWebsite     string                 `protobuf:"bytes,8,opt,name=charity_website,json=charityWebsite,proto3" json:"charity_website,omitempty"`
+	CurrentAmount      *Amount                `protobuf:"bytes,9,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	TargetAmount       *Amount                `protobuf:"bytes,10,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	StartedAt          string                 `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CharityCampaignStartEvent) Reset() {
+	*x = CharityCampaignStartEvent{}
+	mi := &file_eventsub_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharityCampaignStartEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharityCampaignStartEvent) ProtoMessage() {}
+
+func (x *CharityCampaignStartEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharityCampaignStartEvent.ProtoReflect.Descriptor instead.
+func (*CharityCampaignStartEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CharityCampaignStartEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CharityCampaignStartEvent) GetBroadcasterId() string {
+	if x != nil {
+		return x.BroadcasterId
+	}
+	return ""
+}
+
+func (x *CharityCampaignStartEvent) GetBroadcasterName() string {
+	if x != nil {
+		return x.BroadcasterName
+	}
+	return ""
+}
+
+func (x *CharityCampaignStartEvent) GetBroadcasterLogin() string {
+	if x != nil {
+		return x.BroadcasterLogin
+	}
+	return ""
+}
+
+func (x *CharityCampaignStartEvent) GetCharityName() string {
+	if x != nil {
+		return x.CharityName
+	}
+	return ""
+}
+
+func (x *CharityCampaignStartEvent) GetCharityDescription() string {
+	if x != nil {
+		return x.CharityDescription
+	}
+	return ""
+}
+
+func (x *CharityCampaignStartEvent) GetCharityLogo() string {
+	if x != nil {
+		return x.CharityLogo
+	}
+	return ""
+}
+
+func (x *CharityCampaignStartEvent) GetCharityWebsite() string {
+	if x != nil {
+		return x.CharityWebsite
+	}
+	return ""
+}
+
+func (x *CharityCampaignStartEvent) GetCurrentAmount() *Amount {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return nil
+}
+
+func (x *CharityCampaignStartEvent) GetTargetAmount() *Amount {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return nil
+}
+
+func (x *CharityCampaignStartEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+type CharityCampaignProgressEvent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterId      string                 `protobuf:"bytes,2,opt,name=broadcaster_id,json=broadcasterId,proto3" json:"broadcaster_id,omitempty"`
+	BroadcasterName    string                 `protobuf:"bytes,3,opt,name=broadcaster_name,json=broadcasterName,proto3" json:"broadcaster_name,omitempty"`
+	BroadcasterLogin   string                 `protobuf:"bytes,4,opt,name=broadcaster_login,json=broadcasterLogin,proto3" json:"broadcaster_login,omitempty"`
+	CharityName        string                 `protobuf:"bytes,5,opt,name=charity_name,json=charityName,proto3" json:"charity_name,omitempty"`
+	CharityDescription string                 `protobuf:"bytes,6,opt,name=charity_description,json=charityDescription,proto3" json:"charity_description,omitempty"`
+	CharityLogo        string                 `protobuf:"bytes,7,opt,name=charity_logo,json=charityLogo,proto3" json:"charity_logo,omitempty"`
+	CharityWebsite     string                 `protobuf:"bytes,8,opt,name=charity_website,json=charityWebsite,proto3" json:"charity_website,omitempty"`
+	CurrentAmount      *Amount                `protobuf:"bytes,9,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	TargetAmount       *Amount                `protobuf:"bytes,10,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CharityCampaignProgressEvent) Reset() {
+	*x = CharityCampaignProgressEvent{}
+	mi := &file_eventsub_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharityCampaignProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharityCampaignProgressEvent) ProtoMessage() {}
+
+func (x *CharityCampaignProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharityCampaignProgressEvent.ProtoReflect.Descriptor instead.
+func (*CharityCampaignProgressEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CharityCampaignProgressEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CharityCampaignProgressEvent) GetBroadcasterId() string {
+	if x != nil {
+		return x.BroadcasterId
+	}
+	return ""
+}
+
+func (x *CharityCampaignProgressEvent) GetBroadcasterName() string {
+	if x != nil {
+		return x.BroadcasterName
+	}
+	return ""
+}
+
+func (x *CharityCampaignProgressEvent) GetBroadcasterLogin() string {
+	if x != nil {
+		return x.BroadcasterLogin
+	}
+	return ""
+}
+
+func (x *CharityCampaignProgressEvent) GetCharityName() string {
+	if x != nil {
+		return x.CharityName
+	}
+	return ""
+}
+
+func (x *CharityCampaignProgressEvent) GetCharityDescription() string {
+	if x != nil {
+		return x.CharityDescription
+	}
+	return ""
+}
+
+func (x *CharityCampaignProgressEvent) GetCharityLogo() string {
+	if x != nil {
+		return x.CharityLogo
+	}
+	return ""
+}
+
+func (x *CharityCampaignProgressEvent) GetCharityWebsite() string {
+	if x != nil {
+		return x.CharityWebsite
+	}
+	return ""
+}
+
+func (x *CharityCampaignProgressEvent) GetCurrentAmount() *Amount {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return nil
+}
+
+func (x *CharityCampaignProgressEvent) GetTargetAmount() *Amount {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return nil
+}
+
+type CharityCampaignStopEvent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterId      string                 `protobuf:"bytes,2,opt,name=broadcaster_id,json=broadcasterId,proto3" json:"broadcaster_id,omitempty"`
+	BroadcasterName    string                 `protobuf:"bytes,3,opt,name=broadcaster_name,json=broadcasterName,proto3" json:"broadcaster_name,omitempty"`
+	BroadcasterLogin   string                 `protobuf:"bytes,4,opt,name=broadcaster_login,json=broadcasterLogin,proto3" json:"broadcaster_login,omitempty"`
+	CharityName        string                 `protobuf:"bytes,5,opt,name=charity_name,json=charityName,proto3" json:"charity_name,omitempty"`
+	CharityDescription string                 `protobuf:"bytes,6,opt,name=charity_description,json=charityDescription,proto3" json:"charity_description,omitempty"`
+	CharityLogo        string                 `protobuf:"bytes,7,opt,name=charity_logo,json=charityLogo,proto3" json:"charity_logo,omitempty"`
+	CharityWebsite     string                 `protobuf:"bytes,8,opt,name=charity_website,json=charityWebsite,proto3" json:"charity_website,omitempty"`
+	CurrentAmount      *Amount                `protobuf:"bytes,9,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	TargetAmount       *Amount                `protobuf:"bytes,10,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	StoppedAt          string                 `protobuf:"bytes,11,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CharityCampaignStopEvent) Reset() {
+	*x = CharityCampaignStopEvent{}
+	mi := &file_eventsub_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharityCampaignStopEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharityCampaignStopEvent) ProtoMessage() {}
+
+func (x *CharityCampaignStopEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharityCampaignStopEvent.ProtoReflect.Descriptor instead.
+func (*CharityCampaignStopEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CharityCampaignStopEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CharityCampaignStopEvent) GetBroadcasterId() string {
+	if x != nil {
+		return x.BroadcasterId
+	}
+	return ""
+}
+
+func (x *CharityCampaignStopEvent) GetBroadcasterName() string {
+	if x != nil {
+		return x.BroadcasterName
+	}
+	return ""
+}
+
+func (x *CharityCampaignStopEvent) GetBroadcasterLogin() string {
+	if x != nil {
+		return x.BroadcasterLogin
+	}
+	return ""
+}
+
+func (x *CharityCampaignStopEvent) GetCharityName() string {
+	if x != nil {
+		return x.CharityName
+	}
+	return ""
+}
+
+func (x *CharityCampaignStopEvent) GetCharityDescription() string {
+	if x != nil {
+		return x.CharityDescription
+	}
+	return ""
+}
+
+func (x *CharityCampaignStopEvent) GetCharityLogo() string {
+	if x != nil {
+		return x.CharityLogo
+	}
+	return ""
+}
+
+func (x *CharityCampaignStopEvent) GetCharityWebsite() string {
+	if x != nil {
+		return x.CharityWebsite
+	}
+	return ""
+}
+
+func (x *CharityCampaignStopEvent) GetCurrentAmount() *Amount {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return nil
+}
+
+func (x *CharityCampaignStopEvent) GetTargetAmount() *Amount {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return nil
+}
+
+func (x *CharityCampaignStopEvent) GetStoppedAt() string {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return ""
+}
+
+type ConduitShardDisabledEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConduitId     string                 `protobuf:"bytes,1,opt,name=conduit_id,json=conduitId,proto3" json:"conduit_id,omitempty"`
+	ShardId       string                 `protobuf:"bytes,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Transport     *ConduitTransport      `protobuf:"bytes,4,opt,name=transport,proto3" json:"transport,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConduitShardDisabledEvent) Reset() {
+	*x = ConduitShardDisabledEvent{}
+	mi := &file_eventsub_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConduitShardDisabledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConduitShardDisabledEvent) ProtoMessage() {}
+
+func (x *ConduitShardDisabledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConduitShardDisabledEvent.ProtoReflect.Descriptor instead.
+func (*ConduitShardDisabledEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ConduitShardDisabledEvent) GetConduitId() string {
+	if x != nil {
+		return x.ConduitId
+	}
+	return ""
+}
+
+func (x *ConduitShardDisabledEvent) GetShardId() string {
+	if x != nil {
+		return x.ShardId
+	}
+	return ""
+}
+
+func (x *ConduitShardDisabledEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ConduitShardDisabledEvent) GetTransport() *ConduitTransport {
+	if x != nil {
+		return x.Transport
+	}
+	return nil
+}
+
+type DropEntitlementGrantEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data          *DropData              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropEntitlementGrantEvent) Reset() {
+	*x = DropEntitlementGrantEvent{}
+	mi := &file_eventsub_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropEntitlementGrantEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropEntitlementGrantEvent) ProtoMessage() {}
+
+func (x *DropEntitlementGrantEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropEntitlementGrantEvent.ProtoReflect.Descriptor instead.
+func (*DropEntitlementGrantEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DropEntitlementGrantEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DropEntitlementGrantEvent) GetData() *DropData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExtensionBitsTransactionCreateEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExtensionClientId    string                 `protobuf:"bytes,2,opt,name=extension_client_id,json=extensionClientId,proto3" json:"extension_client_id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,3,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,4,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,5,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	UserName             string                 `protobuf:"bytes,6,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,7,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserId               string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Product              *Product               `protobuf:"bytes,9,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) Reset() {
+	*x = ExtensionBitsTransactionCreateEvent{}
+	mi := &file_eventsub_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionBitsTransactionCreateEvent) ProtoMessage() {}
+
+func (x *ExtensionBitsTransactionCreateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionBitsTransactionCreateEvent.ProtoReflect.Descriptor instead.
+func (*ExtensionBitsTransactionCreateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) GetExtensionClientId() string {
+	if x != nil {
+		return x.ExtensionClientId
+	}
+	return ""
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExtensionBitsTransactionCreateEvent) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ChannelGoalBeginEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,4,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	Type                 string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Description          string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CurrentAmount        int64                  `protobuf:"varint,7,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	TargetAmount         int64                  `protobuf:"varint,8,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelGoalBeginEvent) Reset() {
+	*x = ChannelGoalBeginEvent{}
+	mi := &file_eventsub_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelGoalBeginEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelGoalBeginEvent) ProtoMessage() {}
+
+func (x *ChannelGoalBeginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelGoalBeginEvent.ProtoReflect.Descriptor instead.
+func (*ChannelGoalBeginEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ChannelGoalBeginEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelGoalBeginEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelGoalBeginEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelGoalBeginEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGoalBeginEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelGoalBeginEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ChannelGoalBeginEvent) GetCurrentAmount() int64 {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return 0
+}
+
+func (x *ChannelGoalBeginEvent) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *ChannelGoalBeginEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+type ChannelGoalProgressEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,4,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	Type                 string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Description          string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CurrentAmount        int64                  `protobuf:"varint,7,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	TargetAmount         int64                  `protobuf:"varint,8,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelGoalProgressEvent) Reset() {
+	*x = ChannelGoalProgressEvent{}
+	mi := &file_eventsub_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelGoalProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelGoalProgressEvent) ProtoMessage() {}
+
+func (x *ChannelGoalProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelGoalProgressEvent.ProtoReflect.Descriptor instead.
+func (*ChannelGoalProgressEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ChannelGoalProgressEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelGoalProgressEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelGoalProgressEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelGoalProgressEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGoalProgressEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelGoalProgressEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ChannelGoalProgressEvent) GetCurrentAmount() int64 {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return 0
+}
+
+func (x *ChannelGoalProgressEvent) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *ChannelGoalProgressEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+type ChannelGoalEndEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,4,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	Type                 string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Description          string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	IsAchieved           bool                   `protobuf:"varint,7,opt,name=is_achieved,json=isAchieved,proto3" json:"is_achieved,omitempty"`
+	CurrentAmount        int64                  `protobuf:"varint,8,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	TargetAmount         int64                  `protobuf:"varint,9,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt              string                 `protobuf:"bytes,11,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelGoalEndEvent) Reset() {
+	*x = ChannelGoalEndEvent{}
+	mi := &file_eventsub_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelGoalEndEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelGoalEndEvent) ProtoMessage() {}
+
+func (x *ChannelGoalEndEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelGoalEndEvent.ProtoReflect.Descriptor instead.
+func (*ChannelGoalEndEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ChannelGoalEndEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelGoalEndEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelGoalEndEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelGoalEndEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelGoalEndEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelGoalEndEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ChannelGoalEndEvent) GetIsAchieved() bool {
+	if x != nil {
+		return x.IsAchieved
+	}
+	return false
+}
+
+func (x *ChannelGoalEndEvent) GetCurrentAmount() int64 {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return 0
+}
+
+func (x *ChannelGoalEndEvent) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *ChannelGoalEndEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelGoalEndEvent) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+type ChannelHypeTrainBeginEventV2 struct {
+	state                   protoimpl.MessageState    `protogen:"open.v1"`
+	Id                      string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId       string                    `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin    string                    `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName     string                    `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Total                   int64                     `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Progress                int64                     `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	Goal                    int64                     `protobuf:"varint,7,opt,name=goal,proto3" json:"goal,omitempty"`
+	TopContributions        []*Contribution           `protobuf:"bytes,8,rep,name=top_contributions,json=topContributions,proto3" json:"top_contributions,omitempty"`
+	Level                   int64                     `protobuf:"varint,9,opt,name=level,proto3" json:"level,omitempty"`
+	AllTimeHighLevel        int64                     `protobuf:"varint,10,opt,name=all_time_high_level,json=allTimeHighLevel,proto3" json:"all_time_high_level,omitempty"`
+	AllTimeHighTotal        int64                     `protobuf:"varint,11,opt,name=all_time_high_total,json=allTimeHighTotal,proto3" json:"all_time_high_total,omitempty"`
+	SharedTrainParticipants []*SharedTrainParticipant `protobuf:"bytes,12,rep,name=shared_train_participants,json=sharedTrainParticipants,proto3" json:"shared_train_participants,omitempty"`
+	StartedAt               string                    `protobuf:"bytes,13,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	ExpiresAt               string                    `protobuf:"bytes,14,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Type                    string                    `protobuf:"bytes,15,opt,name=type,proto3" json:"type,omitempty"`
+	IsSharedTrain           bool                      `protobuf:"varint,16,opt,name=is_shared_train,json=isSharedTrain,proto3" json:"is_shared_train,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ChannelHypeTrainBeginEventV2) Reset() {
+	*x = ChannelHypeTrainBeginEventV2{}
+	mi := &file_eventsub_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelHypeTrainBeginEventV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelHypeTrainBeginEventV2) ProtoMessage() {}
+
+func (x *ChannelHypeTrainBeginEventV2) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelHypeTrainBeginEventV2.ProtoReflect.Descriptor instead.
+func (*ChannelHypeTrainBeginEventV2) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetGoal() int64 {
+	if x != nil {
+		return x.Goal
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetTopContributions() []*Contribution {
+	if x != nil {
+		return x.TopContributions
+	}
+	return nil
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetAllTimeHighLevel() int64 {
+	if x != nil {
+		return x.AllTimeHighLevel
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetAllTimeHighTotal() int64 {
+	if x != nil {
+		return x.AllTimeHighTotal
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetSharedTrainParticipants() []*SharedTrainParticipant {
+	if x != nil {
+		return x.SharedTrainParticipants
+	}
+	return nil
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainBeginEventV2) GetIsSharedTrain() bool {
+	if x != nil {
+		return x.IsSharedTrain
+	}
+	return false
+}
+
+type ChannelHypeTrainProgressEventV2 struct {
+	state                   protoimpl.MessageState    `protogen:"open.v1"`
+	Id                      string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId       string                    `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin    string                    `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName     string                    `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Total                   int64                     `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Progress                int64                     `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	Goal                    int64                     `protobuf:"varint,7,opt,name=goal,proto3" json:"goal,omitempty"`
+	TopContributions        []*Contribution           `protobuf:"bytes,8,rep,name=top_contributions,json=topContributions,proto3" json:"top_contributions,omitempty"`
+	Level                   int64                     `protobuf:"varint,9,opt,name=level,proto3" json:"level,omitempty"`
+	SharedTrainParticipants []*SharedTrainParticipant `protobuf:"bytes,10,rep,name=shared_train_participants,json=sharedTrainParticipants,proto3" json:"shared_train_participants,omitempty"`
+	StartedAt               string                    `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	ExpiresAt               string                    `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Type                    string                    `protobuf:"bytes,13,opt,name=type,proto3" json:"type,omitempty"`
+	IsSharedTrain           bool                      `protobuf:"varint,14,opt,name=is_shared_train,json=isSharedTrain,proto3" json:"is_shared_train,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ChannelHypeTrainProgressEventV2) Reset() {
+	*x = ChannelHypeTrainProgressEventV2{}
+	mi := &file_eventsub_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelHypeTrainProgressEventV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelHypeTrainProgressEventV2) ProtoMessage() {}
+
+func (x *ChannelHypeTrainProgressEventV2) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelHypeTrainProgressEventV2.ProtoReflect.Descriptor instead.
+func (*ChannelHypeTrainProgressEventV2) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetGoal() int64 {
+	if x != nil {
+		return x.Goal
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetTopContributions() []*Contribution {
+	if x != nil {
+		return x.TopContributions
+	}
+	return nil
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetSharedTrainParticipants() []*SharedTrainParticipant {
+	if x != nil {
+		return x.SharedTrainParticipants
+	}
+	return nil
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainProgressEventV2) GetIsSharedTrain() bool {
+	if x != nil {
+		return x.IsSharedTrain
+	}
+	return false
+}
+
+type ChannelHypeTrainEndEventV2 struct {
+	state                   protoimpl.MessageState    `protogen:"open.v1"`
+	Id                      string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId       string                    `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin    string                    `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName     string                    `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Total                   int64                     `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	TopContributions        []*Contribution           `protobuf:"bytes,6,rep,name=top_contributions,json=topContributions,proto3" json:"top_contributions,omitempty"`
+	Level                   int64                     `protobuf:"varint,7,opt,name=level,proto3" json:"level,omitempty"`
+	SharedTrainParticipants []*SharedTrainParticipant `protobuf:"bytes,8,rep,name=shared_train_participants,json=sharedTrainParticipants,proto3" json:"shared_train_participants,omitempty"`
+	StartedAt               string                    `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CooldownEndsAt          string                    `protobuf:"bytes,10,opt,name=cooldown_ends_at,json=cooldownEndsAt,proto3" json:"cooldown_ends_at,omitempty"`
+	EndedAt                 string                    `protobuf:"bytes,11,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Type                    string                    `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	IsSharedTrain           bool                      `protobuf:"varint,13,opt,name=is_shared_train,json=isSharedTrain,proto3" json:"is_shared_train,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ChannelHypeTrainEndEventV2) Reset() {
+	*x = ChannelHypeTrainEndEventV2{}
+	mi := &file_eventsub_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelHypeTrainEndEventV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelHypeTrainEndEventV2) ProtoMessage() {}
+
+func (x *ChannelHypeTrainEndEventV2) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelHypeTrainEndEventV2.ProtoReflect.Descriptor instead.
+func (*ChannelHypeTrainEndEventV2) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetTopContributions() []*Contribution {
+	if x != nil {
+		return x.TopContributions
+	}
+	return nil
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetSharedTrainParticipants() []*SharedTrainParticipant {
+	if x != nil {
+		return x.SharedTrainParticipants
+	}
+	return nil
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetCooldownEndsAt() string {
+	if x != nil {
+		return x.CooldownEndsAt
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelHypeTrainEndEventV2) GetIsSharedTrain() bool {
+	if x != nil {
+		return x.IsSharedTrain
+	}
+	return false
+}
+
+type ChannelShieldModeBeginEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,4,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,5,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,6,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelShieldModeBeginEvent) Reset() {
+	*x = ChannelShieldModeBeginEvent{}
+	mi := &file_eventsub_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelShieldModeBeginEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelShieldModeBeginEvent) ProtoMessage() {}
+
+func (x *ChannelShieldModeBeginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelShieldModeBeginEvent.ProtoReflect.Descriptor instead.
+func (*ChannelShieldModeBeginEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ChannelShieldModeBeginEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeBeginEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeBeginEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeBeginEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeBeginEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeBeginEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeBeginEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+type ChannelShieldModeEndEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,4,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,5,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,6,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	EndedAt              string                 `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelShieldModeEndEvent) Reset() {
+	*x = ChannelShieldModeEndEvent{}
+	mi := &file_eventsub_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelShieldModeEndEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelShieldModeEndEvent) ProtoMessage() {}
+
+func (x *ChannelShieldModeEndEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelShieldModeEndEvent.ProtoReflect.Descriptor instead.
+func (*ChannelShieldModeEndEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ChannelShieldModeEndEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeEndEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeEndEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeEndEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeEndEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeEndEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelShieldModeEndEvent) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+type ChannelShoutOutCreateEvent struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId      string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin   string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	ModeratorUserId        string                 `protobuf:"bytes,4,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserName      string                 `protobuf:"bytes,5,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	ModeratorUserLogin     string                 `protobuf:"bytes,6,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	ToBroadcasterUserId    string                 `protobuf:"bytes,7,opt,name=to_broadcaster_user_id,json=toBroadcasterUserId,proto3" json:"to_broadcaster_user_id,omitempty"`
+	ToBroadcasterUserName  string                 `protobuf:"bytes,8,opt,name=to_broadcaster_user_name,json=toBroadcasterUserName,proto3" json:"to_broadcaster_user_name,omitempty"`
+	ToBroadcasterUserLogin string                 `protobuf:"bytes,9,opt,name=to_broadcaster_user_login,json=toBroadcasterUserLogin,proto3" json:"to_broadcaster_user_login,omitempty"`
+	StartedAt              string                 `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	ViewerCount            int64                  `protobuf:"varint,11,opt,name=viewer_count,json=viewerCount,proto3" json:"viewer_count,omitempty"`
+	CooldownEndsAt         string                 `protobuf:"bytes,12,opt,name=cooldown_ends_at,json=cooldownEndsAt,proto3" json:"cooldown_ends_at,omitempty"`
+	TargetCooldownEndsAt   string                 `protobuf:"bytes,13,opt,name=target_cooldown_ends_at,json=targetCooldownEndsAt,proto3" json:"target_cooldown_ends_at,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ChannelShoutOutCreateEvent) Reset() {
+	*x = ChannelShoutOutCreateEvent{}
+	mi := &file_eventsub_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelShoutOutCreateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelShoutOutCreateEvent) ProtoMessage() {}
+
+func (x *ChannelShoutOutCreateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelShoutOutCreateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelShoutOutCreateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ChannelShoutOutCreateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetToBroadcasterUserId() string {
+	if x != nil {
+		return x.ToBroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetToBroadcasterUserName() string {
+	if x != nil {
+		return x.ToBroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetToBroadcasterUserLogin() string {
+	if x != nil {
+		return x.ToBroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetViewerCount() int64 {
+	if x != nil {
+		return x.ViewerCount
+	}
+	return 0
+}
+
+func (x *ChannelShoutOutCreateEvent) GetCooldownEndsAt() string {
+	if x != nil {
+		return x.CooldownEndsAt
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutCreateEvent) GetTargetCooldownEndsAt() string {
+	if x != nil {
+		return x.TargetCooldownEndsAt
+	}
+	return ""
+}
+
+type ChannelShoutOutReceivedEvent struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId        string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName      string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin     string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	FromBroadcasterUserId    string                 `protobuf:"bytes,4,opt,name=from_broadcaster_user_id,json=fromBroadcasterUserId,proto3" json:"from_broadcaster_user_id,omitempty"`
+	FromBroadcasterUserName  string                 `protobuf:"bytes,5,opt,name=from_broadcaster_user_name,json=fromBroadcasterUserName,proto3" json:"from_broadcaster_user_name,omitempty"`
+	FromBroadcasterUserLogin string                 `protobuf:"bytes,6,opt,name=from_broadcaster_user_login,json=fromBroadcasterUserLogin,proto3" json:"from_broadcaster_user_login,omitempty"`
+	ViewerCount              int64                  `protobuf:"varint,7,opt,name=viewer_count,json=viewerCount,proto3" json:"viewer_count,omitempty"`
+	StartedAt                string                 `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ChannelShoutOutReceivedEvent) Reset() {
+	*x = ChannelShoutOutReceivedEvent{}
+	mi := &file_eventsub_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelShoutOutReceivedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelShoutOutReceivedEvent) ProtoMessage() {}
+
+func (x *ChannelShoutOutReceivedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelShoutOutReceivedEvent.ProtoReflect.Descriptor instead.
+func (*ChannelShoutOutReceivedEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ChannelShoutOutReceivedEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutReceivedEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutReceivedEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutReceivedEvent) GetFromBroadcasterUserId() string {
+	if x != nil {
+		return x.FromBroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutReceivedEvent) GetFromBroadcasterUserName() string {
+	if x != nil {
+		return x.FromBroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutReceivedEvent) GetFromBroadcasterUserLogin() string {
+	if x != nil {
+		return x.FromBroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelShoutOutReceivedEvent) GetViewerCount() int64 {
+	if x != nil {
+		return x.ViewerCount
+	}
+	return 0
+}
+
+func (x *ChannelShoutOutReceivedEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+type StreamOnlineEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BroadcasterUserId    string                 `protobuf:"bytes,2,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,4,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	Type                 string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	StartedAt            string                 `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *StreamOnlineEvent) Reset() {
+	*x = StreamOnlineEvent{}
+	mi := &file_eventsub_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamOnlineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOnlineEvent) ProtoMessage() {}
+
+func (x *StreamOnlineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOnlineEvent.ProtoReflect.Descriptor instead.
+func (*StreamOnlineEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StreamOnlineEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamOnlineEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *StreamOnlineEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *StreamOnlineEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *StreamOnlineEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StreamOnlineEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+type StreamOfflineEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *StreamOfflineEvent) Reset() {
+	*x = StreamOfflineEvent{}
+	mi := &file_eventsub_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamOfflineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOfflineEvent) ProtoMessage() {}
+
+func (x *StreamOfflineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOfflineEvent.ProtoReflect.Descriptor instead.
+func (*StreamOfflineEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StreamOfflineEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *StreamOfflineEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *StreamOfflineEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+type UserAuthorizationGrantEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,3,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserAuthorizationGrantEvent) Reset() {
+	*x = UserAuthorizationGrantEvent{}
+	mi := &file_eventsub_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAuthorizationGrantEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAuthorizationGrantEvent) ProtoMessage() {}
+
+func (x *UserAuthorizationGrantEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAuthorizationGrantEvent.ProtoReflect.Descriptor instead.
+func (*UserAuthorizationGrantEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UserAuthorizationGrantEvent) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *UserAuthorizationGrantEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserAuthorizationGrantEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *UserAuthorizationGrantEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type UserAuthorizationRevokeEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin     *string                `protobuf:"bytes,3,opt,name=user_login,json=userLogin,proto3,oneof" json:"user_login,omitempty"`
+	UserName      *string                `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserAuthorizationRevokeEvent) Reset() {
+	*x = UserAuthorizationRevokeEvent{}
+	mi := &file_eventsub_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAuthorizationRevokeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAuthorizationRevokeEvent) ProtoMessage() {}
+
+func (x *UserAuthorizationRevokeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAuthorizationRevokeEvent.ProtoReflect.Descriptor instead.
+func (*UserAuthorizationRevokeEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UserAuthorizationRevokeEvent) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *UserAuthorizationRevokeEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserAuthorizationRevokeEvent) GetUserLogin() string {
+	if x != nil && x.UserLogin != nil {
+		return *x.UserLogin
+	}
+	return ""
+}
+
+func (x *UserAuthorizationRevokeEvent) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+type UserUpdateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,5,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUpdateEvent) Reset() {
+	*x = UserUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUpdateEvent) ProtoMessage() {}
+
+func (x *UserUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUpdateEvent.ProtoReflect.Descriptor instead.
+func (*UserUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UserUpdateEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserUpdateEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *UserUpdateEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserUpdateEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserUpdateEvent) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *UserUpdateEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UserWhisperMessageEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    string                 `protobuf:"bytes,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	FromUserLogin string                 `protobuf:"bytes,2,opt,name=from_user_login,json=fromUserLogin,proto3" json:"from_user_login,omitempty"`
+	FromUserName  string                 `protobuf:"bytes,3,opt,name=from_user_name,json=fromUserName,proto3" json:"from_user_name,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,4,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	ToUserLogin   string                 `protobuf:"bytes,5,opt,name=to_user_login,json=toUserLogin,proto3" json:"to_user_login,omitempty"`
+	ToUserName    string                 `protobuf:"bytes,6,opt,name=to_user_name,json=toUserName,proto3" json:"to_user_name,omitempty"`
+	WhisperId     string                 `protobuf:"bytes,7,opt,name=whisper_id,json=whisperId,proto3" json:"whisper_id,omitempty"`
+	Whisper       *Whisper               `protobuf:"bytes,8,opt,name=whisper,proto3" json:"whisper,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserWhisperMessageEvent) Reset() {
+	*x = UserWhisperMessageEvent{}
+	mi := &file_eventsub_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWhisperMessageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWhisperMessageEvent) ProtoMessage() {}
+
+func (x *UserWhisperMessageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWhisperMessageEvent.ProtoReflect.Descriptor instead.
+func (*UserWhisperMessageEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UserWhisperMessageEvent) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *UserWhisperMessageEvent) GetFromUserLogin() string {
+	if x != nil {
+		return x.FromUserLogin
+	}
+	return ""
+}
+
+func (x *UserWhisperMessageEvent) GetFromUserName() string {
+	if x != nil {
+		return x.FromUserName
+	}
+	return ""
+}
+
+func (x *UserWhisperMessageEvent) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *UserWhisperMessageEvent) GetToUserLogin() string {
+	if x != nil {
+		return x.ToUserLogin
+	}
+	return ""
+}
+
+func (x *UserWhisperMessageEvent) GetToUserName() string {
+	if x != nil {
+		return x.ToUserName
+	}
+	return ""
+}
+
+func (x *UserWhisperMessageEvent) GetWhisperId() string {
+	if x != nil {
+		return x.WhisperId
+	}
+	return ""
+}
+
+func (x *UserWhisperMessageEvent) GetWhisper() *Whisper {
+	if x != nil {
+		return x.Whisper
+	}
+	return nil
+}
+
+type ChannelSuspiciousUserUpdateEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,2,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,3,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,4,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,5,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,6,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	UserId               string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName             string                 `protobuf:"bytes,8,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,9,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	LowTrustStatus       string                 `protobuf:"bytes,10,opt,name=low_trust_status,json=lowTrustStatus,proto3" json:"low_trust_status,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) Reset() {
+	*x = ChannelSuspiciousUserUpdateEvent{}
+	mi := &file_eventsub_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelSuspiciousUserUpdateEvent) ProtoMessage() {}
+
+func (x *ChannelSuspiciousUserUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelSuspiciousUserUpdateEvent.ProtoReflect.Descriptor instead.
+func (*ChannelSuspiciousUserUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserUpdateEvent) GetLowTrustStatus() string {
+	if x != nil {
+		return x.LowTrustStatus
+	}
+	return ""
+}
+
+type ChannelBitsUseEvent struct {
+	state                protoimpl.MessageState      `protogen:"open.v1"`
+	BroadcasterUserId    string                      `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                      `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                      `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	UserId               string                      `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                      `protobuf:"bytes,5,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                      `protobuf:"bytes,6,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Bits                 int64                       `protobuf:"varint,7,opt,name=bits,proto3" json:"bits,omitempty"`
+	Type                 string                      `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	Message              *ChannelBitsUseEventMessage `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	PowerUp              *ChannelBitsUseEventPowerUp `protobuf:"bytes,10,opt,name=power_up,json=powerUp,proto3" json:"power_up,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelBitsUseEvent) Reset() {
+	*x = ChannelBitsUseEvent{}
+	mi := &file_eventsub_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelBitsUseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelBitsUseEvent) ProtoMessage() {}
+
+func (x *ChannelBitsUseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelBitsUseEvent.ProtoReflect.Descriptor instead.
+func (*ChannelBitsUseEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ChannelBitsUseEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEvent) GetBits() int64 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+func (x *ChannelBitsUseEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEvent) GetMessage() *ChannelBitsUseEventMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ChannelBitsUseEvent) GetPowerUp() *ChannelBitsUseEventPowerUp {
+	if x != nil {
+		return x.PowerUp
+	}
+	return nil
+}
+
+type ChannelSuspiciousUserMessageEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	UserId               string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,5,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,6,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	LowTrustStatus       string                 `protobuf:"bytes,7,opt,name=low_trust_status,json=lowTrustStatus,proto3" json:"low_trust_status,omitempty"`
+	SharedBanChannelIds  []string               `protobuf:"bytes,8,rep,name=shared_ban_channel_ids,json=sharedBanChannelIds,proto3" json:"shared_ban_channel_ids,omitempty"`
+	Types                []string               `protobuf:"bytes,9,rep,name=types,proto3" json:"types,omitempty"`
+	BanEvasionEvaluation string                 `protobuf:"bytes,10,opt,name=ban_evasion_evaluation,json=banEvasionEvaluation,proto3" json:"ban_evasion_evaluation,omitempty"`
+	Message              *SuspiciousMessage     `protobuf:"bytes,11,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) Reset() {
+	*x = ChannelSuspiciousUserMessageEvent{}
+	mi := &file_eventsub_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelSuspiciousUserMessageEvent) ProtoMessage() {}
+
+func (x *ChannelSuspiciousUserMessageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelSuspiciousUserMessageEvent.ProtoReflect.Descriptor instead.
+func (*ChannelSuspiciousUserMessageEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetLowTrustStatus() string {
+	if x != nil {
+		return x.LowTrustStatus
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetSharedBanChannelIds() []string {
+	if x != nil {
+		return x.SharedBanChannelIds
+	}
+	return nil
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetBanEvasionEvaluation() string {
+	if x != nil {
+		return x.BanEvasionEvaluation
+	}
+	return ""
+}
+
+func (x *ChannelSuspiciousUserMessageEvent) GetMessage() *SuspiciousMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ChannelWarningAcknowledgeEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	UserId               string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,5,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,6,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelWarningAcknowledgeEvent) Reset() {
+	*x = ChannelWarningAcknowledgeEvent{}
+	mi := &file_eventsub_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelWarningAcknowledgeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelWarningAcknowledgeEvent) ProtoMessage() {}
+
+func (x *ChannelWarningAcknowledgeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelWarningAcknowledgeEvent.ProtoReflect.Descriptor instead.
+func (*ChannelWarningAcknowledgeEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ChannelWarningAcknowledgeEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelWarningAcknowledgeEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelWarningAcknowledgeEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelWarningAcknowledgeEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelWarningAcknowledgeEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelWarningAcknowledgeEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type ChannelWarningSendEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	ModeratorUserId      string                 `protobuf:"bytes,4,opt,name=moderator_user_id,json=moderatorUserId,proto3" json:"moderator_user_id,omitempty"`
+	ModeratorUserLogin   string                 `protobuf:"bytes,5,opt,name=moderator_user_login,json=moderatorUserLogin,proto3" json:"moderator_user_login,omitempty"`
+	ModeratorUserName    string                 `protobuf:"bytes,6,opt,name=moderator_user_name,json=moderatorUserName,proto3" json:"moderator_user_name,omitempty"`
+	UserId               string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin            string                 `protobuf:"bytes,8,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName             string                 `protobuf:"bytes,9,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Reason               *string                `protobuf:"bytes,10,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ChatRulesCited       []string               `protobuf:"bytes,11,rep,name=chat_rules_cited,json=chatRulesCited,proto3" json:"chat_rules_cited,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChannelWarningSendEvent) Reset() {
+	*x = ChannelWarningSendEvent{}
+	mi := &file_eventsub_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelWarningSendEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelWarningSendEvent) ProtoMessage() {}
+
+func (x *ChannelWarningSendEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelWarningSendEvent.ProtoReflect.Descriptor instead.
+func (*ChannelWarningSendEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ChannelWarningSendEvent) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *ChannelWarningSendEvent) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelWarningSendEvent) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+func (x *ChannelWarningSendEvent) GetModeratorUserId() string {
+	if x != nil {
+		return x.ModeratorUserId
+	}
+	return ""
+}
+
+func (x *ChannelWarningSendEvent) GetModeratorUserLogin() string {
+	if x != nil {
+		return x.ModeratorUserLogin
+	}
+	return ""
+}
+
+func (x *ChannelWarningSendEvent) GetModeratorUserName() string {
+	if x != nil {
+		return x.ModeratorUserName
+	}
+	return ""
+}
+
+func (x *ChannelWarningSendEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelWarningSendEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ChannelWarningSendEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ChannelWarningSendEvent) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ChannelWarningSendEvent) GetChatRulesCited() []string {
+	if x != nil {
+		return x.ChatRulesCited
+	}
+	return nil
+}
+
+type AutomodMessageHoldMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Fragments     []*Fragment            `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutomodMessageHoldMessage) Reset() {
+	*x = AutomodMessageHoldMessage{}
+	mi := &file_eventsub_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutomodMessageHoldMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodMessageHoldMessage) ProtoMessage() {}
+
+func (x *AutomodMessageHoldMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodMessageHoldMessage.ProtoReflect.Descriptor instead.
+func (*AutomodMessageHoldMessage) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AutomodMessageHoldMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AutomodMessageHoldMessage) GetFragments() []*Fragment {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+type Fragment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Emotes        []*Emote               `protobuf:"bytes,3,rep,name=emotes,proto3" json:"emotes,omitempty"`
+	Cheermotes    []*Cheermote           `protobuf:"bytes,4,rep,name=cheermotes,proto3" json:"cheermotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fragment) Reset() {
+	*x = Fragment{}
+	mi := &file_eventsub_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fragment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fragment) ProtoMessage() {}
+
+func (x *Fragment) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fragment.ProtoReflect.Descriptor instead.
+func (*Fragment) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Fragment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Fragment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Fragment) GetEmotes() []*Emote {
+	if x != nil {
+		return x.Emotes
+	}
+	return nil
+}
+
+func (x *Fragment) GetCheermotes() []*Cheermote {
+	if x != nil {
+		return x.Cheermotes
+	}
+	return nil
+}
+
+type AutomodSettingsUpdateEventData struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterId           string                 `protobuf:"bytes,1,opt,name=broadcaster_id,json=broadcasterId,proto3" json:"broadcaster_id,omitempty"`
+	ModeratorId             string                 `protobuf:"bytes,2,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	OverallLevel            *int64                 `protobuf:"varint,3,opt,name=overall_level,json=overallLevel,proto3,oneof" json:"overall_level,omitempty"`
+	Disability              int64                  `protobuf:"varint,4,opt,name=disability,proto3" json:"disability,omitempty"`
+	Aggression              int64                  `protobuf:"varint,5,opt,name=aggression,proto3" json:"aggression,omitempty"`
+	SexualitySexOrGender    int64                  `protobuf:"varint,6,opt,name=sexuality_sex_or_gender,json=sexualitySexOrGender,proto3" json:"sexuality_sex_or_gender,omitempty"`
+	Misogyny                int64                  `protobuf:"varint,7,opt,name=misogyny,proto3" json:"misogyny,omitempty"`
+	Bullying                int64                  `protobuf:"varint,8,opt,name=bullying,proto3" json:"bullying,omitempty"`
+	Swearing                int64                  `protobuf:"varint,9,opt,name=swearing,proto3" json:"swearing,omitempty"`
+	RaceEthnicityOrReligion int64                  `protobuf:"varint,10,opt,name=race_ethnicity_or_religion,json=raceEthnicityOrReligion,proto3" json:"race_ethnicity_or_religion,omitempty"`
+	SexBasedTerms           int64                  `protobuf:"varint,11,opt,name=sex_based_terms,json=sexBasedTerms,proto3" json:"sex_based_terms,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AutomodSettingsUpdateEventData) Reset() {
+	*x = AutomodSettingsUpdateEventData{}
+	mi := &file_eventsub_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutomodSettingsUpdateEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomodSettingsUpdateEventData) ProtoMessage() {}
+
+func (x *AutomodSettingsUpdateEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomodSettingsUpdateEventData.ProtoReflect.Descriptor instead.
+func (*AutomodSettingsUpdateEventData) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AutomodSettingsUpdateEventData) GetBroadcasterId() string {
+	if x != nil {
+		return x.BroadcasterId
+	}
+	return ""
+}
+
+func (x *AutomodSettingsUpdateEventData) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *AutomodSettingsUpdateEventData) GetOverallLevel() int64 {
+	if x != nil && x.OverallLevel != nil {
+		return *x.OverallLevel
+	}
+	return 0
+}
+
+func (x *AutomodSettingsUpdateEventData) GetDisability() int64 {
+	if x != nil {
+		return x.Disability
+	}
+	return 0
+}
+
+func (x *AutomodSettingsUpdateEventData) GetAggression() int64 {
+	if x != nil {
+		return x.Aggression
+	}
+	return 0
+}
+
+func (x *AutomodSettingsUpdateEventData) GetSexualitySexOrGender() int64 {
+	if x != nil {
+		return x.SexualitySexOrGender
+	}
+	return 0
+}
+
+func (x *AutomodSettingsUpdateEventData) GetMisogyny() int64 {
+	if x != nil {
+		return x.Misogyny
+	}
+	return 0
+}
+
+func (x *AutomodSettingsUpdateEventData) GetBullying() int64 {
+	if x != nil {
+		return x.Bullying
+	}
+	return 0
+}
+
+func (x *AutomodSettingsUpdateEventData) GetSwearing() int64 {
+	if x != nil {
+		return x.Swearing
+	}
+	return 0
+}
+
+func (x *AutomodSettingsUpdateEventData) GetRaceEthnicityOrReligion() int64 {
+	if x != nil {
+		return x.RaceEthnicityOrReligion
+	}
+	return 0
+}
+
+func (x *AutomodSettingsUpdateEventData) GetSexBasedTerms() int64 {
+	if x != nil {
+		return x.SexBasedTerms
+	}
+	return 0
+}
+
+type Message struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Fragments     []*MessageFragment     `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_eventsub_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Message) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Message) GetFragments() []*MessageFragment {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+type Badges struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SetId         string                 `protobuf:"bytes,1,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Info          string                 `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Badges) Reset() {
+	*x = Badges{}
+	mi := &file_eventsub_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Badges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Badges) ProtoMessage() {}
+
+func (x *Badges) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Badges.ProtoReflect.Descriptor instead.
+func (*Badges) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Badges) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+func (x *Badges) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Badges) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+type MessageCheer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bits          int64                  `protobuf:"varint,1,opt,name=bits,proto3" json:"bits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageCheer) Reset() {
+	*x = MessageCheer{}
+	mi := &file_eventsub_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageCheer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageCheer) ProtoMessage() {}
+
+func (x *MessageCheer) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageCheer.ProtoReflect.Descriptor instead.
+func (*MessageCheer) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *MessageCheer) GetBits() int64 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+type Reply struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ParentMessageId   string                 `protobuf:"bytes,1,opt,name=parent_message_id,json=parentMessageId,proto3" json:"parent_message_id,omitempty"`
+	ParentMessageBody string                 `protobuf:"bytes,2,opt,name=parent_message_body,json=parentMessageBody,proto3" json:"parent_message_body,omitempty"`
+	ParentUserId      string                 `protobuf:"bytes,3,opt,name=parent_user_id,json=parentUserId,proto3" json:"parent_user_id,omitempty"`
+	ParentUserName    string                 `protobuf:"bytes,4,opt,name=parent_user_name,json=parentUserName,proto3" json:"parent_user_name,omitempty"`
+	ParentUserLogin   string                 `protobuf:"bytes,5,opt,name=parent_user_login,json=parentUserLogin,proto3" json:"parent_user_login,omitempty"`
+	ThreadMessageId   string                 `protobuf:"bytes,6,opt,name=thread_message_id,json=threadMessageId,proto3" json:"thread_message_id,omitempty"`
+	ThreadUserId      string                 `protobuf:"bytes,7,opt,name=thread_user_id,json=threadUserId,proto3" json:"thread_user_id,omitempty"`
+	ThreadUserName    string                 `protobuf:"bytes,8,opt,name=thread_user_name,json=threadUserName,proto3" json:"thread_user_name,omitempty"`
+	ThreadUserLogin   string                 `protobuf:"bytes,9,opt,name=thread_user_login,json=threadUserLogin,proto3" json:"thread_user_login,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Reply) Reset() {
+	*x = Reply{}
+	mi := &file_eventsub_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reply) ProtoMessage() {}
+
+func (x *Reply) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reply.ProtoReflect.Descriptor instead.
+func (*Reply) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Reply) GetParentMessageId() string {
+	if x != nil {
+		return x.ParentMessageId
+	}
+	return ""
+}
+
+func (x *Reply) GetParentMessageBody() string {
+	if x != nil {
+		return x.ParentMessageBody
+	}
+	return ""
+}
+
+func (x *Reply) GetParentUserId() string {
+	if x != nil {
+		return x.ParentUserId
+	}
+	return ""
+}
+
+func (x *Reply) GetParentUserName() string {
+	if x != nil {
+		return x.ParentUserName
+	}
+	return ""
+}
+
+func (x *Reply) GetParentUserLogin() string {
+	if x != nil {
+		return x.ParentUserLogin
+	}
+	return ""
+}
+
+func (x *Reply) GetThreadMessageId() string {
+	if x != nil {
+		return x.ThreadMessageId
+	}
+	return ""
+}
+
+func (x *Reply) GetThreadUserId() string {
+	if x != nil {
+		return x.ThreadUserId
+	}
+	return ""
+}
+
+func (x *Reply) GetThreadUserName() string {
+	if x != nil {
+		return x.ThreadUserName
+	}
+	return ""
+}
+
+func (x *Reply) GetThreadUserLogin() string {
+	if x != nil {
+		return x.ThreadUserLogin
+	}
+	return ""
+}
+
+type NotificationMessage struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Text          string                  `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Fragments     []*NotificationFragment `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationMessage) Reset() {
+	*x = NotificationMessage{}
+	mi := &file_eventsub_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationMessage) ProtoMessage() {}
+
+func (x *NotificationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationMessage.ProtoReflect.Descriptor instead.
+func (*NotificationMessage) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *NotificationMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *NotificationMessage) GetFragments() []*NotificationFragment {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+type NotificationSub struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubTier        string                 `protobuf:"bytes,1,opt,name=sub_tier,json=subTier,proto3" json:"sub_tier,omitempty"`
+	IsPrime        bool                   `protobuf:"varint,2,opt,name=is_prime,json=isPrime,proto3" json:"is_prime,omitempty"`
+	DurationMonths int64                  `protobuf:"varint,3,opt,name=duration_months,json=durationMonths,proto3" json:"duration_months,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotificationSub) Reset() {
+	*x = NotificationSub{}
+	mi := &file_eventsub_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSub) ProtoMessage() {}
+
+func (x *NotificationSub) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSub.ProtoReflect.Descriptor instead.
+func (*NotificationSub) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *NotificationSub) GetSubTier() string {
+	if x != nil {
+		return x.SubTier
+	}
+	return ""
+}
+
+func (x *NotificationSub) GetIsPrime() bool {
+	if x != nil {
+		return x.IsPrime
+	}
+	return false
+}
+
+func (x *NotificationSub) GetDurationMonths() int64 {
+	if x != nil {
+		return x.DurationMonths
+	}
+	return 0
+}
+
+type NotificationResub struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CumulativeMonths  int64                  `protobuf:"varint,1,opt,name=cumulative_months,json=cumulativeMonths,proto3" json:"cumulative_months,omitempty"`
+	DurationMonths    int64                  `protobuf:"varint,2,opt,name=duration_months,json=durationMonths,proto3" json:"duration_months,omitempty"`
+	StreakMonths      int64                  `protobuf:"varint,3,opt,name=streak_months,json=streakMonths,proto3" json:"streak_months,omitempty"`
+	SubTier           string                 `protobuf:"bytes,4,opt,name=sub_tier,json=subTier,proto3" json:"sub_tier,omitempty"`
+	IsPrime           *bool                  `protobuf:"varint,5,opt,name=is_prime,json=isPrime,proto3,oneof" json:"is_prime,omitempty"`
+	IsGift            bool                   `protobuf:"varint,6,opt,name=is_gift,json=isGift,proto3" json:"is_gift,omitempty"`
+	GifterIsAnonymous *bool                  `protobuf:"varint,7,opt,name=gifter_is_anonymous,json=gifterIsAnonymous,proto3,oneof" json:"gifter_is_anonymous,omitempty"`
+	GifterUserId      *string                `protobuf:"bytes,8,opt,name=gifter_user_id,json=gifterUserId,proto3,oneof" json:"gifter_user_id,omitempty"`
+	GifterUserName    *string                `protobuf:"bytes,9,opt,name=gifter_user_name,json=gifterUserName,proto3,oneof" json:"gifter_user_name,omitempty"`
+	GifterUserLogin   *string                `protobuf:"bytes,10,opt,name=gifter_user_login,json=gifterUserLogin,proto3,oneof" json:"gifter_user_login,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NotificationResub) Reset() {
+	*x = NotificationResub{}
+	mi := &file_eventsub_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationResub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationResub) ProtoMessage() {}
+
+func (x *NotificationResub) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationResub.ProtoReflect.Descriptor instead.
+func (*NotificationResub) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *NotificationResub) GetCumulativeMonths() int64 {
+	if x != nil {
+		return x.CumulativeMonths
+	}
+	return 0
+}
+
+func (x *NotificationResub) GetDurationMonths() int64 {
+	if x != nil {
+		return x.DurationMonths
+	}
+	return 0
+}
+
+func (x *NotificationResub) GetStreakMonths() int64 {
+	if x != nil {
+		return x.StreakMonths
+	}
+	return 0
+}
+
+func (x *NotificationResub) GetSubTier() string {
+	if x != nil {
+		return x.SubTier
+	}
+	return ""
+}
+
+func (x *NotificationResub) GetIsPrime() bool {
+	if x != nil && x.IsPrime != nil {
+		return *x.IsPrime
+	}
+	return false
+}
+
+func (x *NotificationResub) GetIsGift() bool {
+	if x != nil {
+		return x.IsGift
+	}
+	return false
+}
+
+func (x *NotificationResub) GetGifterIsAnonymous() bool {
+	if x != nil && x.GifterIsAnonymous != nil {
+		return *x.GifterIsAnonymous
+	}
+	return false
+}
+
+func (x *NotificationResub) GetGifterUserId() string {
+	if x != nil && x.GifterUserId != nil {
+		return *x.GifterUserId
+	}
+	return ""
+}
+
+func (x *NotificationResub) GetGifterUserName() string {
+	if x != nil && x.GifterUserName != nil {
+		return *x.GifterUserName
+	}
+	return ""
+}
+
+func (x *NotificationResub) GetGifterUserLogin() string {
+	if x != nil && x.GifterUserLogin != nil {
+		return *x.GifterUserLogin
+	}
+	return ""
+}
+
+type NotificationSubGift struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	DurationMonths     int64                  `protobuf:"varint,1,opt,name=duration_months,json=durationMonths,proto3" json:"duration_months,omitempty"`
+	CumulativeMonths   *int64                 `protobuf:"varint,2,opt,name=cumulative_months,json=cumulativeMonths,proto3,oneof" json:"cumulative_months,omitempty"`
+	RecipientUserId    string                 `protobuf:"bytes,3,opt,name=recipient_user_id,json=recipientUserId,proto3" json:"recipient_user_id,omitempty"`
+	RecipientUserName  string                 `protobuf:"bytes,4,opt,name=recipient_user_name,json=recipientUserName,proto3" json:"recipient_user_name,omitempty"`
+	RecipientUserLogin string                 `protobuf:"bytes,5,opt,name=recipient_user_login,json=recipientUserLogin,proto3" json:"recipient_user_login,omitempty"`
+	SubTier            string                 `protobuf:"bytes,6,opt,name=sub_tier,json=subTier,proto3" json:"sub_tier,omitempty"`
+	CommunityGiftId    *string                `protobuf:"bytes,7,opt,name=community_gift_id,json=communityGiftId,proto3,oneof" json:"community_gift_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NotificationSubGift) Reset() {
+	*x = NotificationSubGift{}
+	mi := &file_eventsub_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSubGift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSubGift) ProtoMessage() {}
+
+func (x *NotificationSubGift) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSubGift.ProtoReflect.Descriptor instead.
+func (*NotificationSubGift) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *NotificationSubGift) GetDurationMonths() int64 {
+	if x != nil {
+		return x.DurationMonths
+	}
+	return 0
+}
+
+func (x *NotificationSubGift) GetCumulativeMonths() int64 {
+	if x != nil && x.CumulativeMonths != nil {
+		return *x.CumulativeMonths
+	}
+	return 0
+}
+
+func (x *NotificationSubGift) GetRecipientUserId() string {
+	if x != nil {
+		return x.RecipientUserId
+	}
+	return ""
+}
+
+func (x *NotificationSubGift) GetRecipientUserName() string {
+	if x != nil {
+		return x.RecipientUserName
+	}
+	return ""
+}
+
+func (x *NotificationSubGift) GetRecipientUserLogin() string {
+	if x != nil {
+		return x.RecipientUserLogin
+	}
+	return ""
+}
+
+func (x *NotificationSubGift) GetSubTier() string {
+	if x != nil {
+		return x.SubTier
+	}
+	return ""
+}
+
+func (x *NotificationSubGift) GetCommunityGiftId() string {
+	if x != nil && x.CommunityGiftId != nil {
+		return *x.CommunityGiftId
+	}
+	return ""
+}
+
+type NotificationCommunitySubGift struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Total           int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	SubTier         string                 `protobuf:"bytes,3,opt,name=sub_tier,json=subTier,proto3" json:"sub_tier,omitempty"`
+	CumulativeTotal *int64                 `protobuf:"varint,4,opt,name=cumulative_total,json=cumulativeTotal,proto3,oneof" json:"cumulative_total,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotificationCommunitySubGift) Reset() {
+	*x = NotificationCommunitySubGift{}
+	mi := &file_eventsub_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationCommunitySubGift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationCommunitySubGift) ProtoMessage() {}
+
+func (x *NotificationCommunitySubGift) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationCommunitySubGift.ProtoReflect.Descriptor instead.
+func (*NotificationCommunitySubGift) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *NotificationCommunitySubGift) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationCommunitySubGift) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *NotificationCommunitySubGift) GetSubTier() string {
+	if x != nil {
+		return x.SubTier
+	}
+	return ""
+}
+
+func (x *NotificationCommunitySubGift) GetCumulativeTotal() int64 {
+	if x != nil && x.CumulativeTotal != nil {
+		return *x.CumulativeTotal
+	}
+	return 0
+}
+
+type NotificationGiftPaidUpgrade struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GifterIsAnonymous bool                   `protobuf:"varint,1,opt,name=gifter_is_anonymous,json=gifterIsAnonymous,proto3" json:"gifter_is_anonymous,omitempty"`
+	GifterUserId      *string                `protobuf:"bytes,2,opt,name=gifter_user_id,json=gifterUserId,proto3,oneof" json:"gifter_user_id,omitempty"`
+	GifterUserName    *string                `protobuf:"bytes,3,opt,name=gifter_user_name,json=gifterUserName,proto3,oneof" json:"gifter_user_name,omitempty"`
+	GifterUserLogin   *string                `protobuf:"bytes,4,opt,name=gifter_user_login,json=gifterUserLogin,proto3,oneof" json:"gifter_user_login,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NotificationGiftPaidUpgrade) Reset() {
+	*x = NotificationGiftPaidUpgrade{}
+	mi := &file_eventsub_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationGiftPaidUpgrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationGiftPaidUpgrade) ProtoMessage() {}
+
+func (x *NotificationGiftPaidUpgrade) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationGiftPaidUpgrade.ProtoReflect.Descriptor instead.
+func (*NotificationGiftPaidUpgrade) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *NotificationGiftPaidUpgrade) GetGifterIsAnonymous() bool {
+	if x != nil {
+		return x.GifterIsAnonymous
+	}
+	return false
+}
+
+func (x *NotificationGiftPaidUpgrade) GetGifterUserId() string {
+	if x != nil && x.GifterUserId != nil {
+		return *x.GifterUserId
+	}
+	return ""
+}
+
+func (x *NotificationGiftPaidUpgrade) GetGifterUserName() string {
+	if x != nil && x.GifterUserName != nil {
+		return *x.GifterUserName
+	}
+	return ""
+}
+
+func (x *NotificationGiftPaidUpgrade) GetGifterUserLogin() string {
+	if x != nil && x.GifterUserLogin != nil {
+		return *x.GifterUserLogin
+	}
+	return ""
+}
+
+type NotificationPrimePaidUpgrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubTier       string                 `protobuf:"bytes,1,opt,name=sub_tier,json=subTier,proto3" json:"sub_tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPrimePaidUpgrade) Reset() {
+	*x = NotificationPrimePaidUpgrade{}
+	mi := &file_eventsub_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPrimePaidUpgrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPrimePaidUpgrade) ProtoMessage() {}
+
+func (x *NotificationPrimePaidUpgrade) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPrimePaidUpgrade.ProtoReflect.Descriptor instead.
+func (*NotificationPrimePaidUpgrade) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *NotificationPrimePaidUpgrade) GetSubTier() string {
+	if x != nil {
+		return x.SubTier
+	}
+	return ""
+}
+
+type NotificationPayItForward struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GifterIsAnonymous bool                   `protobuf:"varint,1,opt,name=gifter_is_anonymous,json=gifterIsAnonymous,proto3" json:"gifter_is_anonymous,omitempty"`
+	GifterUserId      *string                `protobuf:"bytes,2,opt,name=gifter_user_id,json=gifterUserId,proto3,oneof" json:"gifter_user_id,omitempty"`
+	GifterUserName    *string                `protobuf:"bytes,3,opt,name=gifter_user_name,json=gifterUserName,proto3,oneof" json:"gifter_user_name,omitempty"`
+	GifterUserLogin   *string                `protobuf:"bytes,4,opt,name=gifter_user_login,json=gifterUserLogin,proto3,oneof" json:"gifter_user_login,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NotificationPayItForward) Reset() {
+	*x = NotificationPayItForward{}
+	mi := &file_eventsub_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPayItForward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPayItForward) ProtoMessage() {}
+
+func (x *NotificationPayItForward) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPayItForward.ProtoReflect.Descriptor instead.
+func (*NotificationPayItForward) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *NotificationPayItForward) GetGifterIsAnonymous() bool {
+	if x != nil {
+		return x.GifterIsAnonymous
+	}
+	return false
+}
+
+func (x *NotificationPayItForward) GetGifterUserId() string {
+	if x != nil && x.GifterUserId != nil {
+		return *x.GifterUserId
+	}
+	return ""
+}
+
+func (x *NotificationPayItForward) GetGifterUserName() string {
+	if x != nil && x.GifterUserName != nil {
+		return *x.GifterUserName
+	}
+	return ""
+}
+
+func (x *NotificationPayItForward) GetGifterUserLogin() string {
+	if x != nil && x.GifterUserLogin != nil {
+		return *x.GifterUserLogin
+	}
+	return ""
+}
+
+type NotificationRaid struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName        string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserLogin       string                 `protobuf:"bytes,3,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	ViewerCount     int64                  `protobuf:"varint,4,opt,name=viewer_count,json=viewerCount,proto3" json:"viewer_count,omitempty"`
+	ProfileImageUrl string                 `protobuf:"bytes,5,opt,name=profile_image_url,json=profileImageUrl,proto3" json:"profile_image_url,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotificationRaid) Reset() {
+	*x = NotificationRaid{}
+	mi := &file_eventsub_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationRaid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationRaid) ProtoMessage() {}
+
+func (x *NotificationRaid) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationRaid.ProtoReflect.Descriptor instead.
+func (*NotificationRaid) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *NotificationRaid) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NotificationRaid) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *NotificationRaid) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *NotificationRaid) GetViewerCount() int64 {
+	if x != nil {
+		return x.ViewerCount
+	}
+	return 0
+}
+
+func (x *NotificationRaid) GetProfileImageUrl() string {
+	if x != nil {
+		return x.ProfileImageUrl
+	}
+	return ""
+}
+
+type NotificationAnnouncement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Color         string                 `protobuf:"bytes,1,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationAnnouncement) Reset() {
+	*x = NotificationAnnouncement{}
+	mi := &file_eventsub_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationAnnouncement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationAnnouncement) ProtoMessage() {}
+
+func (x *NotificationAnnouncement) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationAnnouncement.ProtoReflect.Descriptor instead.
+func (*NotificationAnnouncement) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *NotificationAnnouncement) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type NotificationBitsBadgeTier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tier          int64                  `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationBitsBadgeTier) Reset() {
+	*x = NotificationBitsBadgeTier{}
+	mi := &file_eventsub_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationBitsBadgeTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationBitsBadgeTier) ProtoMessage() {}
+
+func (x *NotificationBitsBadgeTier) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationBitsBadgeTier.ProtoReflect.Descriptor instead.
+func (*NotificationBitsBadgeTier) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *NotificationBitsBadgeTier) GetTier() int64 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+type NotificationCharityDonation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharityName   string                 `protobuf:"bytes,1,opt,name=charity_name,json=charityName,proto3" json:"charity_name,omitempty"`
+	Amount        *Amount                `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationCharityDonation) Reset() {
+	*x = NotificationCharityDonation{}
+	mi := &file_eventsub_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationCharityDonation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationCharityDonation) ProtoMessage() {}
+
+func (x *NotificationCharityDonation) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationCharityDonation.ProtoReflect.Descriptor instead.
+func (*NotificationCharityDonation) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *NotificationCharityDonation) GetCharityName() string {
+	if x != nil {
+		return x.CharityName
+	}
+	return ""
+}
+
+func (x *NotificationCharityDonation) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type SubscriptionMessageStruct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Emotes        []*Emotes              `protobuf:"bytes,2,rep,name=emotes,proto3" json:"emotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionMessageStruct) Reset() {
+	*x = SubscriptionMessageStruct{}
+	mi := &file_eventsub_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionMessageStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionMessageStruct) ProtoMessage() {}
+
+func (x *SubscriptionMessageStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionMessageStruct.ProtoReflect.Descriptor instead.
+func (*SubscriptionMessageStruct) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SubscriptionMessageStruct) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SubscriptionMessageStruct) GetEmotes() []*Emotes {
+	if x != nil {
+		return x.Emotes
+	}
+	return nil
+}
+
+type FollowDurationMinutes struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	FollowDurationMinutes int64                  `protobuf:"varint,1,opt,name=follow_duration_minutes,json=followDurationMinutes,proto3" json:"follow_duration_minutes,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *FollowDurationMinutes) Reset() {
+	*x = FollowDurationMinutes{}
+	mi := &file_eventsub_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowDurationMinutes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowDurationMinutes) ProtoMessage() {}
+
+func (x *FollowDurationMinutes) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowDurationMinutes.ProtoReflect.Descriptor instead.
+func (*FollowDurationMinutes) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *FollowDurationMinutes) GetFollowDurationMinutes() int64 {
+	if x != nil {
+		return x.FollowDurationMinutes
+	}
+	return 0
+}
+
+type Slow struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WaitTimeSeconds int64                  `protobuf:"varint,1,opt,name=wait_time_seconds,json=waitTimeSeconds,proto3" json:"wait_time_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Slow) Reset() {
+	*x = Slow{}
+	mi := &file_eventsub_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Slow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slow) ProtoMessage() {}
+
+func (x *Slow) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slow.ProtoReflect.Descriptor instead.
+func (*Slow) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Slow) GetWaitTimeSeconds() int64 {
+	if x != nil {
+		return x.WaitTimeSeconds
+	}
+	return 0
+}
+
+type UserData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserData) Reset() {
+	*x = UserData{}
+	mi := &file_eventsub_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserData) ProtoMessage() {}
+
+func (x *UserData) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserData.ProtoReflect.Descriptor instead.
+func (*UserData) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UserData) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserData) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *UserData) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type EventBan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventBan) Reset() {
+	*x = EventBan{}
+	mi := &file_eventsub_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventBan) ProtoMessage() {}
+
+func (x *EventBan) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventBan.ProtoReflect.Descriptor instead.
+func (*EventBan) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *EventBan) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EventBan) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *EventBan) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *EventBan) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type Timeout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Timeout) Reset() {
+	*x = Timeout{}
+	mi := &file_eventsub_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Timeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timeout) ProtoMessage() {}
+
+func (x *Timeout) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timeout.ProtoReflect.Descriptor instead.
+func (*Timeout) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Timeout) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Timeout) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *Timeout) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Timeout) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *Timeout) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type EventRaid struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	ViewerCount   int64                  `protobuf:"varint,4,opt,name=viewer_count,json=viewerCount,proto3" json:"viewer_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventRaid) Reset() {
+	*x = EventRaid{}
+	mi := &file_eventsub_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRaid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRaid) ProtoMessage() {}
+
+func (x *EventRaid) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRaid.ProtoReflect.Descriptor instead.
+func (*EventRaid) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *EventRaid) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EventRaid) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *EventRaid) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *EventRaid) GetViewerCount() int64 {
+	if x != nil {
+		return x.ViewerCount
+	}
+	return 0
+}
+
+type Delete struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	MessageId     string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	MessageBody   string                 `protobuf:"bytes,5,opt,name=message_body,json=messageBody,proto3" json:"message_body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Delete) Reset() {
+	*x = Delete{}
+	mi := &file_eventsub_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delete) ProtoMessage() {}
+
+func (x *Delete) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delete.ProtoReflect.Descriptor instead.
+func (*Delete) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Delete) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Delete) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *Delete) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Delete) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *Delete) GetMessageBody() string {
+	if x != nil {
+		return x.MessageBody
+	}
+	return ""
+}
+
+type AutoModTerms struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	List          string                 `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	Terms         []string               `protobuf:"bytes,3,rep,name=terms,proto3" json:"terms,omitempty"`
+	FromAutomod   bool                   `protobuf:"varint,4,opt,name=from_automod,json=fromAutomod,proto3" json:"from_automod,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoModTerms) Reset() {
+	*x = AutoModTerms{}
+	mi := &file_eventsub_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoModTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoModTerms) ProtoMessage() {}
+
+func (x *AutoModTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoModTerms.ProtoReflect.Descriptor instead.
+func (*AutoModTerms) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AutoModTerms) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AutoModTerms) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *AutoModTerms) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *AutoModTerms) GetFromAutomod() bool {
+	if x != nil {
+		return x.FromAutomod
+	}
+	return false
+}
+
+type UnBanRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IsApproved       bool                   `protobuf:"varint,1,opt,name=is_approved,json=isApproved,proto3" json:"is_approved,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin        string                 `protobuf:"bytes,3,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName         string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	ModeratorMessage string                 `protobuf:"bytes,5,opt,name=moderator_message,json=moderatorMessage,proto3" json:"moderator_message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UnBanRequest) Reset() {
+	*x = UnBanRequest{}
+	mi := &file_eventsub_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnBanRequest) ProtoMessage() {}
+
+func (x *UnBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnBanRequest.ProtoReflect.Descriptor instead.
+func (*UnBanRequest) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *UnBanRequest) GetIsApproved() bool {
+	if x != nil {
+		return x.IsApproved
+	}
+	return false
+}
+
+func (x *UnBanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnBanRequest) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *UnBanRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UnBanRequest) GetModeratorMessage() string {
+	if x != nil {
+		return x.ModeratorMessage
+	}
+	return ""
+}
+
+type WarnEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin      string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName       string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Reason         *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ChatRulesCited []string               `protobuf:"bytes,5,rep,name=chat_rules_cited,json=chatRulesCited,proto3" json:"chat_rules_cited,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WarnEvent) Reset() {
+	*x = WarnEvent{}
+	mi := &file_eventsub_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarnEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarnEvent) ProtoMessage() {}
+
+func (x *WarnEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarnEvent.ProtoReflect.Descriptor instead.
+func (*WarnEvent) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *WarnEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WarnEvent) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *WarnEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *WarnEvent) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *WarnEvent) GetChatRulesCited() []string {
+	if x != nil {
+		return x.ChatRulesCited
+	}
+	return nil
+}
+
+type ChannelPointsReward struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ChannelPoints int64                  `protobuf:"varint,2,opt,name=channel_points,json=channelPoints,proto3" json:"channel_points,omitempty"`
+	Emote         *RewardUnlockedEmote   `protobuf:"bytes,3,opt,name=emote,proto3" json:"emote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelPointsReward) Reset() {
+	*x = ChannelPointsReward{}
+	mi := &file_eventsub_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsReward) ProtoMessage() {}
+
+func (x *ChannelPointsReward) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsReward.ProtoReflect.Descriptor instead.
+func (*ChannelPointsReward) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ChannelPointsReward) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelPointsReward) GetChannelPoints() int64 {
+	if x != nil {
+		return x.ChannelPoints
+	}
+	return 0
+}
+
+func (x *ChannelPointsReward) GetEmote() *RewardUnlockedEmote {
+	if x != nil {
+		return x.Emote
+	}
+	return nil
+}
+
+type ChannelPointsRewardMessage struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Text          string                                `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Fragments     []*ChannelPointsRewardMessageFragment `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelPointsRewardMessage) Reset() {
+	*x = ChannelPointsRewardMessage{}
+	mi := &file_eventsub_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsRewardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsRewardMessage) ProtoMessage() {}
+
+func (x *ChannelPointsRewardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsRewardMessage.ProtoReflect.Descriptor instead.
+func (*ChannelPointsRewardMessage) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ChannelPointsRewardMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChannelPointsRewardMessage) GetFragments() []*ChannelPointsRewardMessageFragment {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+type MaxPer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsEnabled     bool                   `protobuf:"varint,1,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	Value         int64                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaxPer) Reset() {
+	*x = MaxPer{}
+	mi := &file_eventsub_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaxPer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxPer) ProtoMessage() {}
+
+func (x *MaxPer) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaxPer.ProtoReflect.Descriptor instead.
+func (*MaxPer) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *MaxPer) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *MaxPer) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Cooldown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsEnabled     bool                   `protobuf:"varint,1,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	Seconds       int64                  `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cooldown) Reset() {
+	*x = Cooldown{}
+	mi := &file_eventsub_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cooldown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cooldown) ProtoMessage() {}
+
+func (x *Cooldown) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cooldown.ProtoReflect.Descriptor instead.
+func (*Cooldown) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Cooldown) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *Cooldown) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+type Image struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url_1X        string                 `protobuf:"bytes,1,opt,name=url_1x,json=url1x,proto3" json:"url_1x,omitempty"`
+	Url_2X        string                 `protobuf:"bytes,2,opt,name=url_2x,json=url2x,proto3" json:"url_2x,omitempty"`
+	Url_4X        string                 `protobuf:"bytes,3,opt,name=url_4x,json=url4x,proto3" json:"url_4x,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_eventsub_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *Image) GetUrl_1X() string {
+	if x != nil {
+		return x.Url_1X
+	}
+	return ""
+}
+
+func (x *Image) GetUrl_2X() string {
+	if x != nil {
+		return x.Url_2X
+	}
+	return ""
+}
+
+func (x *Image) GetUrl_4X() string {
+	if x != nil {
+		return x.Url_4X
+	}
+	return ""
+}
+
+type Reward struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Cost          int64                  `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	Prompt        string                 `protobuf:"bytes,4,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reward) Reset() {
+	*x = Reward{}
+	mi := &file_eventsub_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reward) ProtoMessage() {}
+
+func (x *Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reward.ProtoReflect.Descriptor instead.
+func (*Reward) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *Reward) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reward) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Reward) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *Reward) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+type Choices struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ChannelPointsVotes int64                  `protobuf:"varint,3,opt,name=channel_points_votes,json=channelPointsVotes,proto3" json:"channel_points_votes,omitempty"`
+	Votes              int64                  `protobuf:"varint,4,opt,name=votes,proto3" json:"votes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Choices) Reset() {
+	*x = Choices{}
+	mi := &file_eventsub_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Choices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Choices) ProtoMessage() {}
+
+func (x *Choices) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Choices.ProtoReflect.Descriptor instead.
+func (*Choices) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *Choices) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Choices) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Choices) GetChannelPointsVotes() int64 {
+	if x != nil {
+		return x.ChannelPointsVotes
+	}
+	return 0
+}
+
+func (x *Choices) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+type ChannelPointsVoting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsEnabled     bool                   `protobuf:"varint,1,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	AmountPerVote int64                  `protobuf:"varint,2,opt,name=amount_per_vote,json=amountPerVote,proto3" json:"amount_per_vote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelPointsVoting) Reset() {
+	*x = ChannelPointsVoting{}
+	mi := &file_eventsub_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsVoting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsVoting) ProtoMessage() {}
+
+func (x *ChannelPointsVoting) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsVoting.ProtoReflect.Descriptor instead.
+func (*ChannelPointsVoting) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ChannelPointsVoting) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *ChannelPointsVoting) GetAmountPerVote() int64 {
+	if x != nil {
+		return x.AmountPerVote
+	}
+	return 0
+}
+
+type Outcomes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Users         int64                  `protobuf:"varint,4,opt,name=users,proto3" json:"users,omitempty"`
+	ChannelPoints int64                  `protobuf:"varint,5,opt,name=channel_points,json=channelPoints,proto3" json:"channel_points,omitempty"`
+	TopPredictors *TopPredictors         `protobuf:"bytes,6,opt,name=top_predictors,json=topPredictors,proto3" json:"top_predictors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Outcomes) Reset() {
+	*x = Outcomes{}
+	mi := &file_eventsub_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Outcomes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Outcomes) ProtoMessage() {}
+
+func (x *Outcomes) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Outcomes.ProtoReflect.Descriptor instead.
+func (*Outcomes) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *Outcomes) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Outcomes) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Outcomes) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Outcomes) GetUsers() int64 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *Outcomes) GetChannelPoints() int64 {
+	if x != nil {
+		return x.ChannelPoints
+	}
+	return 0
+}
+
+func (x *Outcomes) GetTopPredictors() *TopPredictors {
+	if x != nil {
+		return x.TopPredictors
+	}
+	return nil
+}
+
+type Amount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	DecimalPlaces int64                  `protobuf:"varint,2,opt,name=decimal_places,json=decimalPlaces,proto3" json:"decimal_places,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Amount) Reset() {
+	*x = Amount{}
+	mi := &file_eventsub_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Amount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Amount) ProtoMessage() {}
+
+func (x *Amount) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Amount.ProtoReflect.Descriptor instead.
+func (*Amount) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *Amount) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Amount) GetDecimalPlaces() int64 {
+	if x != nil {
+		return x.DecimalPlaces
+	}
+	return 0
+}
+
+func (x *Amount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ConduitTransport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Method         string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	SessionId      *string                `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	Callback       *string                `protobuf:"bytes,3,opt,name=callback,proto3,oneof" json:"callback,omitempty"`
+	ConnectedAt    *string                `protobuf:"bytes,4,opt,name=connected_at,json=connectedAt,proto3,oneof" json:"connected_at,omitempty"`
+	DisconnectedAt *string                `protobuf:"bytes,5,opt,name=disconnected_at,json=disconnectedAt,proto3,oneof" json:"disconnected_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConduitTransport) Reset() {
+	*x = ConduitTransport{}
+	mi := &file_eventsub_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConduitTransport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConduitTransport) ProtoMessage() {}
+
+func (x *ConduitTransport) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConduitTransport.ProtoReflect.Descriptor instead.
+func (*ConduitTransport) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ConduitTransport) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ConduitTransport) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *ConduitTransport) GetCallback() string {
+	if x != nil && x.Callback != nil {
+		return *x.Callback
+	}
+	return ""
+}
+
+func (x *ConduitTransport) GetConnectedAt() string {
+	if x != nil && x.ConnectedAt != nil {
+		return *x.ConnectedAt
+	}
+	return ""
+}
+
+func (x *ConduitTransport) GetDisconnectedAt() string {
+	if x != nil && x.DisconnectedAt != nil {
+		return *x.DisconnectedAt
+	}
+	return ""
+}
+
+type DropData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CategoryId     string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName   string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	CampaignId     string                 `protobuf:"bytes,4,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName       string                 `protobuf:"bytes,6,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserLogin      string                 `protobuf:"bytes,7,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	EntitlementId  string                 `protobuf:"bytes,8,opt,name=entitlement_id,json=entitlementId,proto3" json:"entitlement_id,omitempty"`
+	BenefitId      string                 `protobuf:"bytes,9,opt,name=benefit_id,json=benefitId,proto3" json:"benefit_id,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DropData) Reset() {
+	*x = DropData{}
+	mi := &file_eventsub_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropData) ProtoMessage() {}
+
+func (x *DropData) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropData.ProtoReflect.Descriptor instead.
+func (*DropData) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DropData) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DropData) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *DropData) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *DropData) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DropData) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DropData) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *DropData) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *DropData) GetEntitlementId() string {
+	if x != nil {
+		return x.EntitlementId
+	}
+	return ""
+}
+
+func (x *DropData) GetBenefitId() string {
+	if x != nil {
+		return x.BenefitId
+	}
+	return ""
+}
+
+func (x *DropData) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Bits          int64                  `protobuf:"varint,3,opt,name=bits,proto3" json:"bits,omitempty"`
+	InDevelopment bool                   `protobuf:"varint,4,opt,name=in_development,json=inDevelopment,proto3" json:"in_development,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_eventsub_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetBits() int64 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+func (x *Product) GetInDevelopment() bool {
+	if x != nil {
+		return x.InDevelopment
+	}
+	return false
+}
+
+type Contribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Total         int64                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contribution) Reset() {
+	*x = Contribution{}
+	mi := &file_eventsub_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contribution) ProtoMessage() {}
+
+func (x *Contribution) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contribution.ProtoReflect.Descriptor instead.
+func (*Contribution) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Contribution) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Contribution) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *Contribution) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Contribution) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Contribution) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SharedTrainParticipant struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BroadcasterUserId    string                 `protobuf:"bytes,1,opt,name=broadcaster_user_id,json=broadcasterUserId,proto3" json:"broadcaster_user_id,omitempty"`
+	BroadcasterUserLogin string                 `protobuf:"bytes,2,opt,name=broadcaster_user_login,json=broadcasterUserLogin,proto3" json:"broadcaster_user_login,omitempty"`
+	BroadcasterUserName  string                 `protobuf:"bytes,3,opt,name=broadcaster_user_name,json=broadcasterUserName,proto3" json:"broadcaster_user_name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SharedTrainParticipant) Reset() {
+	*x = SharedTrainParticipant{}
+	mi := &file_eventsub_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedTrainParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedTrainParticipant) ProtoMessage() {}
+
+func (x *SharedTrainParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedTrainParticipant.ProtoReflect.Descriptor instead.
+func (*SharedTrainParticipant) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *SharedTrainParticipant) GetBroadcasterUserId() string {
+	if x != nil {
+		return x.BroadcasterUserId
+	}
+	return ""
+}
+
+func (x *SharedTrainParticipant) GetBroadcasterUserLogin() string {
+	if x != nil {
+		return x.BroadcasterUserLogin
+	}
+	return ""
+}
+
+func (x *SharedTrainParticipant) GetBroadcasterUserName() string {
+	if x != nil {
+		return x.BroadcasterUserName
+	}
+	return ""
+}
+
+type Whisper struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Whisper) Reset() {
+	*x = Whisper{}
+	mi := &file_eventsub_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Whisper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Whisper) ProtoMessage() {}
+
+func (x *Whisper) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Whisper.ProtoReflect.Descriptor instead.
+func (*Whisper) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *Whisper) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ChannelBitsUseEventMessage struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Text          string                         `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Fragments     []*ChannelBitsUseEventFragment `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelBitsUseEventMessage) Reset() {
+	*x = ChannelBitsUseEventMessage{}
+	mi := &file_eventsub_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelBitsUseEventMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelBitsUseEventMessage) ProtoMessage() {}
+
+func (x *ChannelBitsUseEventMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelBitsUseEventMessage.ProtoReflect.Descriptor instead.
+func (*ChannelBitsUseEventMessage) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ChannelBitsUseEventMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEventMessage) GetFragments() []*ChannelBitsUseEventFragment {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+type ChannelBitsUseEventPowerUp struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Type            string                           `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Emote           *ChannelBitsUseEventPowerUpEmote `protobuf:"bytes,2,opt,name=emote,proto3" json:"emote,omitempty"`
+	MessageEffectId string                           `protobuf:"bytes,3,opt,name=message_effect_id,json=messageEffectId,proto3" json:"message_effect_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChannelBitsUseEventPowerUp) Reset() {
+	*x = ChannelBitsUseEventPowerUp{}
+	mi := &file_eventsub_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelBitsUseEventPowerUp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelBitsUseEventPowerUp) ProtoMessage() {}
+
+func (x *ChannelBitsUseEventPowerUp) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelBitsUseEventPowerUp.ProtoReflect.Descriptor instead.
+func (*ChannelBitsUseEventPowerUp) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ChannelBitsUseEventPowerUp) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEventPowerUp) GetEmote() *ChannelBitsUseEventPowerUpEmote {
+	if x != nil {
+		return x.Emote
+	}
+	return nil
+}
+
+func (x *ChannelBitsUseEventPowerUp) GetMessageEffectId() string {
+	if x != nil {
+		return x.MessageEffectId
+	}
+	return ""
+}
+
+type SuspiciousMessage struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	MessageId     string                       `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Text          string                       `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Fragments     []*SuspiciousMessageFragment `protobuf:"bytes,3,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspiciousMessage) Reset() {
+	*x = SuspiciousMessage{}
+	mi := &file_eventsub_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspiciousMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspiciousMessage) ProtoMessage() {}
+
+func (x *SuspiciousMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspiciousMessage.ProtoReflect.Descriptor instead.
+func (*SuspiciousMessage) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SuspiciousMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *SuspiciousMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SuspiciousMessage) GetFragments() []*SuspiciousMessageFragment {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+type Emote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	SetId         string                 `protobuf:"bytes,3,opt,name=set_id,json=set-id,proto3" json:"set_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Emote) Reset() {
+	*x = Emote{}
+	mi := &file_eventsub_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Emote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Emote) ProtoMessage() {}
+
+func (x *Emote) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Emote.ProtoReflect.Descriptor instead.
+func (*Emote) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *Emote) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Emote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Emote) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+type Cheermote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bits          int64                  `protobuf:"varint,1,opt,name=bits,proto3" json:"bits,omitempty"`
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Tier          int64                  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cheermote) Reset() {
+	*x = Cheermote{}
+	mi := &file_eventsub_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cheermote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cheermote) ProtoMessage() {}
+
+func (x *Cheermote) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cheermote.ProtoReflect.Descriptor instead.
+func (*Cheermote) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *Cheermote) GetBits() int64 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+func (x *Cheermote) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Cheermote) GetTier() int64 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+type MessageFragment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Cheermote     *Cheermote             `protobuf:"bytes,3,opt,name=cheermote,proto3" json:"cheermote,omitempty"`
+	Emote         *MessageEmote          `protobuf:"bytes,4,opt,name=emote,proto3" json:"emote,omitempty"`
+	Mention       *MessageMention        `protobuf:"bytes,5,opt,name=mention,proto3" json:"mention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageFragment) Reset() {
+	*x = MessageFragment{}
+	mi := &file_eventsub_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageFragment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageFragment) ProtoMessage() {}
+
+func (x *MessageFragment) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageFragment.ProtoReflect.Descriptor instead.
+func (*MessageFragment) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *MessageFragment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MessageFragment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MessageFragment) GetCheermote() *Cheermote {
+	if x != nil {
+		return x.Cheermote
+	}
+	return nil
+}
+
+func (x *MessageFragment) GetEmote() *MessageEmote {
+	if x != nil {
+		return x.Emote
+	}
+	return nil
+}
+
+func (x *MessageFragment) GetMention() *MessageMention {
+	if x != nil {
+		return x.Mention
+	}
+	return nil
+}
+
+type NotificationFragment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Cheermote     *NotificationCheermote `protobuf:"bytes,3,opt,name=cheermote,proto3" json:"cheermote,omitempty"`
+	Emote         *NotificationEmote     `protobuf:"bytes,4,opt,name=emote,proto3" json:"emote,omitempty"`
+	Mention       *NotificationMention   `protobuf:"bytes,5,opt,name=mention,proto3" json:"mention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationFragment) Reset() {
+	*x = NotificationFragment{}
+	mi := &file_eventsub_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationFragment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationFragment) ProtoMessage() {}
+
+func (x *NotificationFragment) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationFragment.ProtoReflect.Descriptor instead.
+func (*NotificationFragment) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *NotificationFragment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NotificationFragment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *NotificationFragment) GetCheermote() *NotificationCheermote {
+	if x != nil {
+		return x.Cheermote
+	}
+	return nil
+}
+
+func (x *NotificationFragment) GetEmote() *NotificationEmote {
+	if x != nil {
+		return x.Emote
+	}
+	return nil
+}
+
+func (x *NotificationFragment) GetMention() *NotificationMention {
+	if x != nil {
+		return x.Mention
+	}
+	return nil
+}
+
+type Emotes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Begin         int64                  `protobuf:"varint,1,opt,name=begin,proto3" json:"begin,omitempty"`
+	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Emotes) Reset() {
+	*x = Emotes{}
+	mi := &file_eventsub_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Emotes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Emotes) ProtoMessage() {}
+
+func (x *Emotes) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Emotes.ProtoReflect.Descriptor instead.
+func (*Emotes) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Emotes) GetBegin() int64 {
+	if x != nil {
+		return x.Begin
+	}
+	return 0
+}
+
+func (x *Emotes) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *Emotes) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RewardUnlockedEmote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewardUnlockedEmote) Reset() {
+	*x = RewardUnlockedEmote{}
+	mi := &file_eventsub_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardUnlockedEmote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardUnlockedEmote) ProtoMessage() {}
+
+func (x *RewardUnlockedEmote) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardUnlockedEmote.ProtoReflect.Descriptor instead.
+func (*RewardUnlockedEmote) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RewardUnlockedEmote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RewardUnlockedEmote) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ChannelPointsRewardMessageFragment struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Type          string                    `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Text          string                    `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Emote         *ChannelPointsRewardEmote `protobuf:"bytes,3,opt,name=emote,proto3" json:"emote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelPointsRewardMessageFragment) Reset() {
+	*x = ChannelPointsRewardMessageFragment{}
+	mi := &file_eventsub_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsRewardMessageFragment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsRewardMessageFragment) ProtoMessage() {}
+
+func (x *ChannelPointsRewardMessageFragment) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsRewardMessageFragment.ProtoReflect.Descriptor instead.
+func (*ChannelPointsRewardMessageFragment) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ChannelPointsRewardMessageFragment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelPointsRewardMessageFragment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChannelPointsRewardMessageFragment) GetEmote() *ChannelPointsRewardEmote {
+	if x != nil {
+		return x.Emote
+	}
+	return nil
+}
+
+type TopPredictors struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserName          string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserLogin         string                 `protobuf:"bytes,2,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	UserId            string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelPointsWon  *int64                 `protobuf:"varint,4,opt,name=channel_points_won,json=channelPointsWon,proto3,oneof" json:"channel_points_won,omitempty"`
+	ChannelPointsUsed int64                  `protobuf:"varint,5,opt,name=channel_points_used,json=channelPointsUsed,proto3" json:"channel_points_used,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TopPredictors) Reset() {
+	*x = TopPredictors{}
+	mi := &file_eventsub_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopPredictors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopPredictors) ProtoMessage() {}
+
+func (x *TopPredictors) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopPredictors.ProtoReflect.Descriptor instead.
+func (*TopPredictors) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *TopPredictors) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *TopPredictors) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *TopPredictors) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TopPredictors) GetChannelPointsWon() int64 {
+	if x != nil && x.ChannelPointsWon != nil {
+		return *x.ChannelPointsWon
+	}
+	return 0
+}
+
+func (x *TopPredictors) GetChannelPointsUsed() int64 {
+	if x != nil {
+		return x.ChannelPointsUsed
+	}
+	return 0
+}
+
+type ChannelBitsUseEventFragment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Emotes        []*Emote               `protobuf:"bytes,3,rep,name=emotes,proto3" json:"emotes,omitempty"`
+	Cheermotes    []*Cheermote           `protobuf:"bytes,4,rep,name=cheermotes,proto3" json:"cheermotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelBitsUseEventFragment) Reset() {
+	*x = ChannelBitsUseEventFragment{}
+	mi := &file_eventsub_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelBitsUseEventFragment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelBitsUseEventFragment) ProtoMessage() {}
+
+func (x *ChannelBitsUseEventFragment) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelBitsUseEventFragment.ProtoReflect.Descriptor instead.
+func (*ChannelBitsUseEventFragment) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ChannelBitsUseEventFragment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEventFragment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEventFragment) GetEmotes() []*Emote {
+	if x != nil {
+		return x.Emotes
+	}
+	return nil
+}
+
+func (x *ChannelBitsUseEventFragment) GetCheermotes() []*Cheermote {
+	if x != nil {
+		return x.Cheermotes
+	}
+	return nil
+}
+
+type ChannelBitsUseEventPowerUpEmote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelBitsUseEventPowerUpEmote) Reset() {
+	*x = ChannelBitsUseEventPowerUpEmote{}
+	mi := &file_eventsub_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelBitsUseEventPowerUpEmote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelBitsUseEventPowerUpEmote) ProtoMessage() {}
+
+func (x *ChannelBitsUseEventPowerUpEmote) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelBitsUseEventPowerUpEmote.ProtoReflect.Descriptor instead.
+func (*ChannelBitsUseEventPowerUpEmote) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ChannelBitsUseEventPowerUpEmote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelBitsUseEventPowerUpEmote) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SuspiciousMessageFragment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Cheermote     *Cheermote             `protobuf:"bytes,3,opt,name=cheermote,proto3" json:"cheermote,omitempty"`
+	Emote         *MessageEmote          `protobuf:"bytes,4,opt,name=emote,proto3" json:"emote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspiciousMessageFragment) Reset() {
+	*x = SuspiciousMessageFragment{}
+	mi := &file_eventsub_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspiciousMessageFragment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspiciousMessageFragment) ProtoMessage() {}
+
+func (x *SuspiciousMessageFragment) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspiciousMessageFragment.ProtoReflect.Descriptor instead.
+func (*SuspiciousMessageFragment) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *SuspiciousMessageFragment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SuspiciousMessageFragment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SuspiciousMessageFragment) GetCheermote() *Cheermote {
+	if x != nil {
+		return x.Cheermote
+	}
+	return nil
+}
+
+func (x *SuspiciousMessageFragment) GetEmote() *MessageEmote {
+	if x != nil {
+		return x.Emote
+	}
+	return nil
+}
+
+type MessageEmote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmoteSetId    string                 `protobuf:"bytes,2,opt,name=emote_set_id,json=emoteSetId,proto3" json:"emote_set_id,omitempty"`
+	Format        []string               `protobuf:"bytes,3,rep,name=format,proto3" json:"format,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageEmote) Reset() {
+	*x = MessageEmote{}
+	mi := &file_eventsub_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageEmote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageEmote) ProtoMessage() {}
+
+func (x *MessageEmote) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageEmote.ProtoReflect.Descriptor instead.
+func (*MessageEmote) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *MessageEmote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageEmote) GetEmoteSetId() string {
+	if x != nil {
+		return x.EmoteSetId
+	}
+	return ""
+}
+
+func (x *MessageEmote) GetFormat() []string {
+	if x != nil {
+		return x.Format
+	}
+	return nil
+}
+
+func (x *MessageEmote) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type MessageMention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,3,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageMention) Reset() {
+	*x = MessageMention{}
+	mi := &file_eventsub_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageMention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageMention) ProtoMessage() {}
+
+func (x *MessageMention) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageMention.ProtoReflect.Descriptor instead.
+func (*MessageMention) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *MessageMention) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MessageMention) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *MessageMention) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+type NotificationCheermote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Bits          int64                  `protobuf:"varint,2,opt,name=bits,proto3" json:"bits,omitempty"`
+	Tier          int64                  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationCheermote) Reset() {
+	*x = NotificationCheermote{}
+	mi := &file_eventsub_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationCheermote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationCheermote) ProtoMessage() {}
+
+func (x *NotificationCheermote) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationCheermote.ProtoReflect.Descriptor instead.
+func (*NotificationCheermote) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *NotificationCheermote) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *NotificationCheermote) GetBits() int64 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+func (x *NotificationCheermote) GetTier() int64 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+type NotificationEmote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmoteSetId    string                 `protobuf:"bytes,2,opt,name=emote_set_id,json=emoteSetId,proto3" json:"emote_set_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Format        []string               `protobuf:"bytes,4,rep,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationEmote) Reset() {
+	*x = NotificationEmote{}
+	mi := &file_eventsub_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationEmote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEmote) ProtoMessage() {}
+
+func (x *NotificationEmote) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEmote.ProtoReflect.Descriptor instead.
+func (*NotificationEmote) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *NotificationEmote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationEmote) GetEmoteSetId() string {
+	if x != nil {
+		return x.EmoteSetId
+	}
+	return ""
+}
+
+func (x *NotificationEmote) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *NotificationEmote) GetFormat() []string {
+	if x != nil {
+		return x.Format
+	}
+	return nil
+}
+
+type NotificationMention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserLogin     string                 `protobuf:"bytes,3,opt,name=user_login,json=userLogin,proto3" json:"user_login,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationMention) Reset() {
+	*x = NotificationMention{}
+	mi := &file_eventsub_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationMention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationMention) ProtoMessage() {}
+
+func (x *NotificationMention) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationMention.ProtoReflect.Descriptor instead.
+func (*NotificationMention) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *NotificationMention) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NotificationMention) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *NotificationMention) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+type ChannelPointsRewardEmote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelPointsRewardEmote) Reset() {
+	*x = ChannelPointsRewardEmote{}
+	mi := &file_eventsub_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelPointsRewardEmote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPointsRewardEmote) ProtoMessage() {}
+
+func (x *ChannelPointsRewardEmote) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsub_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPointsRewardEmote.ProtoReflect.Descriptor instead.
+func (*ChannelPointsRewardEmote) Descriptor() ([]byte, []int) {
+	return file_eventsub_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ChannelPointsRewardEmote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_eventsub_proto protoreflect.FileDescriptor
+
+const file_eventsub_proto_rawDesc = "" +
+	"\n" +
+	"\x0eeventsub.proto\x12\x12twitch.eventsub.v1\x1a\x1cgoogle/protobuf/struct.proto\"t\n" +
+	"\x10SubscribeRequest\x12\x14\n" +
+	"\x05types\x18\x01 \x03(\tR\x05types\x12'\n" +
+	"\x0fbroadcaster_ids\x18\x02 \x03(\tR\x0ebroadcasterIds\x12!\n" +
+	"\fresume_after\x18\x03 \x01(\tR\vresumeAfter\"\xf4B\n" +
+	"\x05Event\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12'\n" +
+	"\x0fsubscription_id\x18\x03 \x01(\tR\x0esubscriptionId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\tR\aversion\x12%\n" +
+	"\x0ebroadcaster_id\x18\x06 \x01(\tR\rbroadcasterId\x12+\n" +
+	"\x11message_timestamp\x18\a \x01(\tR\x10messageTimestamp\x12\x1f\n" +
+	"\vreceived_at\x18\b \x01(\tR\n" +
+	"receivedAt\x12\x12\n" +
+	"\x04json\x18\t \x01(\tR\x04json\x12_\n" +
+	"\x14automod_message_hold\x18d \x01(\v2+.twitch.eventsub.v1.AutomodMessageHoldEventH\x00R\x12automodMessageHold\x12e\n" +
+	"\x16automod_message_update\x18e \x01(\v2-.twitch.eventsub.v1.AutomodMessageUpdateEventH\x00R\x14automodMessageUpdate\x12h\n" +
+	"\x17automod_settings_update\x18f \x01(\v2..twitch.eventsub.v1.AutomodSettingsUpdateEventH\x00R\x15automodSettingsUpdate\x12_\n" +
+	"\x14automod_terms_update\x18g \x01(\v2+.twitch.eventsub.v1.AutomodTermsUpdateEventH\x00R\x12automodTermsUpdate\x12O\n" +
+	"\x0echannel_update\x18h \x01(\v2&.twitch.eventsub.v1.ChannelUpdateEventH\x00R\rchannelUpdate\x12O\n" +
+	"\x0echannel_follow\x18i \x01(\v2&.twitch.eventsub.v1.ChannelFollowEventH\x00R\rchannelFollow\x12c\n" +
+	"\x16channel_ad_break_begin\x18j \x01(\v2,.twitch.eventsub.v1.ChannelAdBreakBeginEventH\x00R\x13channelAdBreakBegin\x12Y\n" +
+	"\x12channel_chat_clear\x18k \x01(\v2).twitch.eventsub.v1.ChannelChatClearEventH\x00R\x10channelChatClear\x12\x7f\n" +
+	" channel_chat_clear_user_messages\x18l \x01(\v25.twitch.eventsub.v1.ChannelChatClearUserMessagesEventH\x00R\x1cchannelChatClearUserMessages\x12_\n" +
+	"\x14channel_chat_message\x18m \x01(\v2+.twitch.eventsub.v1.ChannelChatMessageEventH\x00R\x12channelChatMessage\x12r\n" +
+	"\x1bchannel_chat_message_delete\x18n \x01(\v21.twitch.eventsub.v1.ChannelChatMessageDeleteEventH\x00R\x18channelChatMessageDelete\x12n\n" +
+	"\x19channel_chat_notification\x18o \x01(\v20.twitch.eventsub.v1.ChannelChatNotificationEventH\x00R\x17channelChatNotification\x12u\n" +
+	"\x1cchannel_chat_settings_update\x18p \x01(\v22.twitch.eventsub.v1.ChannelChatSettingsUpdateEventH\x00R\x19channelChatSettingsUpdate\x12y\n" +
+	"\x1echannel_chat_user_message_hold\x18q \x01(\v23.twitch.eventsub.v1.ChannelChatUserMessageHoldEventH\x00R\x1achannelChatUserMessageHold\x12\x7f\n" +
+	" channel_chat_user_message_update\x18r \x01(\v25.twitch.eventsub.v1.ChannelChatUserMessageUpdateEventH\x00R\x1cchannelChatUserMessageUpdate\x12X\n" +
+	"\x11channel_subscribe\x18s \x01(\v2).twitch.eventsub.v1.ChannelSubscribeEventH\x00R\x10channelSubscribe\x12k\n" +
+	"\x18channel_subscription_end\x18t \x01(\v2/.twitch.eventsub.v1.ChannelSubscriptionEndEventH\x00R\x16channelSubscriptionEnd\x12n\n" +
+	"\x19channel_subscription_gift\x18u \x01(\v20.twitch.eventsub.v1.ChannelSubscriptionGiftEventH\x00R\x17channelSubscriptionGift\x12w\n" +
+	"\x1cchannel_subscription_message\x18v \x01(\v23.twitch.eventsub.v1.ChannelSubscriptionMessageEventH\x00R\x1achannelSubscriptionMessage\x12L\n" +
+	"\rchannel_cheer\x18w \x01(\v2%.twitch.eventsub.v1.ChannelCheerEventH\x00R\fchannelCheer\x12I\n" +
+	"\fchannel_raid\x18x \x01(\v2$.twitch.eventsub.v1.ChannelRaidEventH\x00R\vchannelRaid\x12F\n" +
+	"\vchannel_ban\x18y \x01(\v2#.twitch.eventsub.v1.ChannelBanEventH\x00R\n" +
+	"channelBan\x12L\n" +
+	"\rchannel_unban\x18z \x01(\v2%.twitch.eventsub.v1.ChannelUnbanEventH\x00R\fchannelUnban\x12u\n" +
+	"\x1cchannel_unban_request_create\x18{ \x01(\v22.twitch.eventsub.v1.ChannelUnbanRequestCreateEventH\x00R\x19channelUnbanRequestCreate\x12x\n" +
+	"\x1dchannel_unban_request_resolve\x18| \x01(\v23.twitch.eventsub.v1.ChannelUnbanRequestResolveEventH\x00R\x1achannelUnbanRequestResolve\x12W\n" +
+	"\x10channel_moderate\x18} \x01(\v2*.twitch.eventsub.v1.ChannelModerateEventV2H\x00R\x0fchannelModerate\x12b\n" +
+	"\x15channel_moderator_add\x18~ \x01(\v2,.twitch.eventsub.v1.ChannelModeratorAddEventH\x00R\x13channelModeratorAdd\x12k\n" +
+	"\x18channel_moderator_remove\x18\x7f \x01(\v2/.twitch.eventsub.v1.ChannelModeratorRemoveEventH\x00R\x16channelModeratorRemove\x12\x80\x01\n" +
+	" channel_guest_star_session_begin\x18\x80\x01 \x01(\v25.twitch.eventsub.v1.ChannelGuestStarSessionBeginEventH\x00R\x1cchannelGuestStarSessionBegin\x12z\n" +
+	"\x1echannel_guest_star_session_end\x18\x81\x01 \x01(\v23.twitch.eventsub.v1.ChannelGuestStarSessionEndEventH\x00R\x1achannelGuestStarSessionEnd\x12}\n" +
+	"\x1fchannel_guest_star_guest_update\x18\x82\x01 \x01(\v24.twitch.eventsub.v1.ChannelGuestStarGuestUpdateEventH\x00R\x1bchannelGuestStarGuestUpdate\x12\x86\x01\n" +
+	"\"channel_guest_star_settings_update\x18\x83\x01 \x01(\v27.twitch.eventsub.v1.ChannelGuestStarSettingsUpdateEventH\x00R\x1echannelGuestStarSettingsUpdate\x12\xb7\x01\n" +
+	"6channel_channel_points_automatic_reward_redemption_add\x18\x84\x01 \x01(\v2B.twitch.eventsub.v1.ChannelPointsAutomaticRewardRedemptionAddEventH\x00R0channelChannelPointsAutomaticRewardRedemptionAdd\x12\x8f\x01\n" +
+	"(channel_channel_points_custom_reward_add\x18\x85\x01 \x01(\v25.twitch.eventsub.v1.ChannelPointsCustomRewardAddEventH\x00R#channelChannelPointsCustomRewardAdd\x12\x98\x01\n" +
+	"+channel_channel_points_custom_reward_update\x18\x86\x01 \x01(\v28.twitch.eventsub.v1.ChannelPointsCustomRewardUpdateEventH\x00R&channelChannelPointsCustomRewardUpdate\x12\x98\x01\n" +
+	"+channel_channel_points_custom_reward_remove\x18\x87\x01 \x01(\v28.twitch.eventsub.v1.ChannelPointsCustomRewardRemoveEventH\x00R&channelChannelPointsCustomRewardRemove\x12\xae\x01\n" +
+	"3channel_channel_points_custom_reward_redemption_add\x18\x88\x01 \x01(\v2?.twitch.eventsub.v1.ChannelPointsCustomRewardRedemptionAddEventH\x00R-channelChannelPointsCustomRewardRedemptionAdd\x12\xb7\x01\n" +
+	"6channel_channel_points_custom_reward_redemption_update\x18\x89\x01 \x01(\v2B.twitch.eventsub.v1.ChannelPointsCustomRewardRedemptionUpdateEventH\x00R0channelChannelPointsCustomRewardRedemptionUpdate\x12Z\n" +
+	"\x12channel_poll_begin\x18\x8a\x01 \x01(\v2).twitch.eventsub.v1.ChannelPollBeginEventH\x00R\x10channelPollBegin\x12c\n" +
+	"\x15channel_poll_progress\x18\x8b\x01 \x01(\v2,.twitch.eventsub.v1.ChannelPollProgressEventH\x00R\x13channelPollProgress\x12T\n" +
+	"\x10channel_poll_end\x18\x8c\x01 \x01(\v2'.twitch.eventsub.v1.ChannelPollEndEventH\x00R\x0echannelPollEnd\x12l\n" +
+	"\x18channel_prediction_begin\x18\x8d\x01 \x01(\v2/.twitch.eventsub.v1.ChannelPredictionBeginEventH\x00R\x16channelPredictionBegin\x12u\n" +
+	"\x1bchannel_prediction_progress\x18\x8e\x01 \x01(\v22.twitch.eventsub.v1.ChannelPredictionProgressEventH\x00R\x19channelPredictionProgress\x12i\n" +
+	"\x17channel_prediction_lock\x18\x8f\x01 \x01(\v2..twitch.eventsub.v1.ChannelPredictionLockEventH\x00R\x15channelPredictionLock\x12f\n" +
+	"\x16channel_prediction_end\x18\x90\x01 \x01(\v2-.twitch.eventsub.v1.ChannelPredictionEndEventH\x00R\x14channelPredictionEnd\x12Q\n" +
+	"\x0fchannel_vip_add\x18\x91\x01 \x01(\v2&.twitch.eventsub.v1.ChannelVIPAddEventH\x00R\rchannelVipAdd\x12Z\n" +
+	"\x12channel_vip_remove\x18\x92\x01 \x01(\v2).twitch.eventsub.v1.ChannelVIPRemoveEventH\x00R\x10channelVipRemove\x12x\n" +
+	"\x1fchannel_charity_campaign_donate\x18\x93\x01 \x01(\v2..twitch.eventsub.v1.CharityCampaignDonateEventH\x00R\x1cchannelCharityCampaignDonate\x12u\n" +
+	"\x1echannel_charity_campaign_start\x18\x94\x01 \x01(\v2-.twitch.eventsub.v1.CharityCampaignStartEventH\x00R\x1bchannelCharityCampaignStart\x12~\n" +
+	"!channel_charity_campaign_progress\x18\x95\x01 \x01(\v20.twitch.eventsub.v1.CharityCampaignProgressEventH\x00R\x1echannelCharityCampaignProgress\x12r\n" +
+	"\x1dchannel_charity_campaign_stop\x18\x96\x01 \x01(\v2,.twitch.eventsub.v1.CharityCampaignStopEventH\x00R\x1achannelCharityCampaignStop\x12f\n" +
+	"\x16conduit_shard_disabled\x18\x97\x01 \x01(\v2-.twitch.eventsub.v1.ConduitShardDisabledEventH\x00R\x14conduitShardDisabled\x12g\n" +
+	"\x16drop_entitlement_grant\x18\x98\x01 \x01(\v2..twitch.eventsub.v1.DropEntitlementGrantEventsH\x00R\x14dropEntitlementGrant\x12\x85\x01\n" +
+	"!extension_bits_transaction_create\x18\x99\x01 \x01(\v27.twitch.eventsub.v1.ExtensionBitsTransactionCreateEventH\x00R\x1eextensionBitsTransactionCreate\x12Z\n" +
+	"\x12channel_goal_begin\x18\x9a\x01 \x01(\v2).twitch.eventsub.v1.ChannelGoalBeginEventH\x00R\x10channelGoalBegin\x12c\n" +
+	"\x15channel_goal_progress\x18\x9b\x01 \x01(\v2,.twitch.eventsub.v1.ChannelGoalProgressEventH\x00R\x13channelGoalProgress\x12T\n" +
+	"\x10channel_goal_end\x18\x9c\x01 \x01(\v2'.twitch.eventsub.v1.ChannelGoalEndEventH\x00R\x0echannelGoalEnd\x12l\n" +
+	"\x18channel_hype_train_begin\x18\x9d\x01 \x01(\v20.twitch.eventsub.v1.ChannelHypeTrainBeginEventV2H\x00R\x15channelHypeTrainBegin\x12u\n" +
+	"\x1bchannel_hype_train_progress\x18\x9e\x01 \x01(\v23.twitch.eventsub.v1.ChannelHypeTrainProgressEventV2H\x00R\x18channelHypeTrainProgress\x12f\n" +
+	"\x16channel_hype_train_end\x18\x9f\x01 \x01(\v2..twitch.eventsub.v1.ChannelHypeTrainEndEventV2H\x00R\x13channelHypeTrainEnd\x12m\n" +
+	"\x19channel_shield_mode_begin\x18\xa0\x01 \x01(\v2/.twitch.eventsub.v1.ChannelShieldModeBeginEventH\x00R\x16channelShieldModeBegin\x12g\n" +
+	"\x17channel_shield_mode_end\x18\xa1\x01 \x01(\v2-.twitch.eventsub.v1.ChannelShieldModeEndEventH\x00R\x14channelShieldModeEnd\x12i\n" +
+	"\x17channel_shoutout_create\x18\xa2\x01 \x01(\v2..twitch.eventsub.v1.ChannelShoutOutCreateEventH\x00R\x15channelShoutoutCreate\x12m\n" +
+	"\x18channel_shoutout_receive\x18\xa3\x01 \x01(\v20.twitch.eventsub.v1.ChannelShoutOutReceivedEventH\x00R\x16channelShoutoutReceive\x12M\n" +
+	"\rstream_online\x18\xa4\x01 \x01(\v2%.twitch.eventsub.v1.StreamOnlineEventH\x00R\fstreamOnline\x12P\n" +
+	"\x0estream_offline\x18\xa5\x01 \x01(\v2&.twitch.eventsub.v1.StreamOfflineEventH\x00R\rstreamOffline\x12l\n" +
+	"\x18user_authorization_grant\x18\xa6\x01 \x01(\v2/.twitch.eventsub.v1.UserAuthorizationGrantEventH\x00R\x16userAuthorizationGrant\x12o\n" +
+	"\x19user_authorization_revoke\x18\xa7\x01 \x01(\v20.twitch.eventsub.v1.UserAuthorizationRevokeEventH\x00R\x17userAuthorizationRevoke\x12G\n" +
+	"\vuser_update\x18\xa8\x01 \x01(\v2#.twitch.eventsub.v1.UserUpdateEventH\x00R\n" +
+	"userUpdate\x12`\n" +
+	"\x14user_whisper_message\x18\xa9\x01 \x01(\v2+.twitch.eventsub.v1.UserWhisperMessageEventH\x00R\x12userWhisperMessage\x12|\n" +
+	"\x1echannel_suspicious_user_update\x18\xaa\x01 \x01(\v24.twitch.eventsub.v1.ChannelSuspiciousUserUpdateEventH\x00R\x1bchannelSuspiciousUserUpdate\x12T\n" +
+	"\x10channel_bits_use\x18\xab\x01 \x01(\v2'.twitch.eventsub.v1.ChannelBitsUseEventH\x00R\x0echannelBitsUse\x12\x7f\n" +
+	"\x1fchannel_suspicious_user_message\x18\xac\x01 \x01(\v25.twitch.eventsub.v1.ChannelSuspiciousUserMessageEventH\x00R\x1cchannelSuspiciousUserMessage\x12u\n" +
+	"\x1bchannel_warning_acknowledge\x18\xad\x01 \x01(\v22.twitch.eventsub.v1.ChannelWarningAcknowledgeEventH\x00R\x19channelWarningAcknowledge\x12`\n" +
+	"\x14channel_warning_send\x18\xae\x01 \x01(\v2+.twitch.eventsub.v1.ChannelWarningSendEventH\x00R\x12channelWarningSendB\t\n" +
+	"\apayload\"c\n" +
+	"\x1aDropEntitlementGrantEvents\x12E\n" +
+	"\x06events\x18\x01 \x03(\v2-.twitch.eventsub.v1.DropEntitlementGrantEventR\x06events\"\xbb\x03\n" +
+	"\x17AutomodMessageHoldEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x05 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x06 \x01(\tR\tuserLogin\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\a \x01(\tR\tmessageId\x12G\n" +
+	"\amessage\x18\b \x01(\v2-.twitch.eventsub.v1.AutomodMessageHoldMessageR\amessage\x12\x14\n" +
+	"\x05level\x18\t \x01(\x03R\x05level\x12\x1a\n" +
+	"\bcategory\x18\n" +
+	" \x01(\tR\bcategory\x12\x17\n" +
+	"\aheld_at\x18\v \x01(\tR\x06heldAt\"\xf0\x04\n" +
+	"\x19AutomodMessageUpdateEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x05 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x06 \x01(\tR\tuserLogin\x12*\n" +
+	"\x11moderator_user_id\x18\a \x01(\tR\x0fmoderatorUserId\x120\n" +
+	"\x14moderator_user_login\x18\b \x01(\tR\x12moderatorUserLogin\x12.\n" +
+	"\x13moderator_user_name\x18\t \x01(\tR\x11moderatorUserName\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\n" +
+	" \x01(\tR\tmessageId\x12\x18\n" +
+	"\amessage\x18\v \x01(\tR\amessage\x12\x14\n" +
+	"\x05level\x18\f \x01(\x03R\x05level\x12\x1a\n" +
+	"\bcategory\x18\r \x01(\tR\bcategory\x12\x16\n" +
+	"\x06status\x18\x0e \x01(\tR\x06status\x12\x17\n" +
+	"\aheld_at\x18\x0f \x01(\tR\x06heldAt\x12:\n" +
+	"\tfragments\x18\x10 \x01(\v2\x1c.twitch.eventsub.v1.FragmentR\tfragments\"d\n" +
+	"\x1aAutomodSettingsUpdateEvent\x12F\n" +
+	"\x04data\x18\x01 \x03(\v22.twitch.eventsub.v1.AutomodSettingsUpdateEventDataR\x04data\"\x92\x03\n" +
+	"\x17AutomodTermsUpdateEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12*\n" +
+	"\x11moderator_user_id\x18\x04 \x01(\tR\x0fmoderatorUserId\x120\n" +
+	"\x14moderator_user_login\x18\x05 \x01(\tR\x12moderatorUserLogin\x12.\n" +
+	"\x13moderator_user_name\x18\x06 \x01(\tR\x11moderatorUserName\x12\x16\n" +
+	"\x06action\x18\a \x01(\tR\x06action\x12!\n" +
+	"\ffrom_automod\x18\b \x01(\bR\vfromAutomod\x12\x14\n" +
+	"\x05terms\x18\t \x03(\tR\x05terms\"\xea\x02\n" +
+	"\x12ChannelUpdateEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\a \x01(\tR\fcategoryName\x12B\n" +
+	"\x1dcontent_classification_labels\x18\b \x03(\tR\x1bcontentClassificationLabels\"\xa4\x02\n" +
+	"\x12ChannelFollowEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\x12\x1f\n" +
+	"\vfollowed_at\x18\a \x01(\tR\n" +
+	"followedAt\"\xaf\x03\n" +
+	"\x18ChannelAdBreakBeginEvent\x12)\n" +
+	"\x10duration_seconds\x18\x01 \x01(\tR\x0fdurationSeconds\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\tR\tstartedAt\x12!\n" +
+	"\fis_automatic\x18\x03 \x01(\tR\visAutomatic\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\x12*\n" +
+	"\x11requester_user_id\x18\a \x01(\tR\x0frequesterUserId\x120\n" +
+	"\x14requester_user_login\x18\b \x01(\tR\x12requesterUserLogin\x12.\n" +
+	"\x13requester_user_name\x18\t \x01(\tR\x11requesterUserName\"\xb1\x01\n" +
+	"\x15ChannelChatClearEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\"\xb9\x02\n" +
+	"!ChannelChatClearUserMessagesEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12$\n" +
+	"\x0etarget_user_id\x18\x04 \x01(\tR\ftargetUserId\x12(\n" +
+	"\x10target_user_name\x18\x05 \x01(\tR\x0etargetUserName\x12*\n" +
+	"\x11target_user_login\x18\x06 \x01(\tR\x0ftargetUserLogin\"\xc8\t\n" +
+	"\x17ChannelChatMessageEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x12&\n" +
+	"\x0fchatter_user_id\x18\x04 \x01(\tR\rchatterUserId\x12,\n" +
+	"\x12chatter_user_login\x18\x05 \x01(\tR\x10chatterUserLogin\x12*\n" +
+	"\x11chatter_user_name\x18\x06 \x01(\tR\x0fchatterUserName\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\a \x01(\tR\tmessageId\x125\n" +
+	"\amessage\x18\b \x01(\v2\x1b.twitch.eventsub.v1.MessageR\amessage\x12\x14\n" +
+	"\x05color\x18\t \x01(\tR\x05color\x122\n" +
+	"\x06badges\x18\n" +
+	" \x03(\v2\x1a.twitch.eventsub.v1.BadgesR\x06badges\x12!\n" +
+	"\fmessage_type\x18\v \x01(\tR\vmessageType\x126\n" +
+	"\x05cheer\x18\f \x01(\v2 .twitch.eventsub.v1.MessageCheerR\x05cheer\x12/\n" +
+	"\x05reply\x18\r \x01(\v2\x19.twitch.eventsub.v1.ReplyR\x05reply\x12I\n" +
+	"\x1fchannel_points_custom_reward_id\x18\x0e \x01(\tH\x00R\x1bchannelPointsCustomRewardId\x88\x01\x01\x12@\n" +
+	"\x1asource_broadcaster_user_id\x18\x0f \x01(\tH\x01R\x17sourceBroadcasterUserId\x88\x01\x01\x12D\n" +
+	"\x1csource_broadcaster_user_name\x18\x10 \x01(\tH\x02R\x19sourceBroadcasterUserName\x88\x01\x01\x12F\n" +
+	"\x1dsource_broadcaster_user_login\x18\x11 \x01(\tH\x03R\x1asourceBroadcasterUserLogin\x88\x01\x01\x12/\n" +
+	"\x11source_message_id\x18\x12 \x01(\tH\x04R\x0fsourceMessageId\x88\x01\x01\x12?\n" +
+	"\rsource_badges\x18\x13 \x03(\v2\x1a.twitch.eventsub.v1.BadgesR\fsourceBadges\x12)\n" +
+	"\x0eis_source_only\x18\x14 \x01(\bH\x05R\fisSourceOnly\x88\x01\x01B\"\n" +
+	" _channel_points_custom_reward_idB\x1d\n" +
+	"\x1b_source_broadcaster_user_idB\x1f\n" +
+	"\x1d_source_broadcaster_user_nameB \n" +
+	"\x1e_source_broadcaster_user_loginB\x14\n" +
+	"\x12_source_message_idB\x11\n" +
+	"\x0f_is_source_only\"\xd4\x02\n" +
+	"\x1dChannelChatMessageDeleteEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12$\n" +
+	"\x0etarget_user_id\x18\x04 \x01(\tR\ftargetUserId\x12(\n" +
+	"\x10target_user_name\x18\x05 \x01(\tR\x0etargetUserName\x12*\n" +
+	"\x11target_user_login\x18\x06 \x01(\tR\x0ftargetUserLogin\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\a \x01(\tR\tmessageId\"\xf8\v\n" +
+	"\x1cChannelChatNotificationEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12&\n" +
+	"\x0fchatter_user_id\x18\x04 \x01(\tR\rchatterUserId\x12,\n" +
+	"\x12chatter_user_login\x18\x05 \x01(\tR\x10chatterUserLogin\x12*\n" +
+	"\x11chatter_user_name\x18\x06 \x01(\tR\x0fchatterUserName\x120\n" +
+	"\x14chatter_is_anonymous\x18\a \x01(\bR\x12chatterIsAnonymous\x12\x14\n" +
+	"\x05color\x18\b \x01(\tR\x05color\x122\n" +
+	"\x06badges\x18\t \x03(\v2\x1a.twitch.eventsub.v1.BadgesR\x06badges\x12%\n" +
+	"\x0esystem_message\x18\n" +
+	" \x01(\tR\rsystemMessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\v \x01(\tR\tmessageId\x12A\n" +
+	"\amessage\x18\f \x01(\v2'.twitch.eventsub.v1.NotificationMessageR\amessage\x12\x1f\n" +
+	"\vnotice_type\x18\r \x01(\tR\n" +
+	"noticeType\x125\n" +
+	"\x03sub\x18\x0e \x01(\v2#.twitch.eventsub.v1.NotificationSubR\x03sub\x12;\n" +
+	"\x05resub\x18\x0f \x01(\v2%.twitch.eventsub.v1.NotificationResubR\x05resub\x12B\n" +
+	"\bsub_gift\x18\x10 \x01(\v2'.twitch.eventsub.v1.NotificationSubGiftR\asubGift\x12^\n" +
+	"\x12community_sub_gift\x18\x11 \x01(\v20.twitch.eventsub.v1.NotificationCommunitySubGiftR\x10communitySubGift\x12[\n" +
+	"\x11gift_paid_upgrade\x18\x12 \x01(\v2/.twitch.eventsub.v1.NotificationGiftPaidUpgradeR\x0fgiftPaidUpgrade\x12^\n" +
+	"\x12prime_paid_upgrade\x18\x13 \x01(\v20.twitch.eventsub.v1.NotificationPrimePaidUpgradeR\x10primePaidUpgrade\x12R\n" +
+	"\x0epay_it_forward\x18\x14 \x01(\v2,.twitch.eventsub.v1.NotificationPayItForwardR\fpayItForward\x128\n" +
+	"\x04raid\x18\x15 \x01(\v2$.twitch.eventsub.v1.NotificationRaidR\x04raid\x12.\n" +
+	"\x06unraid\x18\x16 \x01(\v2\x16.google.protobuf.ValueR\x06unraid\x12P\n" +
+	"\fannouncement\x18\x17 \x01(\v2,.twitch.eventsub.v1.NotificationAnnouncementR\fannouncement\x12U\n" +
+	"\x0fbits_badge_tier\x18\x18 \x01(\v2-.twitch.eventsub.v1.NotificationBitsBadgeTierR\rbitsBadgeTier\x12Z\n" +
+	"\x10charity_donation\x18\x19 \x01(\v2/.twitch.eventsub.v1.NotificationCharityDonationR\x0fcharityDonation\"\x99\x04\n" +
+	"\x1eChannelChatSettingsUpdateEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x12\x1d\n" +
+	"\n" +
+	"emote_mode\x18\x04 \x01(\bR\temoteMode\x12#\n" +
+	"\rfollower_mode\x18\x05 \x01(\bR\ffollowerMode\x12H\n" +
+	"\x1efollower_mode_duration_minutes\x18\x06 \x01(\x03H\x00R\x1bfollowerModeDurationMinutes\x88\x01\x01\x12\x1b\n" +
+	"\tslow_mode\x18\a \x01(\bR\bslowMode\x12<\n" +
+	"\x1bslow_mode_wait_time_seconds\x18\b \x01(\x03R\x17slowModeWaitTimeSeconds\x12'\n" +
+	"\x0fsubscriber_mode\x18\t \x01(\bR\x0esubscriberMode\x12(\n" +
+	"\x10unique_chat_mode\x18\n" +
+	" \x01(\bR\x0euniqueChatModeB!\n" +
+	"\x1f_follower_mode_duration_minutes\"\xe6\x02\n" +
+	"\x1fChannelChatUserMessageHoldEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x05 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\a \x01(\tR\tmessageId\x125\n" +
+	"\amessage\x18\b \x01(\v2\x1b.twitch.eventsub.v1.MessageR\amessage\"\x80\x03\n" +
+	"!ChannelChatUserMessageUpdateEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x05 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\b \x01(\tR\tmessageId\x125\n" +
+	"\amessage\x18\t \x01(\v2\x1b.twitch.eventsub.v1.MessageR\amessage\"\xb3\x02\n" +
+	"\x15ChannelSubscribeEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\x12\x12\n" +
+	"\x04tier\x18\a \x01(\tR\x04tier\x12\x17\n" +
+	"\ais_gift\x18\b \x01(\bR\x06isGift\"\xb9\x02\n" +
+	"\x1bChannelSubscriptionEndEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\x12\x12\n" +
+	"\x04tier\x18\a \x01(\tR\x04tier\x12\x17\n" +
+	"\ais_gift\x18\b \x01(\bR\x06isGift\"\xd7\x03\n" +
+	"\x1cChannelSubscriptionGiftEvent\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tH\x01R\tuserLogin\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x03 \x01(\tH\x02R\buserName\x88\x01\x01\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05total\x18\a \x01(\x03R\x05total\x12\x12\n" +
+	"\x04tier\x18\b \x01(\tR\x04tier\x12.\n" +
+	"\x10cumulative_total\x18\t \x01(\x03H\x03R\x0fcumulativeTotal\x88\x01\x01\x12!\n" +
+	"\fis_anonymous\x18\n" +
+	" \x01(\bR\visAnonymousB\n" +
+	"\n" +
+	"\b_user_idB\r\n" +
+	"\v_user_loginB\f\n" +
+	"\n" +
+	"_user_nameB\x13\n" +
+	"\x11_cumulative_total\"\xff\x03\n" +
+	"\x1fChannelSubscriptionMessageEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\x12\x12\n" +
+	"\x04tier\x18\a \x01(\tR\x04tier\x12G\n" +
+	"\amessage\x18\b \x01(\v2-.twitch.eventsub.v1.SubscriptionMessageStructR\amessage\x12+\n" +
+	"\x11cumulative_months\x18\t \x01(\x03R\x10cumulativeMonths\x12(\n" +
+	"\rstreak_months\x18\n" +
+	" \x01(\x03H\x00R\fstreakMonths\x88\x01\x01\x12'\n" +
+	"\x0fduration_months\x18\v \x01(\x03R\x0edurationMonthsB\x10\n" +
+	"\x0e_streak_months\"\x8b\x03\n" +
+	"\x11ChannelCheerEvent\x12!\n" +
+	"\fis_anonymous\x18\x01 \x01(\bR\visAnonymous\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tH\x00R\x06userId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_login\x18\x03 \x01(\tH\x01R\tuserLogin\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x04 \x01(\tH\x02R\buserName\x88\x01\x01\x12.\n" +
+	"\x13broadcaster_user_id\x18\x05 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x06 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\a \x01(\tR\x13broadcasterUserName\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x12\x12\n" +
+	"\x04bits\x18\t \x01(\x03R\x04bitsB\n" +
+	"\n" +
+	"\b_user_idB\r\n" +
+	"\v_user_loginB\f\n" +
+	"\n" +
+	"_user_name\"\x8a\x03\n" +
+	"\x10ChannelRaidEvent\x127\n" +
+	"\x18from_broadcaster_user_id\x18\x01 \x01(\tR\x15fromBroadcasterUserId\x12=\n" +
+	"\x1bfrom_broadcaster_user_login\x18\x02 \x01(\tR\x18fromBroadcasterUserLogin\x12;\n" +
+	"\x1afrom_broadcaster_user_name\x18\x03 \x01(\tR\x17fromBroadcasterUserName\x123\n" +
+	"\x16to_broadcaster_user_id\x18\x04 \x01(\tR\x13toBroadcasterUserId\x129\n" +
+	"\x19to_broadcaster_user_login\x18\x05 \x01(\tR\x16toBroadcasterUserLogin\x127\n" +
+	"\x18to_broadcaster_user_name\x18\x06 \x01(\tR\x15toBroadcasterUserName\x12\x18\n" +
+	"\aviewers\x18\a \x01(\x03R\aviewers\"\x90\x04\n" +
+	"\x0fChannelBanEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\x12*\n" +
+	"\x11moderator_user_id\x18\a \x01(\tR\x0fmoderatorUserId\x120\n" +
+	"\x14moderator_user_login\x18\b \x01(\tR\x12moderatorUserLogin\x12.\n" +
+	"\x13moderator_user_name\x18\t \x01(\tR\x11moderatorUserName\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12\x1b\n" +
+	"\tbanned_at\x18\v \x01(\tR\bbannedAt\x12\x1c\n" +
+	"\aends_at\x18\f \x01(\tH\x00R\x06endsAt\x88\x01\x01\x12!\n" +
+	"\fis_permanent\x18\r \x01(\bR\visPermanentB\n" +
+	"\n" +
+	"\b_ends_at\"\x90\x03\n" +
+	"\x11ChannelUnbanEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\x12*\n" +
+	"\x11moderator_user_id\x18\a \x01(\tR\x0fmoderatorUserId\x120\n" +
+	"\x14moderator_user_login\x18\b \x01(\tR\x12moderatorUserLogin\x12.\n" +
+	"\x13moderator_user_name\x18\t \x01(\tR\x11moderatorUserName\"\xd2\x02\n" +
+	"\x1eChannelUnbanRequestCreateEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x06 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\a \x01(\tR\buserName\x12\x12\n" +
+	"\x04text\x18\b \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\xef\x03\n" +
+	"\x1fChannelUnbanRequestResolveEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12*\n" +
+	"\x11moderator_user_id\x18\x05 \x01(\tR\x0fmoderatorUserId\x120\n" +
+	"\x14moderator_user_login\x18\x06 \x01(\tR\x12moderatorUserLogin\x12.\n" +
+	"\x13moderator_user_name\x18\a \x01(\tR\x11moderatorUserName\x12\x17\n" +
+	"\auser_id\x18\b \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\t \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\n" +
+	" \x01(\tR\buserName\x12'\n" +
+	"\x0fresolution_text\x18\v \x01(\tR\x0eresolutionText\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\"\x85\x0e\n" +
+	"\x16ChannelModerateEventV2\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x12;\n" +
+	"\x1asource_broadcaster_user_id\x18\x04 \x01(\tR\x17sourceBroadcasterUserId\x12A\n" +
+	"\x1dsource_broadcaster_user_login\x18\x05 \x01(\tR\x1asourceBroadcasterUserLogin\x12>\n" +
+	"\x1bsourcebroadcaster_user_name\x18\x06 \x01(\tR\x19sourcebroadcasterUserName\x12*\n" +
+	"\x11moderator_user_id\x18\a \x01(\tR\x0fmoderatorUserId\x120\n" +
+	"\x14moderator_user_login\x18\b \x01(\tR\x12moderatorUserLogin\x12.\n" +
+	"\x13moderator_user_name\x18\t \x01(\tR\x11moderatorUserName\x12\x16\n" +
+	"\x06action\x18\n" +
+	" \x01(\tR\x06action\x12G\n" +
+	"\tfollowers\x18\v \x01(\v2).twitch.eventsub.v1.FollowDurationMinutesR\tfollowers\x12,\n" +
+	"\x04slow\x18\f \x01(\v2\x18.twitch.eventsub.v1.SlowR\x04slow\x12.\n" +
+	"\x03vip\x18\r \x01(\v2\x1c.twitch.eventsub.v1.UserDataR\x03vip\x122\n" +
+	"\x05unvip\x18\x0e \x01(\v2\x1c.twitch.eventsub.v1.UserDataR\x05unvip\x12.\n" +
+	"\x03mod\x18\x0f \x01(\v2\x1c.twitch.eventsub.v1.UserDataR\x03mod\x122\n" +
+	"\x05unmod\x18\x10 \x01(\v2\x1c.twitch.eventsub.v1.UserDataR\x05unmod\x12.\n" +
+	"\x03ban\x18\x11 \x01(\v2\x1c.twitch.eventsub.v1.EventBanR\x03ban\x122\n" +
+	"\x05unban\x18\x12 \x01(\v2\x1c.twitch.eventsub.v1.UserDataR\x05unban\x125\n" +
+	"\atimeout\x18\x13 \x01(\v2\x1b.twitch.eventsub.v1.TimeoutR\atimeout\x12:\n" +
+	"\tuntimeout\x18\x14 \x01(\v2\x1c.twitch.eventsub.v1.UserDataR\tuntimeout\x121\n" +
+	"\x04raid\x18\x15 \x01(\v2\x1d.twitch.eventsub.v1.EventRaidR\x04raid\x124\n" +
+	"\x06unraid\x18\x16 \x01(\v2\x1c.twitch.eventsub.v1.UserDataR\x06unraid\x122\n" +
+	"\x06delete\x18\x17 \x01(\v2\x1a.twitch.eventsub.v1.DeleteR\x06delete\x12E\n" +
+	"\rautomod_terms\x18\x18 \x01(\v2 .twitch.eventsub.v1.AutoModTermsR\fautomodTerms\x12E\n" +
+	"\runban_request\x18\x19 \x01(\v2 .twitch.eventsub.v1.UnBanRequestR\funbanRequest\x121\n" +
+	"\x04warn\x18\x1a \x01(\v2\x1d.twitch.eventsub.v1.WarnEventR\x04warn\x12D\n" +
+	"\x0fshared_chat_ban\x18\x1b \x01(\v2\x1c.twitch.eventsub.v1.EventBanR\rsharedChatBan\x12H\n" +
+	"\x11shared_chat_unban\x18\x1c \x01(\v2\x1c.twitch.eventsub.v1.UserDataR\x0fsharedChatUnban\x12K\n" +
+	"\x13shared_chat_timeout\x18\x1d \x01(\v2\x1b.twitch.eventsub.v1.TimeoutR\x11sharedChatTimeout\x12P\n" +
+	"\x15shared_chat_untimeout\x18\x1e \x01(\v2\x1c.twitch.eventsub.v1.UserDataR\x13sharedChatUntimeout\x12H\n" +
+	"\x12shared_chat_delete\x18\x1f \x01(\v2\x1a.twitch.eventsub.v1.DeleteR\x10sharedChatDelete\"\x89\x02\n" +
+	"\x18ChannelModeratorAddEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\"\x8c\x02\n" +
+	"\x1bChannelModeratorRemoveEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\"\x89\x03\n" +
+	"!ChannelGuestStarSessionBeginEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12*\n" +
+	"\x11moderator_user_id\x18\x04 \x01(\tR\x0fmoderatorUserId\x12.\n" +
+	"\x13moderator_user_name\x18\x05 \x01(\tR\x11moderatorUserName\x120\n" +
+	"\x14moderator_user_login\x18\x06 \x01(\tR\x12moderatorUserLogin\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\"\xa2\x03\n" +
+	"\x1fChannelGuestStarSessionEndEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12*\n" +
+	"\x11moderator_user_id\x18\x04 \x01(\tR\x0fmoderatorUserId\x12.\n" +
+	"\x13moderator_user_name\x18\x05 \x01(\tR\x11moderatorUserName\x120\n" +
+	"\x14moderator_user_login\x18\x06 \x01(\tR\x12moderatorUserLogin\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\t \x01(\tR\aendedAt\"\x88\b\n" +
+	" ChannelGuestStarGuestUpdateEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12/\n" +
+	"\x11moderator_user_id\x18\x05 \x01(\tH\x00R\x0fmoderatorUserId\x88\x01\x01\x123\n" +
+	"\x13moderator_user_name\x18\x06 \x01(\tH\x01R\x11moderatorUserName\x88\x01\x01\x125\n" +
+	"\x14moderator_user_login\x18\a \x01(\tH\x02R\x12moderatorUserLogin\x88\x01\x01\x12'\n" +
+	"\rguest_user_id\x18\b \x01(\tH\x03R\vguestUserId\x88\x01\x01\x12+\n" +
+	"\x0fguest_user_name\x18\t \x01(\tH\x04R\rguestUserName\x88\x01\x01\x12-\n" +
+	"\x10guest_user_login\x18\n" +
+	" \x01(\tH\x05R\x0eguestUserLogin\x88\x01\x01\x12\x1c\n" +
+	"\aslot_id\x18\v \x01(\tH\x06R\x06slotId\x88\x01\x01\x12\x19\n" +
+	"\x05state\x18\f \x01(\tH\aR\x05state\x88\x01\x01\x121\n" +
+	"\x12host_video_enabled\x18\r \x01(\bH\bR\x10hostVideoEnabled\x88\x01\x01\x121\n" +
+	"\x12host_audio_enabled\x18\x0e \x01(\bH\tR\x10hostAudioEnabled\x88\x01\x01\x12$\n" +
+	"\vhost_volume\x18\x0f \x01(\x03H\n" +
+	"R\n" +
+	"hostVolume\x88\x01\x01\x12 \n" +
+	"\fhost_user_id\x18\x10 \x01(\tR\n" +
+	"hostUserId\x12$\n" +
+	"\x0ehost_user_name\x18\x11 \x01(\tR\fhostUserName\x12&\n" +
+	"\x0fhost_user_login\x18\x12 \x01(\tR\rhostUserLoginB\x14\n" +
+	"\x12_moderator_user_idB\x16\n" +
+	"\x14_moderator_user_nameB\x17\n" +
+	"\x15_moderator_user_loginB\x10\n" +
+	"\x0e_guest_user_idB\x12\n" +
+	"\x10_guest_user_nameB\x13\n" +
+	"\x11_guest_user_loginB\n" +
+	"\n" +
+	"\b_slot_idB\b\n" +
+	"\x06_stateB\x15\n" +
+	"\x13_host_video_enabledB\x15\n" +
+	"\x13_host_audio_enabledB\x0e\n" +
+	"\f_host_volume\"\x8b\x03\n" +
+	"#ChannelGuestStarSettingsUpdateEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12B\n" +
+	"\x1eis_moderator_send_live_enabled\x18\x04 \x01(\bR\x1aisModeratorSendLiveEnabled\x12\x1d\n" +
+	"\n" +
+	"slot_count\x18\x05 \x01(\x03R\tslotCount\x12D\n" +
+	"\x1fis_browser_source_audio_enabled\x18\x06 \x01(\bR\x1bisBrowserSourceAudioEnabled\x12!\n" +
+	"\fgroup_layout\x18\a \x01(\tR\vgroupLayout\"\xfa\x03\n" +
+	".ChannelPointsAutomaticRewardRedemptionAddEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x05 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x06 \x01(\tR\tuserLogin\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02id\x12?\n" +
+	"\x06reward\x18\b \x01(\v2'.twitch.eventsub.v1.ChannelPointsRewardR\x06reward\x12H\n" +
+	"\amessage\x18\t \x01(\v2..twitch.eventsub.v1.ChannelPointsRewardMessageR\amessage\x12\x1d\n" +
+	"\n" +
+	"user_input\x18\n" +
+	" \x01(\tR\tuserInput\x12\x1f\n" +
+	"\vredeemed_at\x18\v \x01(\tR\n" +
+	"redeemedAt\"\xb2\b\n" +
+	"!ChannelPointsCustomRewardAddEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x05 \x01(\bR\tisEnabled\x12\x1b\n" +
+	"\tis_paused\x18\x06 \x01(\bR\bisPaused\x12\x1e\n" +
+	"\vis_in_stock\x18\a \x01(\bR\tisInStock\x12\x14\n" +
+	"\x05title\x18\b \x01(\tR\x05title\x12\x12\n" +
+	"\x04cost\x18\t \x01(\x03R\x04cost\x12\x16\n" +
+	"\x06prompt\x18\n" +
+	" \x01(\tR\x06prompt\x123\n" +
+	"\x16is_user_input_required\x18\v \x01(\bR\x13isUserInputRequired\x12P\n" +
+	"%should_redemptions_skip_request_queue\x18\f \x01(\bR!shouldRedemptionsSkipRequestQueue\x123\n" +
+	"\x13cooldown_expires_at\x18\r \x01(\tH\x00R\x11cooldownExpiresAt\x88\x01\x01\x12R\n" +
+	"#redemptions_redeemed_current_stream\x18\x0e \x01(\x03H\x01R redemptionsRedeemedCurrentStream\x88\x01\x01\x12@\n" +
+	"\x0emax_per_stream\x18\x0f \x01(\v2\x1a.twitch.eventsub.v1.MaxPerR\fmaxPerStream\x12P\n" +
+	"\x17max_per_user_per_stream\x18\x10 \x01(\v2\x1a.twitch.eventsub.v1.MaxPerR\x13maxPerUserPerStream\x12E\n" +
+	"\x0fglobal_cooldown\x18\x11 \x01(\v2\x1c.twitch.eventsub.v1.CooldownR\x0eglobalCooldown\x12)\n" +
+	"\x10background_color\x18\x12 \x01(\tR\x0fbackgroundColor\x12/\n" +
+	"\x05image\x18\x13 \x01(\v2\x19.twitch.eventsub.v1.ImageR\x05image\x12>\n" +
+	"\rdefault_image\x18\x14 \x01(\v2\x19.twitch.eventsub.v1.ImageR\fdefaultImageB\x16\n" +
+	"\x14_cooldown_expires_atB&\n" +
+	"$_redemptions_redeemed_current_stream\"\xb5\b\n" +
+	"$ChannelPointsCustomRewardUpdateEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x05 \x01(\bR\tisEnabled\x12\x1b\n" +
+	"\tis_paused\x18\x06 \x01(\bR\bisPaused\x12\x1e\n" +
+	"\vis_in_stock\x18\a \x01(\bR\tisInStock\x12\x14\n" +
+	"\x05title\x18\b \x01(\tR\x05title\x12\x12\n" +
+	"\x04cost\x18\t \x01(\x03R\x04cost\x12\x16\n" +
+	"\x06prompt\x18\n" +
+	" \x01(\tR\x06prompt\x123\n" +
+	"\x16is_user_input_required\x18\v \x01(\bR\x13isUserInputRequired\x12P\n" +
+	"%should_redemptions_skip_request_queue\x18\f \x01(\bR!shouldRedemptionsSkipRequestQueue\x123\n" +
+	"\x13cooldown_expires_at\x18\r \x01(\tH\x00R\x11cooldownExpiresAt\x88\x01\x01\x12R\n" +
+	"#redemptions_redeemed_current_stream\x18\x0e \x01(\x03H\x01R redemptionsRedeemedCurrentStream\x88\x01\x01\x12@\n" +
+	"\x0emax_per_stream\x18\x0f \x01(\v2\x1a.twitch.eventsub.v1.MaxPerR\fmaxPerStream\x12P\n" +
+	"\x17max_per_user_per_stream\x18\x10 \x01(\v2\x1a.twitch.eventsub.v1.MaxPerR\x13maxPerUserPerStream\x12E\n" +
+	"\x0fglobal_cooldown\x18\x11 \x01(\v2\x1c.twitch.eventsub.v1.CooldownR\x0eglobalCooldown\x12)\n" +
+	"\x10background_color\x18\x12 \x01(\tR\x0fbackgroundColor\x12/\n" +
+	"\x05image\x18\x13 \x01(\v2\x19.twitch.eventsub.v1.ImageR\x05image\x12>\n" +
+	"\rdefault_image\x18\x14 \x01(\v2\x19.twitch.eventsub.v1.ImageR\fdefaultImageB\x16\n" +
+	"\x14_cooldown_expires_atB&\n" +
+	"$_redemptions_redeemed_current_stream\"\xb5\b\n" +
+	"$ChannelPointsCustomRewardRemoveEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x05 \x01(\bR\tisEnabled\x12\x1b\n" +
+	"\tis_paused\x18\x06 \x01(\bR\bisPaused\x12\x1e\n" +
+	"\vis_in_stock\x18\a \x01(\bR\tisInStock\x12\x14\n" +
+	"\x05title\x18\b \x01(\tR\x05title\x12\x12\n" +
+	"\x04cost\x18\t \x01(\x03R\x04cost\x12\x16\n" +
+	"\x06prompt\x18\n" +
+	" \x01(\tR\x06prompt\x123\n" +
+	"\x16is_user_input_required\x18\v \x01(\bR\x13isUserInputRequired\x12P\n" +
+	"%should_redemptions_skip_request_queue\x18\f \x01(\bR!shouldRedemptionsSkipRequestQueue\x123\n" +
+	"\x13cooldown_expires_at\x18\r \x01(\tH\x00R\x11cooldownExpiresAt\x88\x01\x01\x12R\n" +
+	"#redemptions_redeemed_current_stream\x18\x0e \x01(\x03H\x01R redemptionsRedeemedCurrentStream\x88\x01\x01\x12@\n" +
+	"\x0emax_per_stream\x18\x0f \x01(\v2\x1a.twitch.eventsub.v1.MaxPerR\fmaxPerStream\x12P\n" +
+	"\x17max_per_user_per_stream\x18\x10 \x01(\v2\x1a.twitch.eventsub.v1.MaxPerR\x13maxPerUserPerStream\x12E\n" +
+	"\x0fglobal_cooldown\x18\x11 \x01(\v2\x1c.twitch.eventsub.v1.CooldownR\x0eglobalCooldown\x12)\n" +
+	"\x10background_color\x18\x12 \x01(\tR\x0fbackgroundColor\x12/\n" +
+	"\x05image\x18\x13 \x01(\v2\x19.twitch.eventsub.v1.ImageR\x05image\x12>\n" +
+	"\rdefault_image\x18\x14 \x01(\v2\x19.twitch.eventsub.v1.ImageR\fdefaultImageB\x16\n" +
+	"\x14_cooldown_expires_atB&\n" +
+	"$_redemptions_redeemed_current_stream\"\xb8\x03\n" +
+	"+ChannelPointsCustomRewardRedemptionAddEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x06 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\a \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_input\x18\b \x01(\tR\tuserInput\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x122\n" +
+	"\x06reward\x18\n" +
+	" \x01(\v2\x1a.twitch.eventsub.v1.RewardR\x06reward\x12\x1f\n" +
+	"\vredeemed_at\x18\v \x01(\tR\n" +
+	"redeemedAt\"\xbb\x03\n" +
+	".ChannelPointsCustomRewardRedemptionUpdateEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x06 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\a \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_input\x18\b \x01(\tR\tuserInput\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x122\n" +
+	"\x06reward\x18\n" +
+	" \x01(\v2\x1a.twitch.eventsub.v1.RewardR\x06reward\x12\x1f\n" +
+	"\vredeemed_at\x18\v \x01(\tR\n" +
+	"redeemedAt\"\xa3\x03\n" +
+	"\x15ChannelPollBeginEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x125\n" +
+	"\achoices\x18\x06 \x03(\v2\x1b.twitch.eventsub.v1.ChoicesR\achoices\x12[\n" +
+	"\x15channel_points_voting\x18\a \x01(\v2'.twitch.eventsub.v1.ChannelPointsVotingR\x13channelPointsVoting\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\x12\x17\n" +
+	"\aends_at\x18\t \x01(\tR\x06endsAt\"\xa6\x03\n" +
+	"\x18ChannelPollProgressEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x125\n" +
+	"\achoices\x18\x06 \x03(\v2\x1b.twitch.eventsub.v1.ChoicesR\achoices\x12[\n" +
+	"\x15channel_points_voting\x18\a \x01(\v2'.twitch.eventsub.v1.ChannelPointsVotingR\x13channelPointsVoting\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\x12\x17\n" +
+	"\aends_at\x18\t \x01(\tR\x06endsAt\"\xbb\x03\n" +
+	"\x13ChannelPollEndEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x125\n" +
+	"\achoices\x18\x06 \x03(\v2\x1b.twitch.eventsub.v1.ChoicesR\achoices\x12[\n" +
+	"\x15channel_points_voting\x18\a \x01(\v2'.twitch.eventsub.v1.ChannelPointsVotingR\x13channelPointsVoting\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\t \x01(\tR\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\n" +
+	" \x01(\tR\aendedAt\"\xd1\x02\n" +
+	"\x1bChannelPredictionBeginEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x128\n" +
+	"\boutcomes\x18\x06 \x03(\v2\x1c.twitch.eventsub.v1.OutcomesR\boutcomes\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\a \x01(\tR\tstartedAt\x12\x19\n" +
+	"\blocks_at\x18\b \x01(\tR\alocksAt\"\xd4\x02\n" +
+	"\x1eChannelPredictionProgressEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x128\n" +
+	"\boutcomes\x18\x06 \x03(\v2\x1c.twitch.eventsub.v1.OutcomesR\boutcomes\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\a \x01(\tR\tstartedAt\x12\x19\n" +
+	"\blocks_at\x18\b \x01(\tR\alocksAt\"\xd2\x02\n" +
+	"\x1aChannelPredictionLockEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x128\n" +
+	"\boutcomes\x18\x06 \x03(\v2\x1c.twitch.eventsub.v1.OutcomesR\boutcomes\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\a \x01(\tR\tstartedAt\x12\x1b\n" +
+	"\tlocked_at\x18\b \x01(\tR\blockedAt\"\x95\x03\n" +
+	"\x19ChannelPredictionEndEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12,\n" +
+	"\x12winning_outcome_id\x18\x06 \x01(\tR\x10winningOutcomeId\x128\n" +
+	"\boutcomes\x18\a \x03(\v2\x1c.twitch.eventsub.v1.OutcomesR\boutcomes\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\t \x01(\tR\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\n" +
+	" \x01(\tR\aendedAt\"\x83\x02\n" +
+	"\x12ChannelVIPAddEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\"\x86\x02\n" +
+	"\x15ChannelVIPRemoveEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12.\n" +
+	"\x13broadcaster_user_id\x18\x04 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x06 \x01(\tR\x13broadcasterUserName\"\x90\x04\n" +
+	"\x1aCharityCampaignDonateEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcampaign_id\x18\x02 \x01(\tR\n" +
+	"campaignId\x12.\n" +
+	"\x13broadcaster_user_id\x18\x03 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x05 \x01(\tR\x14broadcasterUserLogin\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\a \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\b \x01(\tR\buserName\x12!\n" +
+	"\fcharity_name\x18\t \x01(\tR\vcharityName\x12/\n" +
+	"\x13charity_description\x18\n" +
+	" \x01(\tR\x12charityDescription\x12!\n" +
+	"\fcharity_logo\x18\v \x01(\tR\vcharityLogo\x12'\n" +
+	"\x0fcharity_website\x18\f \x01(\tR\x0echarityWebsite\x122\n" +
+	"\x06amount\x18\r \x01(\v2\x1a.twitch.eventsub.v1.AmountR\x06amount\"\xed\x03\n" +
+	"\x19CharityCampaignStartEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0ebroadcaster_id\x18\x02 \x01(\tR\rbroadcasterId\x12)\n" +
+	"\x10broadcaster_name\x18\x03 \x01(\tR\x0fbroadcasterName\x12+\n" +
+	"\x11broadcaster_login\x18\x04 \x01(\tR\x10broadcasterLogin\x12!\n" +
+	"\fcharity_name\x18\x05 \x01(\tR\vcharityName\x12/\n" +
+	"\x13charity_description\x18\x06 \x01(\tR\x12charityDescription\x12!\n" +
+	"\fcharity_logo\x18\a \x01(\tR\vcharityLogo\x12'\n" +
+	"\x0fcharity_website\x18\b \x01(\tR\x0echarityWebsite\x12A\n" +
+	"\x0ecurrent_amount\x18\t \x01(\v2\x1a.twitch.eventsub.v1.AmountR\rcurrentAmount\x12?\n" +
+	"\rtarget_amount\x18\n" +
+	" \x01(\v2\x1a.twitch.eventsub.v1.AmountR\ftargetAmount\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\v \x01(\tR\tstartedAt\"\xd1\x03\n" +
+	"\x1cCharityCampaignProgressEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0ebroadcaster_id\x18\x02 \x01(\tR\rbroadcasterId\x12)\n" +
+	"\x10broadcaster_name\x18\x03 \x01(\tR\x0fbroadcasterName\x12+\n" +
+	"\x11broadcaster_login\x18\x04 \x01(\tR\x10broadcasterLogin\x12!\n" +
+	"\fcharity_name\x18\x05 \x01(\tR\vcharityName\x12/\n" +
+	"\x13charity_description\x18\x06 \x01(\tR\x12charityDescription\x12!\n" +
+	"\fcharity_logo\x18\a \x01(\tR\vcharityLogo\x12'\n" +
+	"\x0fcharity_website\x18\b \x01(\tR\x0echarityWebsite\x12A\n" +
+	"\x0ecurrent_amount\x18\t \x01(\v2\x1a.twitch.eventsub.v1.AmountR\rcurrentAmount\x12?\n" +
+	"\rtarget_amount\x18\n" +
+	" \x01(\v2\x1a.twitch.eventsub.v1.AmountR\ftargetAmount\"\xec\x03\n" +
+	"\x18CharityCampaignStopEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0ebroadcaster_id\x18\x02 \x01(\tR\rbroadcasterId\x12)\n" +
+	"\x10broadcaster_name\x18\x03 \x01(\tR\x0fbroadcasterName\x12+\n" +
+	"\x11broadcaster_login\x18\x04 \x01(\tR\x10broadcasterLogin\x12!\n" +
+	"\fcharity_name\x18\x05 \x01(\tR\vcharityName\x12/\n" +
+	"\x13charity_description\x18\x06 \x01(\tR\x12charityDescription\x12!\n" +
+	"\fcharity_logo\x18\a \x01(\tR\vcharityLogo\x12'\n" +
+	"\x0fcharity_website\x18\b \x01(\tR\x0echarityWebsite\x12A\n" +
+	"\x0ecurrent_amount\x18\t \x01(\v2\x1a.twitch.eventsub.v1.AmountR\rcurrentAmount\x12?\n" +
+	"\rtarget_amount\x18\n" +
+	" \x01(\v2\x1a.twitch.eventsub.v1.AmountR\ftargetAmount\x12\x1d\n" +
+	"\n" +
+	"stopped_at\x18\v \x01(\tR\tstoppedAt\"\xb1\x01\n" +
+	"\x19ConduitShardDisabledEvent\x12\x1d\n" +
+	"\n" +
+	"conduit_id\x18\x01 \x01(\tR\tconduitId\x12\x19\n" +
+	"\bshard_id\x18\x02 \x01(\tR\ashardId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12B\n" +
+	"\ttransport\x18\x04 \x01(\v2$.twitch.eventsub.v1.ConduitTransportR\ttransport\"]\n" +
+	"\x19DropEntitlementGrantEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x04data\x18\x02 \x01(\v2\x1c.twitch.eventsub.v1.DropDataR\x04data\"\x8b\x03\n" +
+	"#ExtensionBitsTransactionCreateEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13extension_client_id\x18\x02 \x01(\tR\x11extensionClientId\x12.\n" +
+	"\x13broadcaster_user_id\x18\x03 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x04 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x05 \x01(\tR\x13broadcasterUserName\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\a \x01(\tR\tuserLogin\x12\x17\n" +
+	"\auser_id\x18\b \x01(\tR\x06userId\x125\n" +
+	"\aproduct\x18\t \x01(\v2\x1b.twitch.eventsub.v1.ProductR\aproduct\"\xe2\x02\n" +
+	"\x15ChannelGoalBeginEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x04 \x01(\tR\x14broadcasterUserLogin\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12%\n" +
+	"\x0ecurrent_amount\x18\a \x01(\x03R\rcurrentAmount\x12#\n" +
+	"\rtarget_amount\x18\b \x01(\x03R\ftargetAmount\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\t \x01(\tR\tstartedAt\"\xe5\x02\n" +
+	"\x18ChannelGoalProgressEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x04 \x01(\tR\x14broadcasterUserLogin\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12%\n" +
+	"\x0ecurrent_amount\x18\a \x01(\x03R\rcurrentAmount\x12#\n" +
+	"\rtarget_amount\x18\b \x01(\x03R\ftargetAmount\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\t \x01(\tR\tstartedAt\"\x9c\x03\n" +
+	"\x13ChannelGoalEndEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x04 \x01(\tR\x14broadcasterUserLogin\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vis_achieved\x18\a \x01(\bR\n" +
+	"isAchieved\x12%\n" +
+	"\x0ecurrent_amount\x18\b \x01(\x03R\rcurrentAmount\x12#\n" +
+	"\rtarget_amount\x18\t \x01(\x03R\ftargetAmount\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\tR\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\v \x01(\tR\aendedAt\"\xb3\x05\n" +
+	"\x1cChannelHypeTrainBeginEventV2\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12\x1a\n" +
+	"\bprogress\x18\x06 \x01(\x03R\bprogress\x12\x12\n" +
+	"\x04goal\x18\a \x01(\x03R\x04goal\x12M\n" +
+	"\x11top_contributions\x18\b \x03(\v2 .twitch.eventsub.v1.ContributionR\x10topContributions\x12\x14\n" +
+	"\x05level\x18\t \x01(\x03R\x05level\x12-\n" +
+	"\x13all_time_high_level\x18\n" +
+	" \x01(\x03R\x10allTimeHighLevel\x12-\n" +
+	"\x13all_time_high_total\x18\v \x01(\x03R\x10allTimeHighTotal\x12f\n" +
+	"\x19shared_train_participants\x18\f \x03(\v2*.twitch.eventsub.v1.SharedTrainParticipantR\x17sharedTrainParticipants\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\r \x01(\tR\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\tR\texpiresAt\x12\x12\n" +
+	"\x04type\x18\x0f \x01(\tR\x04type\x12&\n" +
+	"\x0fis_shared_train\x18\x10 \x01(\bR\risSharedTrain\"\xd8\x04\n" +
+	"\x1fChannelHypeTrainProgressEventV2\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12\x1a\n" +
+	"\bprogress\x18\x06 \x01(\x03R\bprogress\x12\x12\n" +
+	"\x04goal\x18\a \x01(\x03R\x04goal\x12M\n" +
+	"\x11top_contributions\x18\b \x03(\v2 .twitch.eventsub.v1.ContributionR\x10topContributions\x12\x14\n" +
+	"\x05level\x18\t \x01(\x03R\x05level\x12f\n" +
+	"\x19shared_train_participants\x18\n" +
+	" \x03(\v2*.twitch.eventsub.v1.SharedTrainParticipantR\x17sharedTrainParticipants\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\v \x01(\tR\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\tR\texpiresAt\x12\x12\n" +
+	"\x04type\x18\r \x01(\tR\x04type\x12&\n" +
+	"\x0fis_shared_train\x18\x0e \x01(\bR\risSharedTrain\"\xc9\x04\n" +
+	"\x1aChannelHypeTrainEndEventV2\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12M\n" +
+	"\x11top_contributions\x18\x06 \x03(\v2 .twitch.eventsub.v1.ContributionR\x10topContributions\x12\x14\n" +
+	"\x05level\x18\a \x01(\x03R\x05level\x12f\n" +
+	"\x19shared_train_participants\x18\b \x03(\v2*.twitch.eventsub.v1.SharedTrainParticipantR\x17sharedTrainParticipants\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\t \x01(\tR\tstartedAt\x12(\n" +
+	"\x10cooldown_ends_at\x18\n" +
+	" \x01(\tR\x0ecooldownEndsAt\x12\x19\n" +
+	"\bended_at\x18\v \x01(\tR\aendedAt\x12\x12\n" +
+	"\x04type\x18\f \x01(\tR\x04type\x12&\n" +
+	"\x0fis_shared_train\x18\r \x01(\bR\risSharedTrain\"\xe4\x02\n" +
+	"\x1bChannelShieldModeBeginEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12*\n" +
+	"\x11moderator_user_id\x18\x04 \x01(\tR\x0fmoderatorUserId\x12.\n" +
+	"\x13moderator_user_name\x18\x05 \x01(\tR\x11moderatorUserName\x120\n" +
+	"\x14moderator_user_login\x18\x06 \x01(\tR\x12moderatorUserLogin\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\a \x01(\tR\tstartedAt\"\xde\x02\n" +
+	"\x19ChannelShieldModeEndEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12*\n" +
+	"\x11moderator_user_id\x18\x04 \x01(\tR\x0fmoderatorUserId\x12.\n" +
+	"\x13moderator_user_name\x18\x05 \x01(\tR\x11moderatorUserName\x120\n" +
+	"\x14moderator_user_login\x18\x06 \x01(\tR\x12moderatorUserLogin\x12\x19\n" +
+	"\bended_at\x18\a \x01(\tR\aendedAt\"\x90\x05\n" +
+	"\x1aChannelShoutOutCreateEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12*\n" +
+	"\x11moderator_user_id\x18\x04 \x01(\tR\x0fmoderatorUserId\x12.\n" +
+	"\x13moderator_user_name\x18\x05 \x01(\tR\x11moderatorUserName\x120\n" +
+	"\x14moderator_user_login\x18\x06 \x01(\tR\x12moderatorUserLogin\x123\n" +
+	"\x16to_broadcaster_user_id\x18\a \x01(\tR\x13toBroadcasterUserId\x127\n" +
+	"\x18to_broadcaster_user_name\x18\b \x01(\tR\x15toBroadcasterUserName\x129\n" +
+	"\x19to_broadcaster_user_login\x18\t \x01(\tR\x16toBroadcasterUserLogin\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\tR\tstartedAt\x12!\n" +
+	"\fviewer_count\x18\v \x01(\x03R\vviewerCount\x12(\n" +
+	"\x10cooldown_ends_at\x18\f \x01(\tR\x0ecooldownEndsAt\x125\n" +
+	"\x17target_cooldown_ends_at\x18\r \x01(\tR\x14targetCooldownEndsAt\"\xaf\x03\n" +
+	"\x1cChannelShoutOutReceivedEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x127\n" +
+	"\x18from_broadcaster_user_id\x18\x04 \x01(\tR\x15fromBroadcasterUserId\x12;\n" +
+	"\x1afrom_broadcaster_user_name\x18\x05 \x01(\tR\x17fromBroadcasterUserName\x12=\n" +
+	"\x1bfrom_broadcaster_user_login\x18\x06 \x01(\tR\x18fromBroadcasterUserLogin\x12!\n" +
+	"\fviewer_count\x18\a \x01(\x03R\vviewerCount\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\"\xf0\x01\n" +
+	"\x11StreamOnlineEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13broadcaster_user_id\x18\x02 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x04 \x01(\tR\x13broadcasterUserName\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\tR\tstartedAt\"\xae\x01\n" +
+	"\x12StreamOfflineEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\"\x8f\x01\n" +
+	"\x1bUserAuthorizationGrantEvent\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x03 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\"\xb7\x01\n" +
+	"\x1cUserAuthorizationRevokeEvent\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\"\n" +
+	"\n" +
+	"user_login\x18\x03 \x01(\tH\x00R\tuserLogin\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x04 \x01(\tH\x01R\buserName\x88\x01\x01B\r\n" +
+	"\v_user_loginB\f\n" +
+	"\n" +
+	"_user_name\"\xc5\x01\n" +
+	"\x0fUserUpdateEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12%\n" +
+	"\x0eemail_verified\x18\x05 \x01(\bR\remailVerified\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"\xc3\x02\n" +
+	"\x17UserWhisperMessageEvent\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\tR\n" +
+	"fromUserId\x12&\n" +
+	"\x0ffrom_user_login\x18\x02 \x01(\tR\rfromUserLogin\x12$\n" +
+	"\x0efrom_user_name\x18\x03 \x01(\tR\ffromUserName\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x04 \x01(\tR\btoUserId\x12\"\n" +
+	"\rto_user_login\x18\x05 \x01(\tR\vtoUserLogin\x12 \n" +
+	"\fto_user_name\x18\x06 \x01(\tR\n" +
+	"toUserName\x12\x1d\n" +
+	"\n" +
+	"whisper_id\x18\a \x01(\tR\twhisperId\x125\n" +
+	"\awhisper\x18\b \x01(\v2\x1b.twitch.eventsub.v1.WhisperR\awhisper\"\xc9\x03\n" +
+	" ChannelSuspiciousUserUpdateEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x122\n" +
+	"\x15broadcaster_user_name\x18\x02 \x01(\tR\x13broadcasterUserName\x124\n" +
+	"\x16broadcaster_user_login\x18\x03 \x01(\tR\x14broadcasterUserLogin\x12*\n" +
+	"\x11moderator_user_id\x18\x04 \x01(\tR\x0fmoderatorUserId\x12.\n" +
+	"\x13moderator_user_name\x18\x05 \x01(\tR\x11moderatorUserName\x120\n" +
+	"\x14moderator_user_login\x18\x06 \x01(\tR\x12moderatorUserLogin\x12\x17\n" +
+	"\auser_id\x18\a \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\b \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\t \x01(\tR\tuserLogin\x12(\n" +
+	"\x10low_trust_status\x18\n" +
+	" \x01(\tR\x0elowTrustStatus\"\xc1\x03\n" +
+	"\x13ChannelBitsUseEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x05 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\x12\x12\n" +
+	"\x04bits\x18\a \x01(\x03R\x04bits\x12\x12\n" +
+	"\x04type\x18\b \x01(\tR\x04type\x12H\n" +
+	"\amessage\x18\t \x01(\v2..twitch.eventsub.v1.ChannelBitsUseEventMessageR\amessage\x12I\n" +
+	"\bpower_up\x18\n" +
+	" \x01(\v2..twitch.eventsub.v1.ChannelBitsUseEventPowerUpR\apowerUp\"\xfe\x03\n" +
+	"!ChannelSuspiciousUserMessageEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x05 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\x12(\n" +
+	"\x10low_trust_status\x18\a \x01(\tR\x0elowTrustStatus\x123\n" +
+	"\x16shared_ban_channel_ids\x18\b \x03(\tR\x13sharedBanChannelIds\x12\x14\n" +
+	"\x05types\x18\t \x03(\tR\x05types\x124\n" +
+	"\x16ban_evasion_evaluation\x18\n" +
+	" \x01(\tR\x14banEvasionEvaluation\x12?\n" +
+	"\amessage\x18\v \x01(\v2%.twitch.eventsub.v1.SuspiciousMessageR\amessage\"\x8f\x02\n" +
+	"\x1eChannelWarningAcknowledgeEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x05 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\"\xe8\x03\n" +
+	"\x17ChannelWarningSendEvent\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\x12*\n" +
+	"\x11moderator_user_id\x18\x04 \x01(\tR\x0fmoderatorUserId\x120\n" +
+	"\x14moderator_user_login\x18\x05 \x01(\tR\x12moderatorUserLogin\x12.\n" +
+	"\x13moderator_user_name\x18\x06 \x01(\tR\x11moderatorUserName\x12\x17\n" +
+	"\auser_id\x18\a \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\b \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\t \x01(\tR\buserName\x12\x1b\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tH\x00R\x06reason\x88\x01\x01\x12(\n" +
+	"\x10chat_rules_cited\x18\v \x03(\tR\x0echatRulesCitedB\t\n" +
+	"\a_reason\"k\n" +
+	"\x19AutomodMessageHoldMessage\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12:\n" +
+	"\tfragments\x18\x02 \x03(\v2\x1c.twitch.eventsub.v1.FragmentR\tfragments\"\xa4\x01\n" +
+	"\bFragment\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x121\n" +
+	"\x06emotes\x18\x03 \x03(\v2\x19.twitch.eventsub.v1.EmoteR\x06emotes\x12=\n" +
+	"\n" +
+	"cheermotes\x18\x04 \x03(\v2\x1d.twitch.eventsub.v1.CheermoteR\n" +
+	"cheermotes\"\xd6\x03\n" +
+	"\x1eAutomodSettingsUpdateEventData\x12%\n" +
+	"\x0ebroadcaster_id\x18\x01 \x01(\tR\rbroadcasterId\x12!\n" +
+	"\fmoderator_id\x18\x02 \x01(\tR\vmoderatorId\x12(\n" +
+	"\roverall_level\x18\x03 \x01(\x03H\x00R\foverallLevel\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"disability\x18\x04 \x01(\x03R\n" +
+	"disability\x12\x1e\n" +
+	"\n" +
+	"aggression\x18\x05 \x01(\x03R\n" +
+	"aggression\x125\n" +
+	"\x17sexuality_sex_or_gender\x18\x06 \x01(\x03R\x14sexualitySexOrGender\x12\x1a\n" +
+	"\bmisogyny\x18\a \x01(\x03R\bmisogyny\x12\x1a\n" +
+	"\bbullying\x18\b \x01(\x03R\bbullying\x12\x1a\n" +
+	"\bswearing\x18\t \x01(\x03R\bswearing\x12;\n" +
+	"\x1arace_ethnicity_or_religion\x18\n" +
+	" \x01(\x03R\x17raceEthnicityOrReligion\x12&\n" +
+	"\x0fsex_based_terms\x18\v \x01(\x03R\rsexBasedTermsB\x10\n" +
+	"\x0e_overall_level\"`\n" +
+	"\aMessage\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12A\n" +
+	"\tfragments\x18\x02 \x03(\v2#.twitch.eventsub.v1.MessageFragmentR\tfragments\"C\n" +
+	"\x06Badges\x12\x15\n" +
+	"\x06set_id\x18\x01 \x01(\tR\x05setId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04info\x18\x03 \x01(\tR\x04info\"\"\n" +
+	"\fMessageCheer\x12\x12\n" +
+	"\x04bits\x18\x01 \x01(\x03R\x04bits\"\x87\x03\n" +
+	"\x05Reply\x12*\n" +
+	"\x11parent_message_id\x18\x01 \x01(\tR\x0fparentMessageId\x12.\n" +
+	"\x13parent_message_body\x18\x02 \x01(\tR\x11parentMessageBody\x12$\n" +
+	"\x0eparent_user_id\x18\x03 \x01(\tR\fparentUserId\x12(\n" +
+	"\x10parent_user_name\x18\x04 \x01(\tR\x0eparentUserName\x12*\n" +
+	"\x11parent_user_login\x18\x05 \x01(\tR\x0fparentUserLogin\x12*\n" +
+	"\x11thread_message_id\x18\x06 \x01(\tR\x0fthreadMessageId\x12$\n" +
+	"\x0ethread_user_id\x18\a \x01(\tR\fthreadUserId\x12(\n" +
+	"\x10thread_user_name\x18\b \x01(\tR\x0ethreadUserName\x12*\n" +
+	"\x11thread_user_login\x18\t \x01(\tR\x0fthreadUserLogin\"q\n" +
+	"\x13NotificationMessage\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12F\n" +
+	"\tfragments\x18\x02 \x03(\v2(.twitch.eventsub.v1.NotificationFragmentR\tfragments\"p\n" +
+	"\x0fNotificationSub\x12\x19\n" +
+	"\bsub_tier\x18\x01 \x01(\tR\asubTier\x12\x19\n" +
+	"\bis_prime\x18\x02 \x01(\bR\aisPrime\x12'\n" +
+	"\x0fduration_months\x18\x03 \x01(\x03R\x0edurationMonths\"\x85\x04\n" +
+	"\x11NotificationResub\x12+\n" +
+	"\x11cumulative_months\x18\x01 \x01(\x03R\x10cumulativeMonths\x12'\n" +
+	"\x0fduration_months\x18\x02 \x01(\x03R\x0edurationMonths\x12#\n" +
+	"\rstreak_months\x18\x03 \x01(\x03R\fstreakMonths\x12\x19\n" +
+	"\bsub_tier\x18\x04 \x01(\tR\asubTier\x12\x1e\n" +
+	"\bis_prime\x18\x05 \x01(\bH\x00R\aisPrime\x88\x01\x01\x12\x17\n" +
+	"\ais_gift\x18\x06 \x01(\bR\x06isGift\x123\n" +
+	"\x13gifter_is_anonymous\x18\a \x01(\bH\x01R\x11gifterIsAnonymous\x88\x01\x01\x12)\n" +
+	"\x0egifter_user_id\x18\b \x01(\tH\x02R\fgifterUserId\x88\x01\x01\x12-\n" +
+	"\x10gifter_user_name\x18\t \x01(\tH\x03R\x0egifterUserName\x88\x01\x01\x12/\n" +
+	"\x11gifter_user_login\x18\n" +
+	" \x01(\tH\x04R\x0fgifterUserLogin\x88\x01\x01B\v\n" +
+	"\t_is_primeB\x16\n" +
+	"\x14_gifter_is_anonymousB\x11\n" +
+	"\x0f_gifter_user_idB\x13\n" +
+	"\x11_gifter_user_nameB\x14\n" +
+	"\x12_gifter_user_login\"\xf6\x02\n" +
+	"\x13NotificationSubGift\x12'\n" +
+	"\x0fduration_months\x18\x01 \x01(\x03R\x0edurationMonths\x120\n" +
+	"\x11cumulative_months\x18\x02 \x01(\x03H\x00R\x10cumulativeMonths\x88\x01\x01\x12*\n" +
+	"\x11recipient_user_id\x18\x03 \x01(\tR\x0frecipientUserId\x12.\n" +
+	"\x13recipient_user_name\x18\x04 \x01(\tR\x11recipientUserName\x120\n" +
+	"\x14recipient_user_login\x18\x05 \x01(\tR\x12recipientUserLogin\x12\x19\n" +
+	"\bsub_tier\x18\x06 \x01(\tR\asubTier\x12/\n" +
+	"\x11community_gift_id\x18\a \x01(\tH\x01R\x0fcommunityGiftId\x88\x01\x01B\x14\n" +
+	"\x12_cumulative_monthsB\x14\n" +
+	"\x12_community_gift_id\"\xa4\x01\n" +
+	"\x1cNotificationCommunitySubGift\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x19\n" +
+	"\bsub_tier\x18\x03 \x01(\tR\asubTier\x12.\n" +
+	"\x10cumulative_total\x18\x04 \x01(\x03H\x00R\x0fcumulativeTotal\x88\x01\x01B\x13\n" +
+	"\x11_cumulative_total\"\x96\x02\n" +
+	"\x1bNotificationGiftPaidUpgrade\x12.\n" +
+	"\x13gifter_is_anonymous\x18\x01 \x01(\bR\x11gifterIsAnonymous\x12)\n" +
+	"\x0egifter_user_id\x18\x02 \x01(\tH\x00R\fgifterUserId\x88\x01\x01\x12-\n" +
+	"\x10gifter_user_name\x18\x03 \x01(\tH\x01R\x0egifterUserName\x88\x01\x01\x12/\n" +
+	"\x11gifter_user_login\x18\x04 \x01(\tH\x02R\x0fgifterUserLogin\x88\x01\x01B\x11\n" +
+	"\x0f_gifter_user_idB\x13\n" +
+	"\x11_gifter_user_nameB\x14\n" +
+	"\x12_gifter_user_login\"9\n" +
+	"\x1cNotificationPrimePaidUpgrade\x12\x19\n" +
+	"\bsub_tier\x18\x01 \x01(\tR\asubTier\"\x93\x02\n" +
+	"\x18NotificationPayItForward\x12.\n" +
+	"\x13gifter_is_anonymous\x18\x01 \x01(\bR\x11gifterIsAnonymous\x12)\n" +
+	"\x0egifter_user_id\x18\x02 \x01(\tH\x00R\fgifterUserId\x88\x01\x01\x12-\n" +
+	"\x10gifter_user_name\x18\x03 \x01(\tH\x01R\x0egifterUserName\x88\x01\x01\x12/\n" +
+	"\x11gifter_user_login\x18\x04 \x01(\tH\x02R\x0fgifterUserLogin\x88\x01\x01B\x11\n" +
+	"\x0f_gifter_user_idB\x13\n" +
+	"\x11_gifter_user_nameB\x14\n" +
+	"\x12_gifter_user_login\"\xb6\x01\n" +
+	"\x10NotificationRaid\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x03 \x01(\tR\tuserLogin\x12!\n" +
+	"\fviewer_count\x18\x04 \x01(\x03R\vviewerCount\x12*\n" +
+	"\x11profile_image_url\x18\x05 \x01(\tR\x0fprofileImageUrl\"0\n" +
+	"\x18NotificationAnnouncement\x12\x14\n" +
+	"\x05color\x18\x01 \x01(\tR\x05color\"/\n" +
+	"\x19NotificationBitsBadgeTier\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\x03R\x04tier\"t\n" +
+	"\x1bNotificationCharityDonation\x12!\n" +
+	"\fcharity_name\x18\x01 \x01(\tR\vcharityName\x122\n" +
+	"\x06amount\x18\x02 \x01(\v2\x1a.twitch.eventsub.v1.AmountR\x06amount\"c\n" +
+	"\x19SubscriptionMessageStruct\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x122\n" +
+	"\x06emotes\x18\x02 \x03(\v2\x1a.twitch.eventsub.v1.EmotesR\x06emotes\"O\n" +
+	"\x15FollowDurationMinutes\x126\n" +
+	"\x17follow_duration_minutes\x18\x01 \x01(\x03R\x15followDurationMinutes\"2\n" +
+	"\x04Slow\x12*\n" +
+	"\x11wait_time_seconds\x18\x01 \x01(\x03R\x0fwaitTimeSeconds\"_\n" +
+	"\bUserData\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\"\x87\x01\n" +
+	"\bEventBan\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"\xa5\x01\n" +
+	"\aTimeout\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAtB\t\n" +
+	"\a_reason\"\x83\x01\n" +
+	"\tEventRaid\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12!\n" +
+	"\fviewer_count\x18\x04 \x01(\x03R\vviewerCount\"\x9f\x01\n" +
+	"\x06Delete\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12!\n" +
+	"\fmessage_body\x18\x05 \x01(\tR\vmessageBody\"s\n" +
+	"\fAutoModTerms\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04list\x18\x02 \x01(\tR\x04list\x12\x14\n" +
+	"\x05terms\x18\x03 \x03(\tR\x05terms\x12!\n" +
+	"\ffrom_automod\x18\x04 \x01(\bR\vfromAutomod\"\xb1\x01\n" +
+	"\fUnBanRequest\x12\x1f\n" +
+	"\vis_approved\x18\x01 \x01(\bR\n" +
+	"isApproved\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x03 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x12+\n" +
+	"\x11moderator_message\x18\x05 \x01(\tR\x10moderatorMessage\"\xb2\x01\n" +
+	"\tWarnEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01\x12(\n" +
+	"\x10chat_rules_cited\x18\x05 \x03(\tR\x0echatRulesCitedB\t\n" +
+	"\a_reason\"\x8f\x01\n" +
+	"\x13ChannelPointsReward\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12%\n" +
+	"\x0echannel_points\x18\x02 \x01(\x03R\rchannelPoints\x12=\n" +
+	"\x05emote\x18\x03 \x01(\v2'.twitch.eventsub.v1.RewardUnlockedEmoteR\x05emote\"\x86\x01\n" +
+	"\x1aChannelPointsRewardMessage\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12T\n" +
+	"\tfragments\x18\x02 \x03(\v26.twitch.eventsub.v1.ChannelPointsRewardMessageFragmentR\tfragments\"=\n" +
+	"\x06MaxPer\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x01 \x01(\bR\tisEnabled\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value\"C\n" +
+	"\bCooldown\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x01 \x01(\bR\tisEnabled\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\x03R\aseconds\"L\n" +
+	"\x05Image\x12\x15\n" +
+	"\x06url_1x\x18\x01 \x01(\tR\x05url1x\x12\x15\n" +
+	"\x06url_2x\x18\x02 \x01(\tR\x05url2x\x12\x15\n" +
+	"\x06url_4x\x18\x03 \x01(\tR\x05url4x\"Z\n" +
+	"\x06Reward\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x03R\x04cost\x12\x16\n" +
+	"\x06prompt\x18\x04 \x01(\tR\x06prompt\"w\n" +
+	"\aChoices\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x120\n" +
+	"\x14channel_points_votes\x18\x03 \x01(\x03R\x12channelPointsVotes\x12\x14\n" +
+	"\x05votes\x18\x04 \x01(\x03R\x05votes\"\\\n" +
+	"\x13ChannelPointsVoting\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x01 \x01(\bR\tisEnabled\x12&\n" +
+	"\x0famount_per_vote\x18\x02 \x01(\x03R\ramountPerVote\"\xcd\x01\n" +
+	"\bOutcomes\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x14\n" +
+	"\x05users\x18\x04 \x01(\x03R\x05users\x12%\n" +
+	"\x0echannel_points\x18\x05 \x01(\x03R\rchannelPoints\x12H\n" +
+	"\x0etop_predictors\x18\x06 \x01(\v2!.twitch.eventsub.v1.TopPredictorsR\rtopPredictors\"a\n" +
+	"\x06Amount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\x12%\n" +
+	"\x0edecimal_places\x18\x02 \x01(\x03R\rdecimalPlaces\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\x86\x02\n" +
+	"\x10ConduitTransport\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\"\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x1f\n" +
+	"\bcallback\x18\x03 \x01(\tH\x01R\bcallback\x88\x01\x01\x12&\n" +
+	"\fconnected_at\x18\x04 \x01(\tH\x02R\vconnectedAt\x88\x01\x01\x12,\n" +
+	"\x0fdisconnected_at\x18\x05 \x01(\tH\x03R\x0edisconnectedAt\x88\x01\x01B\r\n" +
+	"\v_session_idB\v\n" +
+	"\t_callbackB\x0f\n" +
+	"\r_connected_atB\x12\n" +
+	"\x10_disconnected_at\"\xd4\x02\n" +
+	"\bDropData\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12\x1f\n" +
+	"\vcampaign_id\x18\x04 \x01(\tR\n" +
+	"campaignId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\a \x01(\tR\tuserLogin\x12%\n" +
+	"\x0eentitlement_id\x18\b \x01(\tR\rentitlementId\x12\x1d\n" +
+	"\n" +
+	"benefit_id\x18\t \x01(\tR\tbenefitId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"j\n" +
+	"\aProduct\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04bits\x18\x03 \x01(\x03R\x04bits\x12%\n" +
+	"\x0ein_development\x18\x04 \x01(\bR\rinDevelopment\"\x8d\x01\n" +
+	"\fContribution\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\"\xb2\x01\n" +
+	"\x16SharedTrainParticipant\x12.\n" +
+	"\x13broadcaster_user_id\x18\x01 \x01(\tR\x11broadcasterUserId\x124\n" +
+	"\x16broadcaster_user_login\x18\x02 \x01(\tR\x14broadcasterUserLogin\x122\n" +
+	"\x15broadcaster_user_name\x18\x03 \x01(\tR\x13broadcasterUserName\"\x1d\n" +
+	"\aWhisper\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"\x7f\n" +
+	"\x1aChannelBitsUseEventMessage\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12M\n" +
+	"\tfragments\x18\x02 \x03(\v2/.twitch.eventsub.v1.ChannelBitsUseEventFragmentR\tfragments\"\xa7\x01\n" +
+	"\x1aChannelBitsUseEventPowerUp\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12I\n" +
+	"\x05emote\x18\x02 \x01(\v23.twitch.eventsub.v1.ChannelBitsUseEventPowerUpEmoteR\x05emote\x12*\n" +
+	"\x11message_effect_id\x18\x03 \x01(\tR\x0fmessageEffectId\"\x93\x01\n" +
+	"\x11SuspiciousMessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12K\n" +
+	"\tfragments\x18\x03 \x03(\v2-.twitch.eventsub.v1.SuspiciousMessageFragmentR\tfragments\"C\n" +
+	"\x05Emote\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
+	"\x06set_id\x18\x03 \x01(\tR\x06set-id\"K\n" +
+	"\tCheermote\x12\x12\n" +
+	"\x04bits\x18\x01 \x01(\x03R\x04bits\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\x03R\x04tier\"\xec\x01\n" +
+	"\x0fMessageFragment\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12;\n" +
+	"\tcheermote\x18\x03 \x01(\v2\x1d.twitch.eventsub.v1.CheermoteR\tcheermote\x126\n" +
+	"\x05emote\x18\x04 \x01(\v2 .twitch.eventsub.v1.MessageEmoteR\x05emote\x12<\n" +
+	"\amention\x18\x05 \x01(\v2\".twitch.eventsub.v1.MessageMentionR\amention\"\x87\x02\n" +
+	"\x14NotificationFragment\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12G\n" +
+	"\tcheermote\x18\x03 \x01(\v2).twitch.eventsub.v1.NotificationCheermoteR\tcheermote\x12;\n" +
+	"\x05emote\x18\x04 \x01(\v2%.twitch.eventsub.v1.NotificationEmoteR\x05emote\x12A\n" +
+	"\amention\x18\x05 \x01(\v2'.twitch.eventsub.v1.NotificationMentionR\amention\"@\n" +
+	"\x06Emotes\x12\x14\n" +
+	"\x05begin\x18\x01 \x01(\x03R\x05begin\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x03R\x03end\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"9\n" +
+	"\x13RewardUnlockedEmote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x90\x01\n" +
+	"\"ChannelPointsRewardMessageFragment\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12B\n" +
+	"\x05emote\x18\x03 \x01(\v2,.twitch.eventsub.v1.ChannelPointsRewardEmoteR\x05emote\"\xde\x01\n" +
+	"\rTopPredictors\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x02 \x01(\tR\tuserLogin\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x121\n" +
+	"\x12channel_points_won\x18\x04 \x01(\x03H\x00R\x10channelPointsWon\x88\x01\x01\x12.\n" +
+	"\x13channel_points_used\x18\x05 \x01(\x03R\x11channelPointsUsedB\x15\n" +
+	"\x13_channel_points_won\"\xb7\x01\n" +
+	"\x1bChannelBitsUseEventFragment\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x121\n" +
+	"\x06emotes\x18\x03 \x03(\v2\x19.twitch.eventsub.v1.EmoteR\x06emotes\x12=\n" +
+	"\n" +
+	"cheermotes\x18\x04 \x03(\v2\x1d.twitch.eventsub.v1.CheermoteR\n" +
+	"cheermotes\"E\n" +
+	"\x1fChannelBitsUseEventPowerUpEmote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xb8\x01\n" +
+	"\x19SuspiciousMessageFragment\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12;\n" +
+	"\tcheermote\x18\x03 \x01(\v2\x1d.twitch.eventsub.v1.CheermoteR\tcheermote\x126\n" +
+	"\x05emote\x18\x04 \x01(\v2 .twitch.eventsub.v1.MessageEmoteR\x05emote\"s\n" +
+	"\fMessageEmote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\femote_set_id\x18\x02 \x01(\tR\n" +
+	"emoteSetId\x12\x16\n" +
+	"\x06format\x18\x03 \x03(\tR\x06format\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\"e\n" +
+	"\x0eMessageMention\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x03 \x01(\tR\tuserLogin\"W\n" +
+	"\x15NotificationCheermote\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x12\n" +
+	"\x04bits\x18\x02 \x01(\x03R\x04bits\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\x03R\x04tier\"x\n" +
+	"\x11NotificationEmote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\femote_set_id\x18\x02 \x01(\tR\n" +
+	"emoteSetId\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06format\x18\x04 \x03(\tR\x06format\"j\n" +
+	"\x13NotificationMention\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_login\x18\x03 \x01(\tR\tuserLogin\"*\n" +
+	"\x18ChannelPointsRewardEmote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2Z\n" +
+	"\bEventSub\x12N\n" +
+	"\tSubscribe\x12$.twitch.eventsub.v1.SubscribeRequest\x1a\x19.twitch.eventsub.v1.Event0\x01BAZ?github.com/Aiuzu42/go-twitch-eventsub/eventsubgrpc;eventsubgrpcb\x06proto3"
+
+var (
+	file_eventsub_proto_rawDescOnce sync.Once
+	file_eventsub_proto_rawDescData []byte
+)
+
+func file_eventsub_proto_rawDescGZIP() []byte {
+	file_eventsub_proto_rawDescOnce.Do(func() {
+		file_eventsub_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_eventsub_proto_rawDesc), len(file_eventsub_proto_rawDesc)))
+	})
+	return file_eventsub_proto_rawDescData
+}
+
+var file_eventsub_proto_msgTypes = make([]protoimpl.MessageInfo, 144)
+var file_eventsub_proto_goTypes = []any{
+	(*SubscribeRequest)(nil),                               // 0: twitch.eventsub.v1.SubscribeRequest
+	(*Event)(nil),                                          // 1: twitch.eventsub.v1.Event
+	(*DropEntitlementGrantEvents)(nil),                     // 2: twitch.eventsub.v1.DropEntitlementGrantEvents
+	(*AutomodMessageHoldEvent)(nil),                        // 3: twitch.eventsub.v1.AutomodMessageHoldEvent
+	(*AutomodMessageUpdateEvent)(nil),                      // 4: twitch.eventsub.v1.AutomodMessageUpdateEvent
+	(*AutomodSettingsUpdateEvent)(nil),                     // 5: twitch.eventsub.v1.AutomodSettingsUpdateEvent
+	(*AutomodTermsUpdateEvent)(nil),                        // 6: twitch.eventsub.v1.AutomodTermsUpdateEvent
+	(*ChannelUpdateEvent)(nil),                             // 7: twitch.eventsub.v1.ChannelUpdateEvent
+	(*ChannelFollowEvent)(nil),                             // 8: twitch.eventsub.v1.ChannelFollowEvent
+	(*ChannelAdBreakBeginEvent)(nil),                       // 9: twitch.eventsub.v1.ChannelAdBreakBeginEvent
+	(*ChannelChatClearEvent)(nil),                          // 10: twitch.eventsub.v1.ChannelChatClearEvent
+	(*ChannelChatClearUserMessagesEvent)(nil),              // 11: twitch.eventsub.v1.ChannelChatClearUserMessagesEvent
+	(*ChannelChatMessageEvent)(nil),                        // 12: twitch.eventsub.v1.ChannelChatMessageEvent
+	(*ChannelChatMessageDeleteEvent)(nil),                  // 13: twitch.eventsub.v1.ChannelChatMessageDeleteEvent
+	(*ChannelChatNotificationEvent)(nil),                   // 14: twitch.eventsub.v1.ChannelChatNotificationEvent
+	(*ChannelChatSettingsUpdateEvent)(nil),                 // 15: twitch.eventsub.v1.ChannelChatSettingsUpdateEvent
+	(*ChannelChatUserMessageHoldEvent)(nil),                // 16: twitch.eventsub.v1.ChannelChatUserMessageHoldEvent
+	(*ChannelChatUserMessageUpdateEvent)(nil),              // 17: twitch.eventsub.v1.ChannelChatUserMessageUpdateEvent
+	(*ChannelSubscribeEvent)(nil),                          // 18: twitch.eventsub.v1.ChannelSubscribeEvent
+	(*ChannelSubscriptionEndEvent)(nil),                    // 19: twitch.eventsub.v1.ChannelSubscriptionEndEvent
+	(*ChannelSubscriptionGiftEvent)(nil),                   // 20: twitch.eventsub.v1.ChannelSubscriptionGiftEvent
+	(*ChannelSubscriptionMessageEvent)(nil),                // 21: twitch.eventsub.v1.ChannelSubscriptionMessageEvent
+	(*ChannelCheerEvent)(nil),                              // 22: twitch.eventsub.v1.ChannelCheerEvent
+	(*ChannelRaidEvent)(nil),                               // 23: twitch.eventsub.v1.ChannelRaidEvent
+	(*ChannelBanEvent)(nil),                                // 24: twitch.eventsub.v1.ChannelBanEvent
+	(*ChannelUnbanEvent)(nil),                              // 25: twitch.eventsub.v1.ChannelUnbanEvent
+	(*ChannelUnbanRequestCreateEvent)(nil),                 // 26: twitch.eventsub.v1.ChannelUnbanRequestCreateEvent
+	(*ChannelUnbanRequestResolveEvent)(nil),                // 27: twitch.eventsub.v1.ChannelUnbanRequestResolveEvent
+	(*ChannelModerateEventV2)(nil),                         // 28: twitch.eventsub.v1.ChannelModerateEventV2
+	(*ChannelModeratorAddEvent)(nil),                       // 29: twitch.eventsub.v1.ChannelModeratorAddEvent
+	(*ChannelModeratorRemoveEvent)(nil),                    // 30: twitch.eventsub.v1.ChannelModeratorRemoveEvent
+	(*ChannelGuestStarSessionBeginEvent)(nil),              // 31: twitch.eventsub.v1.ChannelGuestStarSessionBeginEvent
+	(*ChannelGuestStarSessionEndEvent)(nil),                // 32: twitch.eventsub.v1.ChannelGuestStarSessionEndEvent
+	(*ChannelGuestStarGuestUpdateEvent)(nil),               // 33: twitch.eventsub.v1.ChannelGuestStarGuestUpdateEvent
+	(*ChannelGuestStarSettingsUpdateEvent)(nil),            // 34: twitch.eventsub.v1.ChannelGuestStarSettingsUpdateEvent
+	(*ChannelPointsAutomaticRewardRedemptionAddEvent)(nil), // 35: twitch.eventsub.v1.ChannelPointsAutomaticRewardRedemptionAddEvent
+	(*ChannelPointsCustomRewardAddEvent)(nil),              // 36: twitch.eventsub.v1.ChannelPointsCustomRewardAddEvent
+	(*ChannelPointsCustomRewardUpdateEvent)(nil),           // 37: twitch.eventsub.v1.ChannelPointsCustomRewardUpdateEvent
+	(*ChannelPointsCustomRewardRemoveEvent)(nil),           // 38: twitch.eventsub.v1.ChannelPointsCustomRewardRemoveEvent
+	(*ChannelPointsCustomRewardRedemptionAddEvent)(nil),    // 39: twitch.eventsub.v1.ChannelPointsCustomRewardRedemptionAddEvent
+	(*ChannelPointsCustomRewardRedemptionUpdateEvent)(nil), // 40: twitch.eventsub.v1.ChannelPointsCustomRewardRedemptionUpdateEvent
+	(*ChannelPollBeginEvent)(nil),                          // 41: twitch.eventsub.v1.ChannelPollBeginEvent
+	(*ChannelPollProgressEvent)(nil),                       // 42: twitch.eventsub.v1.ChannelPollProgressEvent
+	(*ChannelPollEndEvent)(nil),                            // 43: twitch.eventsub.v1.ChannelPollEndEvent
+	(*ChannelPredictionBeginEvent)(nil),                    // 44: twitch.eventsub.v1.ChannelPredictionBeginEvent
+	(*ChannelPredictionProgressEvent)(nil),                 // 45: twitch.eventsub.v1.ChannelPredictionProgressEvent
+	(*ChannelPredictionLockEvent)(nil),                     // 46: twitch.eventsub.v1.ChannelPredictionLockEvent
+	(*ChannelPredictionEndEvent)(nil),                      // 47: twitch.eventsub.v1.ChannelPredictionEndEvent
+	(*ChannelVIPAddEvent)(nil),                             // 48: twitch.eventsub.v1.ChannelVIPAddEvent
+	(*ChannelVIPRemoveEvent)(nil),                          // 49: twitch.eventsub.v1.ChannelVIPRemoveEvent
+	(*CharityCampaignDonateEvent)(nil),                     // 50: twitch.eventsub.v1.CharityCampaignDonateEvent
+	(*CharityCampaignStartEvent)(nil),                      // 51: twitch.eventsub.v1.CharityCampaignStartEvent
+	(*CharityCampaignProgressEvent)(nil),                   // 52: twitch.eventsub.v1.CharityCampaignProgressEvent
+	(*CharityCampaignStopEvent)(nil),                       // 53: twitch.eventsub.v1.CharityCampaignStopEvent
+	(*ConduitShardDisabledEvent)(nil),                      // 54: twitch.eventsub.v1.ConduitShardDisabledEvent
+	(*DropEntitlementGrantEvent)(nil),                      // 55: twitch.eventsub.v1.DropEntitlementGrantEvent
+	(*ExtensionBitsTransactionCreateEvent)(nil),            // 56: twitch.eventsub.v1.ExtensionBitsTransactionCreateEvent
+	(*ChannelGoalBeginEvent)(nil),                          // 57: twitch.eventsub.v1.ChannelGoalBeginEvent
+	(*ChannelGoalProgressEvent)(nil),                       // 58: twitch.eventsub.v1.ChannelGoalProgressEvent
+	(*ChannelGoalEndEvent)(nil),                            // 59: twitch.eventsub.v1.ChannelGoalEndEvent
+	(*ChannelHypeTrainBeginEventV2)(nil),                   // 60: twitch.eventsub.v1.ChannelHypeTrainBeginEventV2
+	(*ChannelHypeTrainProgressEventV2)(nil),                // 61: twitch.eventsub.v1.ChannelHypeTrainProgressEventV2
+	(*ChannelHypeTrainEndEventV2)(nil),                     // 62: twitch.eventsub.v1.ChannelHypeTrainEndEventV2
+	(*ChannelShieldModeBeginEvent)(nil),                    // 63: twitch.eventsub.v1.ChannelShieldModeBeginEvent
+	(*ChannelShieldModeEndEvent)(nil),                      // 64: twitch.eventsub.v1.ChannelShieldModeEndEvent
+	(*ChannelShoutOutCreateEvent)(nil),                     // 65: twitch.eventsub.v1.ChannelShoutOutCreateEvent
+	(*ChannelShoutOutReceivedEvent)(nil),                   // 66: twitch.eventsub.v1.ChannelShoutOutReceivedEvent
+	(*StreamOnlineEvent)(nil),                              // 67: twitch.eventsub.v1.StreamOnlineEvent
+	(*StreamOfflineEvent)(nil),                             // 68: twitch.eventsub.v1.StreamOfflineEvent
+	(*UserAuthorizationGrantEvent)(nil),                    // 69: twitch.eventsub.v1.UserAuthorizationGrantEvent
+	(*UserAuthorizationRevokeEvent)(nil),                   // 70: twitch.eventsub.v1.UserAuthorizationRevokeEvent
+	(*UserUpdateEvent)(nil),                                // 71: twitch.eventsub.v1.UserUpdateEvent
+	(*UserWhisperMessageEvent)(nil),                        // 72: twitch.eventsub.v1.UserWhisperMessageEvent
+	(*ChannelSuspiciousUserUpdateEvent)(nil),               // 73: twitch.eventsub.v1.ChannelSuspiciousUserUpdateEvent
+	(*ChannelBitsUseEvent)(nil),                            // 74: twitch.eventsub.v1.ChannelBitsUseEvent
+	(*ChannelSuspiciousUserMessageEvent)(nil),              // 75: twitch.eventsub.v1.ChannelSuspiciousUserMessageEvent
+	(*ChannelWarningAcknowledgeEvent)(nil),                 // 76: twitch.eventsub.v1.ChannelWarningAcknowledgeEvent
+	(*ChannelWarningSendEvent)(nil),                        // 77: twitch.eventsub.v1.ChannelWarningSendEvent
+	(*AutomodMessageHoldMessage)(nil),                      // 78: twitch.eventsub.v1.AutomodMessageHoldMessage
+	(*Fragment)(nil),                                       // 79: twitch.eventsub.v1.Fragment
+	(*AutomodSettingsUpdateEventData)(nil),                 // 80: twitch.eventsub.v1.AutomodSettingsUpdateEventData
+	(*Message)(nil),                                        // 81: twitch.eventsub.v1.Message
+	(*Badges)(nil),                                         // 82: twitch.eventsub.v1.Badges
+	(*MessageCheer)(nil),                                   // 83: twitch.eventsub.v1.MessageCheer
+	(*Reply)(nil),                                          // 84: twitch.eventsub.v1.Reply
+	(*NotificationMessage)(nil),                            // 85: twitch.eventsub.v1.NotificationMessage
+	(*NotificationSub)(nil),                                // 86: twitch.eventsub.v1.NotificationSub
+	(*NotificationResub)(nil),                              // 87: twitch.eventsub.v1.NotificationResub
+	(*NotificationSubGift)(nil),                            // 88: twitch.eventsub.v1.NotificationSubGift
+	(*NotificationCommunitySubGift)(nil),                   // 89: twitch.eventsub.v1.NotificationCommunitySubGift
+	(*NotificationGiftPaidUpgrade)(nil),                    // 90: twitch.eventsub.v1.NotificationGiftPaidUpgrade
+	(*NotificationPrimePaidUpgrade)(nil),                   // 91: twitch.eventsub.v1.NotificationPrimePaidUpgrade
+	(*NotificationPayItForward)(nil),                       // 92: twitch.eventsub.v1.NotificationPayItForward
+	(*NotificationRaid)(nil),                               // 93: twitch.eventsub.v1.NotificationRaid
+	(*NotificationAnnouncement)(nil),                       // 94: twitch.eventsub.v1.NotificationAnnouncement
+	(*NotificationBitsBadgeTier)(nil),                      // 95: twitch.eventsub.v1.NotificationBitsBadgeTier
+	(*NotificationCharityDonation)(nil),                    // 96: twitch.eventsub.v1.NotificationCharityDonation
+	(*SubscriptionMessageStruct)(nil),                      // 97: twitch.eventsub.v1.SubscriptionMessageStruct
+	(*FollowDurationMinutes)(nil),                          // 98: twitch.eventsub.v1.FollowDurationMinutes
+	(*Slow)(nil),                                           // 99: twitch.eventsub.v1.Slow
+	(*UserData)(nil),                                       // 100: twitch.eventsub.v1.UserData
+	(*EventBan)(nil),                                       // 101: twitch.eventsub.v1.EventBan
+	(*Timeout)(nil),                                        // 102: twitch.eventsub.v1.Timeout
+	(*EventRaid)(nil),                                      // 103: twitch.eventsub.v1.EventRaid
+	(*Delete)(nil),                                         // 104: twitch.eventsub.v1.Delete
+	(*AutoModTerms)(nil),                                   // 105: twitch.eventsub.v1.AutoModTerms
+	(*UnBanRequest)(nil),                                   // 106: twitch.eventsub.v1.UnBanRequest
+	(*WarnEvent)(nil),                                      // 107: twitch.eventsub.v1.WarnEvent
+	(*ChannelPointsReward)(nil),                            // 108: twitch.eventsub.v1.ChannelPointsReward
+	(*ChannelPointsRewardMessage)(nil),                     // 109: twitch.eventsub.v1.ChannelPointsRewardMessage
+	(*MaxPer)(nil),                                         // 110: twitch.eventsub.v1.MaxPer
+	(*Cooldown)(nil),                                       // 111: twitch.eventsub.v1.Cooldown
+	(*Image)(nil),                                          // 112: twitch.eventsub.v1.Image
+	(*Reward)(nil),                                         // 113: twitch.eventsub.v1.Reward
+	(*Choices)(nil),                                        // 114: twitch.eventsub.v1.Choices
+	(*ChannelPointsVoting)(nil),                            // 115: twitch.eventsub.v1.ChannelPointsVoting
+	(*Outcomes)(nil),                                       // 116: twitch.eventsub.v1.Outcomes
+	(*Amount)(nil),                                         // 117: twitch.eventsub.v1.Amount
+	(*ConduitTransport)(nil),                               // 118: twitch.eventsub.v1.ConduitTransport
+	(*DropData)(nil),                                       // 119: twitch.eventsub.v1.DropData
+	(*Product)(nil),                                        // 120: twitch.eventsub.v1.Product
+	(*Contribution)(nil),                                   // 121: twitch.eventsub.v1.Contribution
+	(*SharedTrainParticipant)(nil),                         // 122: twitch.eventsub.v1.SharedTrainParticipant
+	(*Whisper)(nil),                                        // 123: twitch.eventsub.v1.Whisper
+	(*ChannelBitsUseEventMessage)(nil),                     // 124: twitch.eventsub.v1.ChannelBitsUseEventMessage
+	(*ChannelBitsUseEventPowerUp)(nil),                     // 125: twitch.eventsub.v1.ChannelBitsUseEventPowerUp
+	(*SuspiciousMessage)(nil),                              // 126: twitch.eventsub.v1.SuspiciousMessage
+	(*Emote)(nil),                                          // 127: twitch.eventsub.v1.Emote
+	(*Cheermote)(nil),                                      // 128: twitch.eventsub.v1.Cheermote
+	(*MessageFragment)(nil),                                // 129: twitch.eventsub.v1.MessageFragment
+	(*NotificationFragment)(nil),                           // 130: twitch.eventsub.v1.NotificationFragment
+	(*Emotes)(nil),                                         // 131: twitch.eventsub.v1.Emotes
+	(*RewardUnlockedEmote)(nil),                            // 132: twitch.eventsub.v1.RewardUnlockedEmote
+	(*ChannelPointsRewardMessageFragment)(nil),             // 133: twitch.eventsub.v1.ChannelPointsRewardMessageFragment
+	(*TopPredictors)(nil),                                  // 134: twitch.eventsub.v1.TopPredictors
+	(*ChannelBitsUseEventFragment)(nil),                    // 135: twitch.eventsub.v1.ChannelBitsUseEventFragment
+	(*ChannelBitsUseEventPowerUpEmote)(nil),                // 136: twitch.eventsub.v1.ChannelBitsUseEventPowerUpEmote
+	(*SuspiciousMessageFragment)(nil),                      // 137: twitch.eventsub.v1.SuspiciousMessageFragment
+	(*MessageEmote)(nil),                                   // 138: twitch.eventsub.v1.MessageEmote
+	(*MessageMention)(nil),                                 // 139: twitch.eventsub.v1.MessageMention
+	(*NotificationCheermote)(nil),                          // 140: twitch.eventsub.v1.NotificationCheermote
+	(*NotificationEmote)(nil),                              // 141: twitch.eventsub.v1.NotificationEmote
+	(*NotificationMention)(nil),                            // 142: twitch.eventsub.v1.NotificationMention
+	(*ChannelPointsRewardEmote)(nil),                       // 143: twitch.eventsub.v1.ChannelPointsRewardEmote
+	(*structpb.Value)(nil),                                 // 144: google.protobuf.Value
+}
+var file_eventsub_proto_depIdxs = []int32{
+	3,   // 0: twitch.eventsub.v1.Event.automod_message_hold:type_name -> twitch.eventsub.v1.AutomodMessageHoldEvent
+	4,   // 1: twitch.eventsub.v1.Event.automod_message_update:type_name -> twitch.eventsub.v1.AutomodMessageUpdateEvent
+	5,   // 2: twitch.eventsub.v1.Event.automod_settings_update:type_name -> twitch.eventsub.v1.AutomodSettingsUpdateEvent
+	6,   // 3: twitch.eventsub.v1.Event.automod_terms_update:type_name -> twitch.eventsub.v1.AutomodTermsUpdateEvent
+	7,   // 4: twitch.eventsub.v1.Event.channel_update:type_name -> twitch.eventsub.v1.ChannelUpdateEvent
+	8,   // 5: twitch.eventsub.v1.Event.channel_follow:type_name -> twitch.eventsub.v1.ChannelFollowEvent
+	9,   // 6: twitch.eventsub.v1.Event.channel_ad_break_begin:type_name -> twitch.eventsub.v1.ChannelAdBreakBeginEvent
+	10,  // 7: twitch.eventsub.v1.Event.channel_chat_clear:type_name -> twitch.eventsub.v1.ChannelChatClearEvent
+	11,  // 8: twitch.eventsub.v1.Event.channel_chat_clear_user_messages:type_name -> twitch.eventsub.v1.ChannelChatClearUserMessagesEvent
+	12,  // 9: twitch.eventsub.v1.Event.channel_chat_message:type_name -> twitch.eventsub.v1.ChannelChatMessageEvent
+	13,  // 10: twitch.eventsub.v1.Event.channel_chat_message_delete:type_name -> twitch.eventsub.v1.ChannelChatMessageDeleteEvent
+	14,  // 11: twitch.eventsub.v1.Event.channel_chat_notification:type_name -> twitch.eventsub.v1.ChannelChatNotificationEvent
+	15,  // 12: twitch.eventsub.v1.Event.channel_chat_settings_update:type_name -> twitch.eventsub.v1.ChannelChatSettingsUpdateEvent
+	16,  // 13: twitch.eventsub.v1.Event.channel_chat_user_message_hold:type_name -> twitch.eventsub.v1.ChannelChatUserMessageHoldEvent
+	17,  // 14: twitch.eventsub.v1.Event.channel_chat_user_message_update:type_name -> twitch.eventsub.v1.ChannelChatUserMessageUpdateEvent
+	18,  // 15: twitch.eventsub.v1.Event.channel_subscribe:type_name -> twitch.eventsub.v1.ChannelSubscribeEvent
+	19,  // 16: twitch.eventsub.v1.Event.channel_subscription_end:type_name -> twitch.eventsub.v1.ChannelSubscriptionEndEvent
+	20,  // 17: twitch.eventsub.v1.Event.channel_subscription_gift:type_name -> twitch.eventsub.v1.ChannelSubscriptionGiftEvent
+	21,  // 18: twitch.eventsub.v1.Event.channel_subscription_message:type_name -> twitch.eventsub.v1.ChannelSubscriptionMessageEvent
+	22,  // 19: twitch.eventsub.v1.Event.channel_cheer:type_name -> twitch.eventsub.v1.ChannelCheerEvent
+	23,  // 20: twitch.eventsub.v1.Event.channel_raid:type_name -> twitch.eventsub.v1.ChannelRaidEvent
+	24,  // 21: twitch.eventsub.v1.Event.channel_ban:type_name -> twitch.eventsub.v1.ChannelBanEvent
+	25,  // 22: twitch.eventsub.v1.Event.channel_unban:type_name -> twitch.eventsub.v1.ChannelUnbanEvent
+	26,  // 23: twitch.eventsub.v1.Event.channel_unban_request_create:type_name -> twitch.eventsub.v1.ChannelUnbanRequestCreateEvent
+	27,  // 24: twitch.eventsub.v1.Event.channel_unban_request_resolve:type_name -> twitch.eventsub.v1.ChannelUnbanRequestResolveEvent
+	28,  // 25: twitch.eventsub.v1.Event.channel_moderate:type_name -> twitch.eventsub.v1.ChannelModerateEventV2
+	29,  // 26: twitch.eventsub.v1.Event.channel_moderator_add:type_name -> twitch.eventsub.v1.ChannelModeratorAddEvent
+	30,  // 27: twitch.eventsub.v1.Event.channel_moderator_remove:type_name -> twitch.eventsub.v1.ChannelModeratorRemoveEvent
+	31,  // 28: twitch.eventsub.v1.Event.channel_guest_star_session_begin:type_name -> twitch.eventsub.v1.ChannelGuestStarSessionBeginEvent
+	32,  // 29: twitch.eventsub.v1.Event.channel_guest_star_session_end:type_name -> twitch.eventsub.v1.ChannelGuestStarSessionEndEvent
+	33,  // 30: twitch.eventsub.v1.Event.channel_guest_star_guest_update:type_name -> twitch.eventsub.v1.ChannelGuestStarGuestUpdateEvent
+	34,  // 31: twitch.eventsub.v1.Event.channel_guest_star_settings_update:type_name -> twitch.eventsub.v1.ChannelGuestStarSettingsUpdateEvent
+	35,  // 32: twitch.eventsub.v1.Event.channel_channel_points_automatic_reward_redemption_add:type_name -> twitch.eventsub.v1.ChannelPointsAutomaticRewardRedemptionAddEvent
+	36,  // 33: twitch.eventsub.v1.Event.channel_channel_points_custom_reward_add:type_name -> twitch.eventsub.v1.ChannelPointsCustomRewardAddEvent
+	37,  // 34: twitch.eventsub.v1.Event.channel_channel_points_custom_reward_update:type_name -> twitch.eventsub.v1.ChannelPointsCustomRewardUpdateEvent
+	38,  // 35: twitch.eventsub.v1.Event.channel_channel_points_custom_reward_remove:type_name -> twitch.eventsub.v1.ChannelPointsCustomRewardRemoveEvent
+	39,  // 36: twitch.eventsub.v1.Event.channel_channel_points_custom_reward_redemption_add:type_name -> twitch.eventsub.v1.ChannelPointsCustomRewardRedemptionAddEvent
+	40,  // 37: twitch.eventsub.v1.Event.channel_channel_points_custom_reward_redemption_update:type_name -> twitch.eventsub.v1.ChannelPointsCustomRewardRedemptionUpdateEvent
+	41,  // 38: twitch.eventsub.v1.Event.channel_poll_begin:type_name -> twitch.eventsub.v1.ChannelPollBeginEvent
+	42,  // 39: twitch.eventsub.v1.Event.channel_poll_progress:type_name -> twitch.eventsub.v1.ChannelPollProgressEvent
+	43,  // 40: twitch.eventsub.v1.Event.channel_poll_end:type_name -> twitch.eventsub.v1.ChannelPollEndEvent
+	44,  // 41: twitch.eventsub.v1.Event.channel_prediction_begin:type_name -> twitch.eventsub.v1.ChannelPredictionBeginEvent
+	45,  // 42: twitch.eventsub.v1.Event.channel_prediction_progress:type_name -> twitch.eventsub.v1.ChannelPredictionProgressEvent
+	46,  // 43: twitch.eventsub.v1.Event.channel_prediction_lock:type_name -> twitch.eventsub.v1.ChannelPredictionLockEvent
+	47,  // 44: twitch.eventsub.v1.Event.channel_prediction_end:type_name -> twitch.eventsub.v1.ChannelPredictionEndEvent
+	48,  // 45: twitch.eventsub.v1.Event.channel_vip_add:type_name -> twitch.eventsub.v1.ChannelVIPAddEvent
+	49,  // 46: twitch.eventsub.v1.Event.channel_vip_remove:type_name -> twitch.eventsub.v1.ChannelVIPRemoveEvent
+	50,  // 47: twitch.eventsub.v1.Event.channel_charity_campaign_donate:type_name -> twitch.eventsub.v1.CharityCampaignDonateEvent
+	51,  // 48: twitch.eventsub.v1.Event.channel_charity_campaign_start:type_name -> twitch.eventsub.v1.CharityCampaignStartEvent
+	52,  // 49: twitch.eventsub.v1.Event.channel_charity_campaign_progress:type_name -> twitch.eventsub.v1.CharityCampaignProgressEvent
+	53,  // 50: twitch.eventsub.v1.Event.channel_charity_campaign_stop:type_name -> twitch.eventsub.v1.CharityCampaignStopEvent
+	54,  // 51: twitch.eventsub.v1.Event.conduit_shard_disabled:type_name -> twitch.eventsub.v1.ConduitShardDisabledEvent
+	2,   // 52: twitch.eventsub.v1.Event.drop_entitlement_grant:type_name -> twitch.eventsub.v1.DropEntitlementGrantEvents
+	56,  // 53: twitch.eventsub.v1.Event.extension_bits_transaction_create:type_name -> twitch.eventsub.v1.ExtensionBitsTransactionCreateEvent
+	57,  // 54: twitch.eventsub.v1.Event.channel_goal_begin:type_name -> twitch.eventsub.v1.ChannelGoalBeginEvent
+	58,  // 55: twitch.eventsub.v1.Event.channel_goal_progress:type_name -> twitch.eventsub.v1.ChannelGoalProgressEvent
+	59,  // 56: twitch.eventsub.v1.Event.channel_goal_end:type_name -> twitch.eventsub.v1.ChannelGoalEndEvent
+	60,  // 57: twitch.eventsub.v1.Event.channel_hype_train_begin:type_name -> twitch.eventsub.v1.ChannelHypeTrainBeginEventV2
+	61,  // 58: twitch.eventsub.v1.Event.channel_hype_train_progress:type_name -> twitch.eventsub.v1.ChannelHypeTrainProgressEventV2
+	62,  // 59: twitch.eventsub.v1.Event.channel_hype_train_end:type_name -> twitch.eventsub.v1.ChannelHypeTrainEndEventV2
+	63,  // 60: twitch.eventsub.v1.Event.channel_shield_mode_begin:type_name -> twitch.eventsub.v1.ChannelShieldModeBeginEvent
+	64,  // 61: twitch.eventsub.v1.Event.channel_shield_mode_end:type_name -> twitch.eventsub.v1.ChannelShieldModeEndEvent
+	65,  // 62: twitch.eventsub.v1.Event.channel_shoutout_create:type_name -> twitch.eventsub.v1.ChannelShoutOutCreateEvent
+	66,  // 63: twitch.eventsub.v1.Event.channel_shoutout_receive:type_name -> twitch.eventsub.v1.ChannelShoutOutReceivedEvent
+	67,  // 64: twitch.eventsub.v1.Event.stream_online:type_name -> twitch.eventsub.v1.StreamOnlineEvent
+	68,  // 65: twitch.eventsub.v1.Event.stream_offline:type_name -> twitch.eventsub.v1.StreamOfflineEvent
+	69,  // 66: twitch.eventsub.v1.Event.user_authorization_grant:type_name -> twitch.eventsub.v1.UserAuthorizationGrantEvent
+	70,  // 67: twitch.eventsub.v1.Event.user_authorization_revoke:type_name -> twitch.eventsub.v1.UserAuthorizationRevokeEvent
+	71,  // 68: twitch.eventsub.v1.Event.user_update:type_name -> twitch.eventsub.v1.UserUpdateEvent
+	72,  // 69: twitch.eventsub.v1.Event.user_whisper_message:type_name -> twitch.eventsub.v1.UserWhisperMessageEvent
+	73,  // 70: twitch.eventsub.v1.Event.channel_suspicious_user_update:type_name -> twitch.eventsub.v1.ChannelSuspiciousUserUpdateEvent
+	74,  // 71: twitch.eventsub.v1.Event.channel_bits_use:type_name -> twitch.eventsub.v1.ChannelBitsUseEvent
+	75,  // 72: twitch.eventsub.v1.Event.channel_suspicious_user_message:type_name -> twitch.eventsub.v1.ChannelSuspiciousUserMessageEvent
+	76,  // 73: twitch.eventsub.v1.Event.channel_warning_acknowledge:type_name -> twitch.eventsub.v1.ChannelWarningAcknowledgeEvent
+	77,  // 74: twitch.eventsub.v1.Event.channel_warning_send:type_name -> twitch.eventsub.v1.ChannelWarningSendEvent
+	55,  // 75: twitch.eventsub.v1.DropEntitlementGrantEvents.events:type_name -> twitch.eventsub.v1.DropEntitlementGrantEvent
+	78,  // 76: twitch.eventsub.v1.AutomodMessageHoldEvent.message:type_name -> twitch.eventsub.v1.AutomodMessageHoldMessage
+	79,  // 77: twitch.eventsub.v1.AutomodMessageUpdateEvent.fragments:type_name -> twitch.eventsub.v1.Fragment
+	80,  // 78: twitch.eventsub.v1.AutomodSettingsUpdateEvent.data:type_name -> twitch.eventsub.v1.AutomodSettingsUpdateEventData
+	81,  // 79: twitch.eventsub.v1.ChannelChatMessageEvent.message:type_name -> twitch.eventsub.v1.Message
+	82,  // 80: twitch.eventsub.v1.ChannelChatMessageEvent.badges:type_name -> twitch.eventsub.v1.Badges
+	83,  // 81: twitch.eventsub.v1.ChannelChatMessageEvent.cheer:type_name -> twitch.eventsub.v1.MessageCheer
+	84,  // 82: twitch.eventsub.v1.ChannelChatMessageEvent.reply:type_name -> twitch.eventsub.v1.Reply
+	82,  // 83: twitch.eventsub.v1.ChannelChatMessageEvent.source_badges:type_name -> twitch.eventsub.v1.Badges
+	82,  // 84: twitch.eventsub.v1.ChannelChatNotificationEvent.badges:type_name -> twitch.eventsub.v1.Badges
+	85,  // 85: twitch.eventsub.v1.ChannelChatNotificationEvent.message:type_name -> twitch.eventsub.v1.NotificationMessage
+	86,  // 86: twitch.eventsub.v1.ChannelChatNotificationEvent.sub:type_name -> twitch.eventsub.v1.NotificationSub
+	87,  // 87: twitch.eventsub.v1.ChannelChatNotificationEvent.resub:type_name -> twitch.eventsub.v1.NotificationResub
+	88,  // 88: twitch.eventsub.v1.ChannelChatNotificationEvent.sub_gift:type_name -> twitch.eventsub.v1.NotificationSubGift
+	89,  // 89: twitch.eventsub.v1.ChannelChatNotificationEvent.community_sub_gift:type_name -> twitch.eventsub.v1.NotificationCommunitySubGift
+	90,  // 90: twitch.eventsub.v1.ChannelChatNotificationEvent.gift_paid_upgrade:type_name -> twitch.eventsub.v1.NotificationGiftPaidUpgrade
+	91,  // 91: twitch.eventsub.v1.ChannelChatNotificationEvent.prime_paid_upgrade:type_name -> twitch.eventsub.v1.NotificationPrimePaidUpgrade
+	92,  // 92: twitch.eventsub.v1.ChannelChatNotificationEvent.pay_it_forward:type_name -> twitch.eventsub.v1.NotificationPayItForward
+	93,  // 93: twitch.eventsub.v1.ChannelChatNotificationEvent.raid:type_name -> twitch.eventsub.v1.NotificationRaid
+	144, // 94: twitch.eventsub.v1.ChannelChatNotificationEvent.unraid:type_name -> google.protobuf.Value
+	94,  // 95: twitch.eventsub.v1.ChannelChatNotificationEvent.announcement:type_name -> twitch.eventsub.v1.NotificationAnnouncement
+	95,  // 96: twitch.eventsub.v1.ChannelChatNotificationEvent.bits_badge_tier:type_name -> twitch.eventsub.v1.NotificationBitsBadgeTier
+	96,  // 97: twitch.eventsub.v1.ChannelChatNotificationEvent.charity_donation:type_name -> twitch.eventsub.v1.NotificationCharityDonation
+	81,  // 98: twitch.eventsub.v1.ChannelChatUserMessageHoldEvent.message:type_name -> twitch.eventsub.v1.Message
+	81,  // 99: twitch.eventsub.v1.ChannelChatUserMessageUpdateEvent.message:type_name -> twitch.eventsub.v1.Message
+	97,  // 100: twitch.eventsub.v1.ChannelSubscriptionMessageEvent.message:type_name -> twitch.eventsub.v1.SubscriptionMessageStruct
+	98,  // 101: twitch.eventsub.v1.ChannelModerateEventV2.followers:type_name -> twitch.eventsub.v1.FollowDurationMinutes
+	99,  // 102: twitch.eventsub.v1.ChannelModerateEventV2.slow:type_name -> twitch.eventsub.v1.Slow
+	100, // 103: twitch.eventsub.v1.ChannelModerateEventV2.vip:type_name -> twitch.eventsub.v1.UserData
+	100, // 104: twitch.eventsub.v1.ChannelModerateEventV2.unvip:type_name -> twitch.eventsub.v1.UserData
+	100, // 105: twitch.eventsub.v1.ChannelModerateEventV2.mod:type_name -> twitch.eventsub.v1.UserData
+	100, // 106: twitch.eventsub.v1.ChannelModerateEventV2.unmod:type_name -> twitch.eventsub.v1.UserData
+	101, // 107: twitch.eventsub.v1.ChannelModerateEventV2.ban:type_name -> twitch.eventsub.v1.EventBan
+	100, // 108: twitch.eventsub.v1.ChannelModerateEventV2.unban:type_name -> twitch.eventsub.v1.UserData
+	102, // 109: twitch.eventsub.v1.ChannelModerateEventV2.timeout:type_name -> twitch.eventsub.v1.Timeout
+	100, // 110: twitch.eventsub.v1.ChannelModerateEventV2.untimeout:type_name -> twitch.eventsub.v1.UserData
+	103, // 111: twitch.eventsub.v1.ChannelModerateEventV2.raid:type_name -> twitch.eventsub.v1.EventRaid
+	100, // 112: twitch.eventsub.v1.ChannelModerateEventV2.unraid:type_name -> twitch.eventsub.v1.UserData
+	104, // 113: twitch.eventsub.v1.ChannelModerateEventV2.delete:type_name -> twitch.eventsub.v1.Delete
+	105, // 114: twitch.eventsub.v1.ChannelModerateEventV2.automod_terms:type_name -> twitch.eventsub.v1.AutoModTerms
+	106, // 115: twitch.eventsub.v1.ChannelModerateEventV2.unban_request:type_name -> twitch.eventsub.v1.UnBanRequest
+	107, // 116: twitch.eventsub.v1.ChannelModerateEventV2.warn:type_name -> twitch.eventsub.v1.WarnEvent
+	101, // 117: twitch.eventsub.v1.ChannelModerateEventV2.shared_chat_ban:type_name -> twitch.eventsub.v1.EventBan
+	100, // 118: twitch.eventsub.v1.ChannelModerateEventV2.shared_chat_unban:type_name -> twitch.eventsub.v1.UserData
+	102, // 119: twitch.eventsub.v1.ChannelModerateEventV2.shared_chat_timeout:type_name -> twitch.eventsub.v1.Timeout
+	100, // 120: twitch.eventsub.v1.ChannelModerateEventV2.shared_chat_untimeout:type_name -> twitch.eventsub.v1.UserData
+	104, // 121: twitch.eventsub.v1.ChannelModerateEventV2.shared_chat_delete:type_name -> twitch.eventsub.v1.Delete
+	108, // 122: twitch.eventsub.v1.ChannelPointsAutomaticRewardRedemptionAddEvent.reward:type_name -> twitch.eventsub.v1.ChannelPointsReward
+	109, // 123: twitch.eventsub.v1.ChannelPointsAutomaticRewardRedemptionAddEvent.message:type_name -> twitch.eventsub.v1.ChannelPointsRewardMessage
+	110, // 124: twitch.eventsub.v1.ChannelPointsCustomRewardAddEvent.max_per_stream:type_name -> twitch.eventsub.v1.MaxPer
+	110, // 125: twitch.eventsub.v1.ChannelPointsCustomRewardAddEvent.max_per_user_per_stream:type_name -> twitch.eventsub.v1.MaxPer
+	111, // 126: twitch.eventsub.v1.ChannelPointsCustomRewardAddEvent.global_cooldown:type_name -> twitch.eventsub.v1.Cooldown
+	112, // 127: twitch.eventsub.v1.ChannelPointsCustomRewardAddEvent.image:type_name -> twitch.eventsub.v1.Image
+	112, // 128: twitch.eventsub.v1.ChannelPointsCustomRewardAddEvent.default_image:type_name -> twitch.eventsub.v1.Image
+	110, // 129: twitch.eventsub.v1.ChannelPointsCustomRewardUpdateEvent.max_per_stream:type_name -> twitch.eventsub.v1.MaxPer
+	110, // 130: twitch.eventsub.v1.ChannelPointsCustomRewardUpdateEvent.max_per_user_per_stream:type_name -> twitch.eventsub.v1.MaxPer
+	111, // 131: twitch.eventsub.v1.ChannelPointsCustomRewardUpdateEvent.global_cooldown:type_name -> twitch.eventsub.v1.Cooldown
+	112, // 132: twitch.eventsub.v1.ChannelPointsCustomRewardUpdateEvent.image:type_name -> twitch.eventsub.v1.Image
+	112, // 133: twitch.eventsub.v1.ChannelPointsCustomRewardUpdateEvent.default_image:type_name -> twitch.eventsub.v1.Image
+	110, // 134: twitch.eventsub.v1.ChannelPointsCustomRewardRemoveEvent.max_per_stream:type_name -> twitch.eventsub.v1.MaxPer
+	110, // 135: twitch.eventsub.v1.ChannelPointsCustomRewardRemoveEvent.max_per_user_per_stream:type_name -> twitch.eventsub.v1.MaxPer
+	111, // 136: twitch.eventsub.v1.ChannelPointsCustomRewardRemoveEvent.global_cooldown:type_name -> twitch.eventsub.v1.Cooldown
+	112, // 137: twitch.eventsub.v1.ChannelPointsCustomRewardRemoveEvent.image:type_name -> twitch.eventsub.v1.Image
+	112, // 138: twitch.eventsub.v1.ChannelPointsCustomRewardRemoveEvent.default_image:type_name -> twitch.eventsub.v1.Image
+	113, // 139: twitch.eventsub.v1.ChannelPointsCustomRewardRedemptionAddEvent.reward:type_name -> twitch.eventsub.v1.Reward
+	113, // 140: twitch.eventsub.v1.ChannelPointsCustomRewardRedemptionUpdateEvent.reward:type_name -> twitch.eventsub.v1.Reward
+	114, // 141: twitch.eventsub.v1.ChannelPollBeginEvent.choices:type_name -> twitch.eventsub.v1.Choices
+	115, // 142: twitch.eventsub.v1.ChannelPollBeginEvent.channel_points_voting:type_name -> twitch.eventsub.v1.ChannelPointsVoting
+	114, // 143: twitch.eventsub.v1.ChannelPollProgressEvent.choices:type_name -> twitch.eventsub.v1.Choices
+	115, // 144: twitch.eventsub.v1.ChannelPollProgressEvent.channel_points_voting:type_name -> twitch.eventsub.v1.ChannelPointsVoting
+	114, // 145: twitch.eventsub.v1.ChannelPollEndEvent.choices:type_name -> twitch.eventsub.v1.Choices
+	115, // 146: twitch.eventsub.v1.ChannelPollEndEvent.channel_points_voting:type_name -> twitch.eventsub.v1.ChannelPointsVoting
+	116, // 147: twitch.eventsub.v1.ChannelPredictionBeginEvent.outcomes:type_name -> twitch.eventsub.v1.Outcomes
+	116, // 148: twitch.eventsub.v1.ChannelPredictionProgressEvent.outcomes:type_name -> twitch.eventsub.v1.Outcomes
+	116, // 149: twitch.eventsub.v1.ChannelPredictionLockEvent.outcomes:type_name -> twitch.eventsub.v1.Outcomes
+	116, // 150: twitch.eventsub.v1.ChannelPredictionEndEvent.outcomes:type_name -> twitch.eventsub.v1.Outcomes
+	117, // 151: twitch.eventsub.v1.CharityCampaignDonateEvent.amount:type_name -> twitch.eventsub.v1.Amount
+	117, // 152: twitch.eventsub.v1.CharityCampaignStartEvent.current_amount:type_name -> twitch.eventsub.v1.Amount
+	117, // 153: twitch.eventsub.v1.CharityCampaignStartEvent.target_amount:type_name -> twitch.eventsub.v1.Amount
+	117, // 154: twitch.eventsub.v1.CharityCampaignProgressEvent.current_amount:type_name -> twitch.eventsub.v1.Amount
+	117, // 155: twitch.eventsub.v1.CharityCampaignProgressEvent.target_amount:type_name -> twitch.eventsub.v1.Amount
+	117, // 156: twitch.eventsub.v1.CharityCampaignStopEvent.current_amount:type_name -> twitch.eventsub.v1.Amount
+	117, // 157: twitch.eventsub.v1.CharityCampaignStopEvent.target_amount:type_name -> twitch.eventsub.v1.Amount
+	118, // 158: twitch.eventsub.v1.ConduitShardDisabledEvent.transport:type_name -> twitch.eventsub.v1.ConduitTransport
+	119, // 159: twitch.eventsub.v1.DropEntitlementGrantEvent.data:type_name -> twitch.eventsub.v1.DropData
+	120, // 160: twitch.eventsub.v1.ExtensionBitsTransactionCreateEvent.product:type_name -> twitch.eventsub.v1.Product
+	121, // 161: twitch.eventsub.v1.ChannelHypeTrainBeginEventV2.top_contributions:type_name -> twitch.eventsub.v1.Contribution
+	122, // 162: twitch.eventsub.v1.ChannelHypeTrainBeginEventV2.shared_train_participants:type_name -> twitch.eventsub.v1.SharedTrainParticipant
+	121, // 163: twitch.eventsub.v1.ChannelHypeTrainProgressEventV2.top_contributions:type_name -> twitch.eventsub.v1.Contribution
+	122, // 164: twitch.eventsub.v1.ChannelHypeTrainProgressEventV2.shared_train_participants:type_name -> twitch.eventsub.v1.SharedTrainParticipant
+	121, // 165: twitch.eventsub.v1.ChannelHypeTrainEndEventV2.top_contributions:type_name -> twitch.eventsub.v1.Contribution
+	122, // 166: twitch.eventsub.v1.ChannelHypeTrainEndEventV2.shared_train_participants:type_name -> twitch.eventsub.v1.SharedTrainParticipant
+	123, // 167: twitch.eventsub.v1.UserWhisperMessageEvent.whisper:type_name -> twitch.eventsub.v1.Whisper
+	124, // 168: twitch.eventsub.v1.ChannelBitsUseEvent.message:type_name -> twitch.eventsub.v1.ChannelBitsUseEventMessage
+	125, // 169: twitch.eventsub.v1.ChannelBitsUseEvent.power_up:type_name -> twitch.eventsub.v1.ChannelBitsUseEventPowerUp
+	126, // 170: twitch.eventsub.v1.ChannelSuspiciousUserMessageEvent.message:type_name -> twitch.eventsub.v1.SuspiciousMessage
+	79,  // 171: twitch.eventsub.v1.AutomodMessageHoldMessage.fragments:type_name -> twitch.eventsub.v1.Fragment
+	127, // 172: twitch.eventsub.v1.Fragment.emotes:type_name -> twitch.eventsub.v1.Emote
+	128, // 173: twitch.eventsub.v1.Fragment.cheermotes:type_name -> twitch.eventsub.v1.Cheermote
+	129, // 174: twitch.eventsub.v1.Message.fragments:type_name -> twitch.eventsub.v1.MessageFragment
+	130, // 175: twitch.eventsub.v1.NotificationMessage.fragments:type_name -> twitch.eventsub.v1.NotificationFragment
+	117, // 176: twitch.eventsub.v1.NotificationCharityDonation.amount:type_name -> twitch.eventsub.v1.Amount
+	131, // 177: twitch.eventsub.v1.SubscriptionMessageStruct.emotes:type_name -> twitch.eventsub.v1.Emotes
+	132, // 178: twitch.eventsub.v1.ChannelPointsReward.emote:type_name -> twitch.eventsub.v1.RewardUnlockedEmote
+	133, // 179: twitch.eventsub.v1.ChannelPointsRewardMessage.fragments:type_name -> twitch.eventsub.v1.ChannelPointsRewardMessageFragment
+	134, // 180: twitch.eventsub.v1.Outcomes.top_predictors:type_name -> twitch.eventsub.v1.TopPredictors
+	135, // 181: twitch.eventsub.v1.ChannelBitsUseEventMessage.fragments:type_name -> twitch.eventsub.v1.ChannelBitsUseEventFragment
+	136, // 182: twitch.eventsub.v1.ChannelBitsUseEventPowerUp.emote:type_name -> twitch.eventsub.v1.ChannelBitsUseEventPowerUpEmote
+	137, // 183: twitch.eventsub.v1.SuspiciousMessage.fragments:type_name -> twitch.eventsub.v1.SuspiciousMessageFragment
+	128, // 184: twitch.eventsub.v1.MessageFragment.cheermote:type_name -> twitch.eventsub.v1.Cheermote
+	138, // 185: twitch.eventsub.v1.MessageFragment.emote:type_name -> twitch.eventsub.v1.MessageEmote
+	139, // 186: twitch.eventsub.v1.MessageFragment.mention:type_name -> twitch.eventsub.v1.MessageMention
+	140, // 187: twitch.eventsub.v1.NotificationFragment.cheermote:type_name -> twitch.eventsub.v1.NotificationCheermote
+	141, // 188: twitch.eventsub.v1.NotificationFragment.emote:type_name -> twitch.eventsub.v1.NotificationEmote
+	142, // 189: twitch.eventsub.v1.NotificationFragment.mention:type_name -> twitch.eventsub.v1.NotificationMention
+	143, // 190: twitch.eventsub.v1.ChannelPointsRewardMessageFragment.emote:type_name -> twitch.eventsub.v1.ChannelPointsRewardEmote
+	127, // 191: twitch.eventsub.v1.ChannelBitsUseEventFragment.emotes:type_name -> twitch.eventsub.v1.Emote
+	128, // 192: twitch.eventsub.v1.ChannelBitsUseEventFragment.cheermotes:type_name -> twitch.eventsub.v1.Cheermote
+	128, // 193: twitch.eventsub.v1.SuspiciousMessageFragment.cheermote:type_name -> twitch.eventsub.v1.Cheermote
+	138, // 194: twitch.eventsub.v1.SuspiciousMessageFragment.emote:type_name -> twitch.eventsub.v1.MessageEmote
+	0,   // 195: twitch.eventsub.v1.EventSub.Subscribe:input_type -> twitch.eventsub.v1.SubscribeRequest
+	1,   // 196: twitch.eventsub.v1.EventSub.Subscribe:output_type -> twitch.eventsub.v1.Event
+	196, // [196:197] is the sub-list for method output_type
+	195, // [195:196] is the sub-list for method input_type
+	195, // [195:195] is the sub-list for extension type_name
+	195, // [195:195] is the sub-list for extension extendee
+	0,   // [0:195] is the sub-list for field type_name
+}
+
+func init() { file_eventsub_proto_init() }
+func file_eventsub_proto_init() {
+	if File_eventsub_proto != nil {
+		return
+	}
+	file_eventsub_proto_msgTypes[1].OneofWrappers = []any{
+		(*Event_AutomodMessageHold)(nil),
+		(*Event_AutomodMessageUpdate)(nil),
+		(*Event_AutomodSettingsUpdate)(nil),
+		(*Event_AutomodTermsUpdate)(nil),
+		(*Event_ChannelUpdate)(nil),
+		(*Event_ChannelFollow)(nil),
+		(*Event_ChannelAdBreakBegin)(nil),
+		(*Event_ChannelChatClear)(nil),
+		(*Event_ChannelChatClearUserMessages)(nil),
+		(*Event_ChannelChatMessage)(nil),
+		(*Event_ChannelChatMessageDelete)(nil),
+		(*Event_ChannelChatNotification)(nil),
+		(*Event_ChannelChatSettingsUpdate)(nil),
+		(*Event_ChannelChatUserMessageHold)(nil),
+		(*Event_ChannelChatUserMessageUpdate)(nil),
+		(*Event_ChannelSubscribe)(nil),
+		(*Event_ChannelSubscriptionEnd)(nil),
+		(*Event_ChannelSubscriptionGift)(nil),
+		(*Event_ChannelSubscriptionMessage)(nil),
+		(*Event_ChannelCheer)(nil),
+		(*Event_ChannelRaid)(nil),
+		(*Event_ChannelBan)(nil),
+		(*Event_ChannelUnban)(nil),
+		(*Event_ChannelUnbanRequestCreate)(nil),
+		(*Event_ChannelUnbanRequestResolve)(nil),
+		(*Event_ChannelModerate)(nil),
+		(*Event_ChannelModeratorAdd)(nil),
+		(*Event_ChannelModeratorRemove)(nil),
+		(*Event_ChannelGuestStarSessionBegin)(nil),
+		(*Event_ChannelGuestStarSessionEnd)(nil),
+		(*Event_ChannelGuestStarGuestUpdate)(nil),
+		(*Event_ChannelGuestStarSettingsUpdate)(nil),
+		(*Event_ChannelChannelPointsAutomaticRewardRedemptionAdd)(nil),
+		(*Event_ChannelChannelPointsCustomRewardAdd)(nil),
+		(*Event_ChannelChannelPointsCustomRewardUpdate)(nil),
+		(*Event_ChannelChannelPointsCustomRewardRemove)(nil),
+		(*Event_ChannelChannelPointsCustomRewardRedemptionAdd)(nil),
+		(*Event_ChannelChannelPointsCustomRewardRedemptionUpdate)(nil),
+		(*Event_ChannelPollBegin)(nil),
+		(*Event_ChannelPollProgress)(nil),
+		(*Event_ChannelPollEnd)(nil),
+		(*Event_ChannelPredictionBegin)(nil),
+		(*Event_ChannelPredictionProgress)(nil),
+		(*Event_ChannelPredictionLock)(nil),
+		(*Event_ChannelPredictionEnd)(nil),
+		(*Event_ChannelVipAdd)(nil),
+		(*Event_ChannelVipRemove)(nil),
+		(*Event_ChannelCharityCampaignDonate)(nil),
+		(*Event_ChannelCharityCampaignStart)(nil),
+		(*Event_ChannelCharityCampaignProgress)(nil),
+		(*Event_ChannelCharityCampaignStop)(nil),
+		(*Event_ConduitShardDisabled)(nil),
+		(*Event_DropEntitlementGrant)(nil),
+		(*Event_ExtensionBitsTransactionCreate)(nil),
+		(*Event_ChannelGoalBegin)(nil),
+		(*Event_ChannelGoalProgress)(nil),
+		(*Event_ChannelGoalEnd)(nil),
+		(*Event_ChannelHypeTrainBegin)(nil),
+		(*Event_ChannelHypeTrainProgress)(nil),
+		(*Event_ChannelHypeTrainEnd)(nil),
+		(*Event_ChannelShieldModeBegin)(nil),
+		(*Event_ChannelShieldModeEnd)(nil),
+		(*Event_ChannelShoutoutCreate)(nil),
+		(*Event_ChannelShoutoutReceive)(nil),
+		(*Event_StreamOnline)(nil),
+		(*Event_StreamOffline)(nil),
+		(*Event_UserAuthorizationGrant)(nil),
+		(*Event_UserAuthorizationRevoke)(nil),
+		(*Event_UserUpdate)(nil),
+		(*Event_UserWhisperMessage)(nil),
+		(*Event_ChannelSuspiciousUserUpdate)(nil),
+		(*Event_ChannelBitsUse)(nil),
+		(*Event_ChannelSuspiciousUserMessage)(nil),
+		(*Event_ChannelWarningAcknowledge)(nil),
+		(*Event_ChannelWarningSend)(nil),
+	}
+	file_eventsub_proto_msgTypes[12].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[15].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[20].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[21].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[22].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[24].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[33].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[36].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[37].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[38].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[70].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[77].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[80].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[87].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[88].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[89].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[90].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[92].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[101].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[102].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[107].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[118].OneofWrappers = []any{}
+	file_eventsub_proto_msgTypes[134].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_eventsub_proto_rawDesc), len(file_eventsub_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   144,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_eventsub_proto_goTypes,
+		DependencyIndexes: file_eventsub_proto_depIdxs,
+		MessageInfos:      file_eventsub_proto_msgTypes,
+	}.Build()
+	File_eventsub_proto = out.File
+	file_eventsub_proto_goTypes = nil
+	file_eventsub_proto_depIdxs = nil
+}
@@ -0,0 +1,331 @@
+// Command gen writes eventsub.proto from the event structs of the twitcheventsub package and compiles it into
+// eventsub.pb.go, without protoc: the proto is parsed with protocompile and handed to protoc-gen-go run as a
+// plugin. It is its own module so eventsubgrpc does not depend on protocompile, and must run from its directory,
+// which go generate in eventsubgrpc does with go run -C.
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	protoFile = "eventsub.proto"
+	// grpcDir is the eventsubgrpc directory, where the proto and the generated code are written.
+	grpcDir   = "../.."
+	goPackage = "github.com/Aiuzu42/go-twitch-eventsub/eventsubgrpc;eventsubgrpc"
+	// payloadStart is the first field number of the Event payload oneof, the fields before it are the envelope.
+	payloadStart = 100
+)
+
+type event struct {
+	subType  string
+	typeName string
+	batched  bool
+}
+
+type generator struct {
+	types    map[string]*ast.StructType
+	messages map[string]*ast.StructType
+	order    []string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	models, err := parser.ParseFile(fset, filepath.Join(grpcDir, "..", "models.go"), nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	events, err := parser.ParseFile(fset, filepath.Join(grpcDir, "..", "events.go"), nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &generator{types: map[string]*ast.StructType{}, messages: map[string]*ast.StructType{}}
+	for _, decl := range models.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			if ts, ok := spec.(*ast.TypeSpec); ok {
+				if st, ok := ts.Type.(*ast.StructType); ok {
+					g.types[ts.Name.Name] = st
+				}
+			}
+		}
+	}
+	src := g.proto(findEvents(events))
+	if err := os.WriteFile(filepath.Join(grpcDir, protoFile), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	if err := compile(src); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// findEvents reads the subscription types and their event types from the eventTypes map, whose entries are
+// either on calls, typed by the On function getter they take, or eventType literals with a decode[T] field.
+func findEvents(f *ast.File) []event {
+	var events []event
+	ast.Inspect(f, func(n ast.Node) bool {
+		vs, ok := n.(*ast.ValueSpec)
+		if !ok || vs.Names[0].Name != "eventTypes" {
+			return true
+		}
+		for _, elt := range vs.Values[0].(*ast.CompositeLit).Elts {
+			kv := elt.(*ast.KeyValueExpr)
+			subType, _ := strconv.Unquote(kv.Key.(*ast.BasicLit).Value)
+			e := event{subType: subType}
+			var t ast.Expr
+			switch v := kv.Value.(type) {
+			case *ast.CallExpr:
+				getter := v.Args[0].(*ast.FuncLit).Type.Results.List[0].Type.(*ast.FuncType)
+				t = getter.Params.List[0].Type
+			case *ast.CompositeLit:
+				for _, field := range v.Elts {
+					if field := field.(*ast.KeyValueExpr); field.Key.(*ast.Ident).Name == "decode" {
+						t = field.Value.(*ast.IndexExpr).Index
+					}
+				}
+			}
+			switch t := t.(type) {
+			case *ast.Ident:
+				e.typeName = t.Name
+			case *ast.ArrayType:
+				e.typeName = t.Elt.(*ast.Ident).Name
+				e.batched = true
+			default:
+				log.Fatalf("unable to find the event type of %s", subType)
+			}
+			events = append(events, e)
+		}
+		return false
+	})
+	return events
+}
+
+// oneofName turns a subscription type into the name of its Event payload field, channel.cheer is channel_cheer.
+func oneofName(subType string) string {
+	return strings.NewReplacer(".", "_", "-", "_").Replace(subType)
+}
+
+// fieldName makes a JSON key a valid proto field name, set-id becomes set_id.
+func fieldName(key string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, key)
+}
+
+func (g *generator) proto(events []event) []byte {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by internal/gen. DO NOT EDIT.\n\n")
+	b.WriteString("syntax = \"proto3\";\n\npackage twitch.eventsub.v1;\n\n")
+	b.WriteString("import \"google/protobuf/struct.proto\";\n\n")
+	fmt.Fprintf(&b, "option go_package = %q;\n\n", goPackage)
+	b.WriteString(`// EventSub streams the notifications received by a twitcheventsub client.
+service EventSub {
+  // Subscribe streams the notifications matching the request until the client cancels.
+  rpc Subscribe(SubscribeRequest) returns (stream Event);
+}
+
+message SubscribeRequest {
+  // types only streams these subscription types, all of them when empty.
+  repeated string types = 1;
+  // broadcaster_ids only streams the notifications of these broadcasters, all of them when empty.
+  repeated string broadcaster_ids = 2;
+  // resume_after replays the buffered notifications received after this message id before streaming new ones.
+  string resume_after = 3;
+}
+
+// Event is a notification, payload holds the decoded event and json the event as Twitch sent it.
+message Event {
+  uint64 sequence = 1;
+  string message_id = 2;
+  string subscription_id = 3;
+  string type = 4;
+  string version = 5;
+  string broadcaster_id = 6;
+  string message_timestamp = 7;
+  string received_at = 8;
+  string json = 9;
+
+  oneof payload {
+`)
+	for i, e := range events {
+		name := e.typeName
+		if e.batched {
+			name += "s"
+		}
+		fmt.Fprintf(&b, "    %s %s = %d;\n", name, oneofName(e.subType), payloadStart+i)
+	}
+	b.WriteString("  }\n}\n")
+	for _, e := range events {
+		g.need(e.typeName)
+		if e.batched {
+			fmt.Fprintf(&b, "\nmessage %ss {\n  repeated %s events = 1;\n}\n", e.typeName, e.typeName)
+		}
+	}
+	for i := 0; i < len(g.order); i++ {
+		name := g.order[i]
+		b.WriteString("\nmessage " + name + " {\n")
+		n := 0
+		for _, f := range g.messages[name].Fields.List {
+			if f.Tag == nil || len(f.Names) == 0 {
+				continue
+			}
+			tag, _ := strconv.Unquote(f.Tag.Value)
+			key := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+			if key == "" || key == "-" {
+				continue
+			}
+			n++
+			fieldName, option := fieldName(key), ""
+			if fieldName != key {
+				option = fmt.Sprintf(" [json_name = %q]", key)
+			}
+			fmt.Fprintf(&b, "  %s %s = %d%s;\n", g.fieldType(name+f.Names[0].Name, f.Type), fieldName, n, option)
+		}
+		b.WriteString("}\n")
+	}
+	return b.Bytes()
+}
+
+// need adds a message for the struct name, and transitively the structs it uses.
+func (g *generator) need(name string) {
+	if _, ok := g.messages[name]; ok {
+		return
+	}
+	st, ok := g.types[name]
+	if !ok {
+		log.Fatalf("struct %s not found", name)
+	}
+	g.messages[name] = st
+	g.order = append(g.order, name)
+}
+
+// fieldType maps a Go field type to a proto one, anonymous structs become messages named after their field.
+func (g *generator) fieldType(anonName string, expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		inner := g.fieldType(anonName, t.X)
+		if strings.HasPrefix(inner, "repeated ") || !isScalar(inner) {
+			return inner
+		}
+		return "optional " + inner
+	case *ast.ArrayType:
+		return "repeated " + strings.TrimPrefix(g.fieldType(anonName, t.Elt), "optional ")
+	case *ast.MapType:
+		return "map<string, " + g.fieldType(anonName, t.Value) + ">"
+	case *ast.StructType:
+		if _, ok := g.messages[anonName]; !ok {
+			g.messages[anonName] = t
+			g.order = append(g.order, anonName)
+		}
+		return anonName
+	case *ast.SelectorExpr:
+		switch t.Sel.Name {
+		case "Time":
+			return "string"
+		case "RawMessage":
+			return "google.protobuf.Value"
+		}
+	case *ast.InterfaceType:
+		return "google.protobuf.Value"
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return "string"
+		case "bool":
+			return "bool"
+		case "int", "int64":
+			return "int64"
+		case "int32":
+			return "int32"
+		case "float32", "float64":
+			return "double"
+		case "any":
+			return "google.protobuf.Value"
+		}
+		g.need(t.Name)
+		return t.Name
+	}
+	log.Fatalf("unsupported field type %T", expr)
+	return ""
+}
+
+func isScalar(t string) bool {
+	return slices.Contains([]string{"string", "bool", "int64", "int32", "double"}, t)
+}
+
+// compile does what protoc with protoc-gen-go would, writing eventsub.pb.go next to the proto file.
+func compile(src []byte) error {
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{protoFile: string(src)}),
+		}),
+	}
+	files, err := compiler.Compile(context.Background(), protoFile)
+	if err != nil {
+		return err
+	}
+	req, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{protoFile},
+		Parameter:      ptr("paths=source_relative"),
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(structpb.File_google_protobuf_struct_proto),
+			protodesc.ToFileDescriptorProto(files[0].(protoreflect.FileDescriptor)),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	// protoc-gen-go comes from the protobuf version in go.mod, keep it in step with eventsubgrpc/go.mod
+	var out bytes.Buffer
+	cmd := exec.Command("go", "run", "google.golang.org/protobuf/cmd/protoc-gen-go")
+	cmd.Stdin = bytes.NewReader(req)
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("protoc-gen-go: %w", err)
+	}
+	res := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(out.Bytes(), res); err != nil {
+		return fmt.Errorf("error decoding the protoc-gen-go response: %w", err)
+	}
+	if res.Error != nil {
+		return fmt.Errorf("%s", res.GetError())
+	}
+	for _, f := range res.File {
+		if err := os.WriteFile(filepath.Join(grpcDir, f.GetName()), []byte(f.GetContent()), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
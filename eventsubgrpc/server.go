@@ -0,0 +1,180 @@
+// Package eventsubgrpc streams the notifications received by a twitcheventsub client over gRPC, so services in
+// other languages get decoded events without parsing Twitch JSON. The messages are described in eventsub.proto,
+// generated from the event structs of twitcheventsub.
+package eventsubgrpc
+
+//go:generate go run -C internal/gen .
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultBufferSize = 1024
+	subscriberQueue   = 256
+	subscribeMethod   = "/twitch.eventsub.v1.EventSub/Subscribe"
+)
+
+// EventSubServer is the server API of the EventSub service.
+type EventSubServer interface {
+	Subscribe(req *SubscribeRequest, stream grpc.ServerStreamingServer[Event]) error
+}
+
+// ServiceDesc describes the EventSub service for grpc.Server.RegisterService.
+var ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "twitch.eventsub.v1.EventSub",
+	HandlerType: (*EventSubServer)(nil),
+	Streams: []grpc.StreamDesc{{
+		StreamName:    "Subscribe",
+		Handler:       subscribeHandler,
+		ServerStreams: true,
+	}},
+	Metadata: "eventsub.proto",
+}
+
+// RegisterEventSubServer registers srv on s.
+func RegisterEventSubServer(s grpc.ServiceRegistrar, srv EventSubServer) {
+	s.RegisterService(&ServiceDesc, srv)
+}
+
+func subscribeHandler(srv any, stream grpc.ServerStream) error {
+	req := new(SubscribeRequest)
+	if err := stream.RecvMsg(req); err != nil {
+		return err
+	}
+	return srv.(EventSubServer).Subscribe(req, &grpc.GenericServerStream[SubscribeRequest, Event]{ServerStream: stream})
+}
+
+// EventSubClient is the client API of the EventSub service, for Go consumers.
+type EventSubClient interface {
+	Subscribe(ctx context.Context, req *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+}
+
+type eventSubClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventSubClient(cc grpc.ClientConnInterface) EventSubClient {
+	return &eventSubClient{cc: cc}
+}
+
+func (c *eventSubClient) Subscribe(ctx context.Context, req *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	stream, err := c.cc.NewStream(ctx, &ServiceDesc.Streams[0], subscribeMethod, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(req); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// Server implements EventSubServer on top of a client. The last notifications are kept so a subscriber that
+// reconnects with resume_after gets what it missed, subscribers too slow to keep up get ResourceExhausted, see
+// twitcheventsub.Broadcaster.
+type Server struct {
+	broadcaster *twitcheventsub.Broadcaster[*Event]
+}
+
+// NewServer creates a Server fed by c that keeps the last bufferSize notifications, register it with
+// RegisterEventSubServer.
+func NewServer(c *twitcheventsub.Client, bufferSize int) *Server {
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+	s := &Server{broadcaster: twitcheventsub.NewBroadcaster[*Event](bufferSize)}
+	c.AddListener(s.broadcast)
+	return s
+}
+
+// newEvent converts a notification, payload is left empty when the event does not match its message.
+func newEvent(env twitcheventsub.Envelope) *Event {
+	payload := env.Payload()
+	field := []byte(`{"` + oneofName(env.Subscription.Type) + `":`)
+	if bytes.HasPrefix(bytes.TrimSpace(payload), []byte("[")) {
+		field = append(append(append(field, `{"events":`...), payload...), '}')
+	} else {
+		field = append(field, payload...)
+	}
+	ev := &Event{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(append(field, '}'), ev); err != nil {
+		ev = &Event{}
+	}
+	ev.MessageId = env.MessageID
+	ev.SubscriptionId = env.Subscription.Id
+	ev.Type = env.Subscription.Type
+	ev.Version = env.Subscription.Version
+	ev.BroadcasterId = twitcheventsub.BroadcasterKey(env.Subscription)
+	if !env.MessageTimestamp.IsZero() {
+		ev.MessageTimestamp = env.MessageTimestamp.UTC().Format(time.RFC3339Nano)
+	}
+	ev.ReceivedAt = env.ReceivedAt.UTC().Format(time.RFC3339Nano)
+	ev.Json = string(payload)
+	return ev
+}
+
+func oneofName(subType string) string {
+	b := []byte(subType)
+	for i, c := range b {
+		if c == '.' || c == '-' {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+func (s *Server) broadcast(ctx context.Context, env twitcheventsub.Envelope) error {
+	ev := newEvent(env)
+	s.broadcaster.Publish(func(seq uint64) *Event {
+		ev.Sequence = seq
+		return ev
+	})
+	return nil
+}
+
+func (s *Server) Subscribe(req *SubscribeRequest, stream grpc.ServerStreamingServer[Event]) error {
+	match := func(ev *Event) bool {
+		return (len(req.Types) == 0 || slices.Contains(req.Types, ev.Type)) &&
+			(len(req.BroadcasterIds) == 0 || slices.Contains(req.BroadcasterIds, ev.BroadcasterId))
+	}
+	var after func(e twitcheventsub.BroadcastEntry[*Event]) bool
+	if req.ResumeAfter != "" {
+		after = func(e twitcheventsub.BroadcastEntry[*Event]) bool { return e.Value.MessageId == req.ResumeAfter }
+	}
+	r, backlog, ok := s.broadcaster.Subscribe(subscriberQueue, match, after)
+	defer r.Close()
+	if after != nil && !ok {
+		return status.Errorf(codes.OutOfRange, "message %s is no longer buffered", req.ResumeAfter)
+	}
+	for _, e := range backlog {
+		if err := stream.Send(e.Value); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-r.C():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber too slow")
+			}
+			if err := stream.Send(e.Value); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package eventsubgrpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stream hands the events Subscribe sends to the test, Send blocks until they are read.
+type stream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *Event
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream) Send(ev *Event) error {
+	s.events <- ev
+	return nil
+}
+
+// subscribe runs Subscribe until the returned cancel function is called.
+func subscribe(s *Server, req *SubscribeRequest) (*stream, <-chan error, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	st := &stream{ctx: ctx, events: make(chan *Event)}
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Subscribe(req, st)
+	}()
+	return st, done, cancel
+}
+
+// publish feeds the server a notification for the fixture sent by broadcaster and returns its message id.
+func publish(t *testing.T, s *Server, f eventsubtest.Fixture, broadcaster string) string {
+	t.Helper()
+	f, err := f.WithBroadcaster(broadcaster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := twitcheventsub.Envelope{MessageID: eventsubtest.NewMessageID(), ReceivedAt: time.Now(),
+		Subscription: f.Subscription, Body: body}
+	if err := s.broadcast(context.Background(), env); err != nil {
+		t.Fatal(err)
+	}
+	return env.MessageID
+}
+
+func receive(t *testing.T, st *stream) *Event {
+	t.Helper()
+	select {
+	case ev := <-st.events:
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func newServer(bufferSize int) *Server {
+	return NewServer(twitcheventsub.NewClient("test-secret-value", "http://localhost/eventsub"), bufferSize)
+}
+
+func TestSubscribeFilters(t *testing.T) {
+	s := newServer(0)
+	follow := eventsubtest.NewChannelFollowEvent().Fixture()
+	cheer := eventsubtest.NewChannelCheerEvent().Fixture()
+	first := publish(t, s, follow, "1")
+	publish(t, s, cheer, "1")
+	publish(t, s, follow, "2")
+	want := publish(t, s, follow, "1")
+
+	st, done, cancel := subscribe(s, &SubscribeRequest{Types: []string{"channel.follow"}, BroadcasterIds: []string{"1"},
+		ResumeAfter: first})
+	if ev := receive(t, st); ev.MessageId != want {
+		t.Fatalf("backlog sent %s %s, want the follow %s", ev.Type, ev.MessageId, want)
+	}
+	// the receiver is registered once the backlog has been sent
+	publish(t, s, cheer, "1")
+	publish(t, s, follow, "2")
+	want = publish(t, s, follow, "1")
+	ev := receive(t, st)
+	if ev.MessageId != want || ev.Type != "channel.follow" || ev.BroadcasterId != "1" {
+		t.Errorf("sent %s of %s, want the follow of 1", ev.Type, ev.BroadcasterId)
+	}
+	if ev.GetChannelFollow() == nil || ev.Sequence != 7 {
+		t.Errorf("payload %v at sequence %d, want the follow at 7", ev.GetPayload(), ev.Sequence)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Subscribe = %v after the client left", err)
+	}
+}
+
+func TestSubscribeResumeMiss(t *testing.T) {
+	s := newServer(2)
+	first := publish(t, s, eventsubtest.NewChannelFollowEvent().Fixture(), "1")
+	for range 2 {
+		publish(t, s, eventsubtest.NewChannelFollowEvent().Fixture(), "1")
+	}
+	_, done, cancel := subscribe(s, &SubscribeRequest{ResumeAfter: first})
+	defer cancel()
+	if err := <-done; status.Code(err) != codes.OutOfRange {
+		t.Errorf("Subscribe = %v, want OutOfRange for a message out of the buffer", err)
+	}
+}
+
+func TestSubscribeSlowSubscriber(t *testing.T) {
+	s := newServer(0)
+	f := eventsubtest.NewChannelFollowEvent().Fixture()
+	first := publish(t, s, f, "1")
+	publish(t, s, f, "1")
+	st, done, cancel := subscribe(s, &SubscribeRequest{ResumeAfter: first})
+	defer cancel()
+	receive(t, st)
+	// one event blocked in Send, a full queue and one more
+	for range subscriberQueue + 2 {
+		publish(t, s, f, "1")
+	}
+	for {
+		select {
+		case <-st.events:
+		case err := <-done:
+			if status.Code(err) != codes.ResourceExhausted {
+				t.Errorf("Subscribe = %v, want ResourceExhausted", err)
+			}
+			return
+		case <-time.After(time.Second):
+			t.Fatal("slow subscriber not dropped")
+		}
+	}
+}
+
+func TestNewEventBatched(t *testing.T) {
+	f, err := eventsubtest.LoadFixture("drop.entitlement.grant", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := twitcheventsub.Envelope{MessageID: "id", Subscription: f.Subscription, Body: body}
+	ev := newEvent(env)
+	grants := ev.GetDropEntitlementGrant().GetEvents()
+	if len(grants) == 0 || grants[0].GetData().GetUserId() != "4242" {
+		t.Fatalf("decoded %v, want the grants of the fixture", ev.GetPayload())
+	}
+	if ev.Json != string(env.Payload()) || ev.Type != "drop.entitlement.grant" {
+		t.Errorf("json %q of %s, want the events as sent", ev.Json, ev.Type)
+	}
+}
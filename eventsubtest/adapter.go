@@ -0,0 +1,112 @@
+package eventsubtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+)
+
+const (
+	adapterCallback = "http://localhost/eventsub"
+	adapterSecret   = "adapter-test-secret"
+)
+
+// AdapterCase is a webhook message a framework adapter must answer like Client.HandleEvent.
+type AdapterCase struct {
+	Name string
+	// Sync runs the client in sync mode with a listener returning an error.
+	Sync bool
+	// Request builds the message, signed with secret.
+	Request func(secret string) (*http.Request, error)
+	Status  int
+	// Body is the expected response body, only checked when not empty.
+	Body string
+}
+
+// AdapterCases returns the messages every adapter is tested with: a challenge, a notification, a bad signature,
+// an unknown message type and a notification whose handlers fail in sync mode.
+func AdapterCases() []AdapterCase {
+	follow := NewChannelFollowEvent().Fixture()
+	notification := func(secret string) (*http.Request, error) {
+		body, err := follow.Body()
+		if err != nil {
+			return nil, err
+		}
+		return NewRequest(adapterCallback, secret, MessageTypeNotification, follow.Subscription, body)
+	}
+	return []AdapterCase{
+		{Name: "challenge", Status: http.StatusOK, Body: "challenge-value", Request: func(secret string) (*http.Request, error) {
+			body, err := ChallengeBody(follow.Subscription, "challenge-value")
+			if err != nil {
+				return nil, err
+			}
+			return NewRequest(adapterCallback, secret, MessageTypeChallenge, follow.Subscription, body)
+		}},
+		{Name: "notification", Status: http.StatusNoContent, Request: notification},
+		{Name: "bad signature", Status: http.StatusForbidden, Request: func(secret string) (*http.Request, error) {
+			return notification(secret + "-wrong")
+		}},
+		{Name: "unknown type", Status: http.StatusBadRequest, Request: func(secret string) (*http.Request, error) {
+			body, err := follow.Body()
+			if err != nil {
+				return nil, err
+			}
+			return NewRequest(adapterCallback, secret, "unknown_message_type", follow.Subscription, body)
+		}},
+		{Name: "sync handler error", Sync: true, Status: http.StatusInternalServerError, Request: notification},
+	}
+}
+
+// TestAdapter runs AdapterCases against the handlers newHandler returns for a client, and checks the notification
+// reaches the client handlers.
+func TestAdapter(t *testing.T, newHandler func(c *twitcheventsub.Client) http.Handler) {
+	for _, tc := range AdapterCases() {
+		t.Run(tc.Name, func(t *testing.T) {
+			c := twitcheventsub.NewClient(adapterSecret, adapterCallback)
+			c.SetSyncMode(tc.Sync)
+			if tc.Sync {
+				c.AddListener(func(ctx context.Context, env twitcheventsub.Envelope) error {
+					return errors.New("listener failed")
+				})
+			}
+			received := make(chan string, 1)
+			c.OnChannelFollow(func(event twitcheventsub.ChannelFollowEvent) {
+				received <- event.UserID
+			})
+			req, err := tc.Request(adapterSecret)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w := httptest.NewRecorder()
+			newHandler(c).ServeHTTP(w, req)
+			if w.Code != tc.Status {
+				t.Fatalf("status = %d, want %d", w.Code, tc.Status)
+			}
+			if tc.Body != "" {
+				if got := w.Body.String(); got != tc.Body {
+					t.Errorf("body = %q, want %q", got, tc.Body)
+				}
+				if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+					t.Errorf("Content-Type = %q, want text/plain", ct)
+				}
+			}
+			if tc.Status != http.StatusNoContent {
+				return
+			}
+			select {
+			case <-received:
+			case <-time.After(time.Second):
+				t.Error("handler was not called")
+			}
+			if err := c.Shutdown(context.Background()); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
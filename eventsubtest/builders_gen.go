@@ -0,0 +1,6270 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package eventsubtest
+
+import (
+	"encoding/json"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+)
+
+// AutomodMessageHoldEventBuilder builds automod.message.hold events starting from the v1 fixture.
+type AutomodMessageHoldEventBuilder struct {
+	event twitcheventsub.AutomodMessageHoldEvent
+}
+
+// NewAutomodMessageHoldEvent returns a builder holding the automod.message.hold fixture event.
+func NewAutomodMessageHoldEvent() *AutomodMessageHoldEventBuilder {
+	b := &AutomodMessageHoldEventBuilder{}
+	mustDecode("automod.message.hold", "1", &b.event)
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithBroadcasterUserID(v string) *AutomodMessageHoldEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithBroadcasterUserName(v string) *AutomodMessageHoldEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithBroadcasterUserLogin(v string) *AutomodMessageHoldEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithUserID(v string) *AutomodMessageHoldEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithUserName(v string) *AutomodMessageHoldEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithUserLogin(v string) *AutomodMessageHoldEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithMessageID(v string) *AutomodMessageHoldEventBuilder {
+	b.event.MessageID = v
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithMessage(v twitcheventsub.AutomodMessageHoldMessage) *AutomodMessageHoldEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithLevel(v int) *AutomodMessageHoldEventBuilder {
+	b.event.Level = v
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithCategory(v string) *AutomodMessageHoldEventBuilder {
+	b.event.Category = v
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) WithHeldAt(v time.Time) *AutomodMessageHoldEventBuilder {
+	b.event.HeldAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *AutomodMessageHoldEventBuilder) With(f func(e *twitcheventsub.AutomodMessageHoldEvent)) *AutomodMessageHoldEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *AutomodMessageHoldEventBuilder) Build() twitcheventsub.AutomodMessageHoldEvent {
+	return b.event
+}
+
+func (b *AutomodMessageHoldEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the automod.message.hold fixture carrying the built event.
+func (b *AutomodMessageHoldEventBuilder) Fixture() Fixture {
+	f := mustLoad("automod.message.hold", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// AutomodMessageUpdateEventBuilder builds automod.message.update events starting from the v1 fixture.
+type AutomodMessageUpdateEventBuilder struct {
+	event twitcheventsub.AutomodMessageUpdateEvent
+}
+
+// NewAutomodMessageUpdateEvent returns a builder holding the automod.message.update fixture event.
+func NewAutomodMessageUpdateEvent() *AutomodMessageUpdateEventBuilder {
+	b := &AutomodMessageUpdateEventBuilder{}
+	mustDecode("automod.message.update", "1", &b.event)
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithBroadcasterUserID(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithBroadcasterUserName(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithBroadcasterUserLogin(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithUserID(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithUserName(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithUserLogin(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithModeratorUserID(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithModeratorUserLogin(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithModeratorUserName(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithMessageID(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.MessageID = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithMessage(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithLevel(v int) *AutomodMessageUpdateEventBuilder {
+	b.event.Level = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithCategory(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.Category = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithStatus(v string) *AutomodMessageUpdateEventBuilder {
+	b.event.Status = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithHeldAt(v time.Time) *AutomodMessageUpdateEventBuilder {
+	b.event.HeldAt = v
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) WithFragments(v twitcheventsub.Fragment) *AutomodMessageUpdateEventBuilder {
+	b.event.Fragments = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *AutomodMessageUpdateEventBuilder) With(f func(e *twitcheventsub.AutomodMessageUpdateEvent)) *AutomodMessageUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *AutomodMessageUpdateEventBuilder) Build() twitcheventsub.AutomodMessageUpdateEvent {
+	return b.event
+}
+
+func (b *AutomodMessageUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the automod.message.update fixture carrying the built event.
+func (b *AutomodMessageUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("automod.message.update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// AutomodSettingsUpdateEventBuilder builds automod.settings.update events starting from the v1 fixture.
+type AutomodSettingsUpdateEventBuilder struct {
+	event twitcheventsub.AutomodSettingsUpdateEvent
+}
+
+// NewAutomodSettingsUpdateEvent returns a builder holding the automod.settings.update fixture event.
+func NewAutomodSettingsUpdateEvent() *AutomodSettingsUpdateEventBuilder {
+	b := &AutomodSettingsUpdateEventBuilder{}
+	mustDecode("automod.settings.update", "1", &b.event)
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *AutomodSettingsUpdateEventBuilder) With(f func(e *twitcheventsub.AutomodSettingsUpdateEvent)) *AutomodSettingsUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *AutomodSettingsUpdateEventBuilder) Build() twitcheventsub.AutomodSettingsUpdateEvent {
+	return b.event
+}
+
+func (b *AutomodSettingsUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the automod.settings.update fixture carrying the built event.
+func (b *AutomodSettingsUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("automod.settings.update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// AutomodTermsUpdateEventBuilder builds automod.terms.update events starting from the v1 fixture.
+type AutomodTermsUpdateEventBuilder struct {
+	event twitcheventsub.AutomodTermsUpdateEvent
+}
+
+// NewAutomodTermsUpdateEvent returns a builder holding the automod.terms.update fixture event.
+func NewAutomodTermsUpdateEvent() *AutomodTermsUpdateEventBuilder {
+	b := &AutomodTermsUpdateEventBuilder{}
+	mustDecode("automod.terms.update", "1", &b.event)
+	return b
+}
+
+func (b *AutomodTermsUpdateEventBuilder) WithBroadcasterUserID(v string) *AutomodTermsUpdateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *AutomodTermsUpdateEventBuilder) WithBroadcasterUserName(v string) *AutomodTermsUpdateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *AutomodTermsUpdateEventBuilder) WithBroadcasterUserLogin(v string) *AutomodTermsUpdateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *AutomodTermsUpdateEventBuilder) WithModeratorUserID(v string) *AutomodTermsUpdateEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *AutomodTermsUpdateEventBuilder) WithModeratorUserLogin(v string) *AutomodTermsUpdateEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *AutomodTermsUpdateEventBuilder) WithModeratorUserName(v string) *AutomodTermsUpdateEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *AutomodTermsUpdateEventBuilder) WithAction(v string) *AutomodTermsUpdateEventBuilder {
+	b.event.Action = v
+	return b
+}
+
+func (b *AutomodTermsUpdateEventBuilder) WithFromAutomod(v bool) *AutomodTermsUpdateEventBuilder {
+	b.event.FromAutomod = v
+	return b
+}
+
+func (b *AutomodTermsUpdateEventBuilder) WithTerms(v []string) *AutomodTermsUpdateEventBuilder {
+	b.event.Terms = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *AutomodTermsUpdateEventBuilder) With(f func(e *twitcheventsub.AutomodTermsUpdateEvent)) *AutomodTermsUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *AutomodTermsUpdateEventBuilder) Build() twitcheventsub.AutomodTermsUpdateEvent {
+	return b.event
+}
+
+func (b *AutomodTermsUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the automod.terms.update fixture carrying the built event.
+func (b *AutomodTermsUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("automod.terms.update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelUpdateEventBuilder builds channel.update events starting from the v2 fixture.
+type ChannelUpdateEventBuilder struct {
+	event twitcheventsub.ChannelUpdateEvent
+}
+
+// NewChannelUpdateEvent returns a builder holding the channel.update fixture event.
+func NewChannelUpdateEvent() *ChannelUpdateEventBuilder {
+	b := &ChannelUpdateEventBuilder{}
+	mustDecode("channel.update", "2", &b.event)
+	return b
+}
+
+func (b *ChannelUpdateEventBuilder) WithBroadcasterUserID(v string) *ChannelUpdateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelUpdateEventBuilder) WithBroadcasterUserLogin(v string) *ChannelUpdateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelUpdateEventBuilder) WithBroadcasterUserName(v string) *ChannelUpdateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelUpdateEventBuilder) WithTitle(v string) *ChannelUpdateEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelUpdateEventBuilder) WithLanguage(v string) *ChannelUpdateEventBuilder {
+	b.event.Language = v
+	return b
+}
+
+func (b *ChannelUpdateEventBuilder) WithCategoryID(v string) *ChannelUpdateEventBuilder {
+	b.event.CategoryID = v
+	return b
+}
+
+func (b *ChannelUpdateEventBuilder) WithCategoryName(v string) *ChannelUpdateEventBuilder {
+	b.event.CategoryName = v
+	return b
+}
+
+func (b *ChannelUpdateEventBuilder) WithContentClassificationLabels(v []string) *ChannelUpdateEventBuilder {
+	b.event.ContentClassificationLabels = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelUpdateEventBuilder) With(f func(e *twitcheventsub.ChannelUpdateEvent)) *ChannelUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelUpdateEventBuilder) Build() twitcheventsub.ChannelUpdateEvent {
+	return b.event
+}
+
+func (b *ChannelUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.update fixture carrying the built event.
+func (b *ChannelUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.update", "2")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelFollowEventBuilder builds channel.follow events starting from the v2 fixture.
+type ChannelFollowEventBuilder struct {
+	event twitcheventsub.ChannelFollowEvent
+}
+
+// NewChannelFollowEvent returns a builder holding the channel.follow fixture event.
+func NewChannelFollowEvent() *ChannelFollowEventBuilder {
+	b := &ChannelFollowEventBuilder{}
+	mustDecode("channel.follow", "2", &b.event)
+	return b
+}
+
+func (b *ChannelFollowEventBuilder) WithUserID(v string) *ChannelFollowEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelFollowEventBuilder) WithUserLogin(v string) *ChannelFollowEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelFollowEventBuilder) WithUserName(v string) *ChannelFollowEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelFollowEventBuilder) WithBroadcasterUserID(v string) *ChannelFollowEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelFollowEventBuilder) WithBroadcasterUserLogin(v string) *ChannelFollowEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelFollowEventBuilder) WithBroadcasterUserName(v string) *ChannelFollowEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelFollowEventBuilder) WithFollowedAt(v time.Time) *ChannelFollowEventBuilder {
+	b.event.FollowedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelFollowEventBuilder) With(f func(e *twitcheventsub.ChannelFollowEvent)) *ChannelFollowEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelFollowEventBuilder) Build() twitcheventsub.ChannelFollowEvent {
+	return b.event
+}
+
+func (b *ChannelFollowEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.follow fixture carrying the built event.
+func (b *ChannelFollowEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.follow", "2")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelAdBreakBeginEventBuilder builds channel.ad_break.begin events starting from the v1 fixture.
+type ChannelAdBreakBeginEventBuilder struct {
+	event twitcheventsub.ChannelAdBreakBeginEvent
+}
+
+// NewChannelAdBreakBeginEvent returns a builder holding the channel.ad_break.begin fixture event.
+func NewChannelAdBreakBeginEvent() *ChannelAdBreakBeginEventBuilder {
+	b := &ChannelAdBreakBeginEventBuilder{}
+	mustDecode("channel.ad_break.begin", "1", &b.event)
+	return b
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) WithDurationSeconds(v string) *ChannelAdBreakBeginEventBuilder {
+	b.event.DurationSeconds = v
+	return b
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) WithStartedAt(v time.Time) *ChannelAdBreakBeginEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) WithIsAutomatic(v string) *ChannelAdBreakBeginEventBuilder {
+	b.event.IsAutomatic = v
+	return b
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) WithBroadcasterUserID(v string) *ChannelAdBreakBeginEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) WithBroadcasterUserLogin(v string) *ChannelAdBreakBeginEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) WithBroadcasterUserName(v string) *ChannelAdBreakBeginEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) WithRequesterUserID(v string) *ChannelAdBreakBeginEventBuilder {
+	b.event.RequesterUserID = v
+	return b
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) WithRequesterUserLogin(v string) *ChannelAdBreakBeginEventBuilder {
+	b.event.RequesterUserLogin = v
+	return b
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) WithRequesterUserName(v string) *ChannelAdBreakBeginEventBuilder {
+	b.event.RequesterUserName = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelAdBreakBeginEventBuilder) With(f func(e *twitcheventsub.ChannelAdBreakBeginEvent)) *ChannelAdBreakBeginEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) Build() twitcheventsub.ChannelAdBreakBeginEvent {
+	return b.event
+}
+
+func (b *ChannelAdBreakBeginEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.ad_break.begin fixture carrying the built event.
+func (b *ChannelAdBreakBeginEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.ad_break.begin", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelChatClearEventBuilder builds channel.chat.clear events starting from the v1 fixture.
+type ChannelChatClearEventBuilder struct {
+	event twitcheventsub.ChannelChatClearEvent
+}
+
+// NewChannelChatClearEvent returns a builder holding the channel.chat.clear fixture event.
+func NewChannelChatClearEvent() *ChannelChatClearEventBuilder {
+	b := &ChannelChatClearEventBuilder{}
+	mustDecode("channel.chat.clear", "1", &b.event)
+	return b
+}
+
+func (b *ChannelChatClearEventBuilder) WithBroadcasterUserID(v string) *ChannelChatClearEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelChatClearEventBuilder) WithBroadcasterUserName(v string) *ChannelChatClearEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelChatClearEventBuilder) WithBroadcasterUserLogin(v string) *ChannelChatClearEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelChatClearEventBuilder) With(f func(e *twitcheventsub.ChannelChatClearEvent)) *ChannelChatClearEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelChatClearEventBuilder) Build() twitcheventsub.ChannelChatClearEvent {
+	return b.event
+}
+
+func (b *ChannelChatClearEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.chat.clear fixture carrying the built event.
+func (b *ChannelChatClearEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.chat.clear", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelChatClearUserMessagesEventBuilder builds channel.chat.clear_user_messages events starting from the v1 fixture.
+type ChannelChatClearUserMessagesEventBuilder struct {
+	event twitcheventsub.ChannelChatClearUserMessagesEvent
+}
+
+// NewChannelChatClearUserMessagesEvent returns a builder holding the channel.chat.clear_user_messages fixture event.
+func NewChannelChatClearUserMessagesEvent() *ChannelChatClearUserMessagesEventBuilder {
+	b := &ChannelChatClearUserMessagesEventBuilder{}
+	mustDecode("channel.chat.clear_user_messages", "1", &b.event)
+	return b
+}
+
+func (b *ChannelChatClearUserMessagesEventBuilder) WithBroadcasterUserID(v string) *ChannelChatClearUserMessagesEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelChatClearUserMessagesEventBuilder) WithBroadcasterUserName(v string) *ChannelChatClearUserMessagesEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelChatClearUserMessagesEventBuilder) WithBroadcasterUserLogin(v string) *ChannelChatClearUserMessagesEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelChatClearUserMessagesEventBuilder) WithTargetUserID(v string) *ChannelChatClearUserMessagesEventBuilder {
+	b.event.TargetUserID = v
+	return b
+}
+
+func (b *ChannelChatClearUserMessagesEventBuilder) WithTargetUserName(v string) *ChannelChatClearUserMessagesEventBuilder {
+	b.event.TargetUserName = v
+	return b
+}
+
+func (b *ChannelChatClearUserMessagesEventBuilder) WithTargetUserLogin(v string) *ChannelChatClearUserMessagesEventBuilder {
+	b.event.TargetUserLogin = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelChatClearUserMessagesEventBuilder) With(f func(e *twitcheventsub.ChannelChatClearUserMessagesEvent)) *ChannelChatClearUserMessagesEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelChatClearUserMessagesEventBuilder) Build() twitcheventsub.ChannelChatClearUserMessagesEvent {
+	return b.event
+}
+
+func (b *ChannelChatClearUserMessagesEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.chat.clear_user_messages fixture carrying the built event.
+func (b *ChannelChatClearUserMessagesEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.chat.clear_user_messages", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelChatMessageEventBuilder builds channel.chat.message events starting from the v1 fixture.
+type ChannelChatMessageEventBuilder struct {
+	event twitcheventsub.ChannelChatMessageEvent
+}
+
+// NewChannelChatMessageEvent returns a builder holding the channel.chat.message fixture event.
+func NewChannelChatMessageEvent() *ChannelChatMessageEventBuilder {
+	b := &ChannelChatMessageEventBuilder{}
+	mustDecode("channel.chat.message", "1", &b.event)
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithBroadcasterUserID(v string) *ChannelChatMessageEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithBroadcasterUserLogin(v string) *ChannelChatMessageEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithBroadcasterUserName(v string) *ChannelChatMessageEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithChatterUserID(v string) *ChannelChatMessageEventBuilder {
+	b.event.ChatterUserID = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithChatterUserLogin(v string) *ChannelChatMessageEventBuilder {
+	b.event.ChatterUserLogin = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithChatterUserName(v string) *ChannelChatMessageEventBuilder {
+	b.event.ChatterUserName = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithMessageID(v string) *ChannelChatMessageEventBuilder {
+	b.event.MessageID = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithMessage(v twitcheventsub.Message) *ChannelChatMessageEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithColor(v string) *ChannelChatMessageEventBuilder {
+	b.event.Color = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithBadges(v []twitcheventsub.Badges) *ChannelChatMessageEventBuilder {
+	b.event.Badges = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithMessageType(v string) *ChannelChatMessageEventBuilder {
+	b.event.MessageType = v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithCheer(v twitcheventsub.MessageCheer) *ChannelChatMessageEventBuilder {
+	b.event.Cheer = &v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithReply(v twitcheventsub.Reply) *ChannelChatMessageEventBuilder {
+	b.event.Reply = &v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithChannelPointsCustomRewardID(v string) *ChannelChatMessageEventBuilder {
+	b.event.ChannelPointsCustomRewardID = &v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithSourceBrboadcasterUserID(v string) *ChannelChatMessageEventBuilder {
+	b.event.SourceBrboadcasterUserID = &v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithSourceBroadcasterUserName(v string) *ChannelChatMessageEventBuilder {
+	b.event.SourceBroadcasterUserName = &v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithSourceBroadcasterUserLogin(v string) *ChannelChatMessageEventBuilder {
+	b.event.SourceBroadcasterUserLogin = &v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithSourceMessageID(v string) *ChannelChatMessageEventBuilder {
+	b.event.SourceMessageID = &v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithSourceBadges(v []twitcheventsub.Badges) *ChannelChatMessageEventBuilder {
+	b.event.SourceBadges = &v
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) WithIsSourceOnly(v bool) *ChannelChatMessageEventBuilder {
+	b.event.IsSourceOnly = &v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelChatMessageEventBuilder) With(f func(e *twitcheventsub.ChannelChatMessageEvent)) *ChannelChatMessageEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelChatMessageEventBuilder) Build() twitcheventsub.ChannelChatMessageEvent {
+	return b.event
+}
+
+func (b *ChannelChatMessageEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.chat.message fixture carrying the built event.
+func (b *ChannelChatMessageEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.chat.message", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelChatMessageDeleteEventBuilder builds channel.chat.message_delete events starting from the v1 fixture.
+type ChannelChatMessageDeleteEventBuilder struct {
+	event twitcheventsub.ChannelChatMessageDeleteEvent
+}
+
+// NewChannelChatMessageDeleteEvent returns a builder holding the channel.chat.message_delete fixture event.
+func NewChannelChatMessageDeleteEvent() *ChannelChatMessageDeleteEventBuilder {
+	b := &ChannelChatMessageDeleteEventBuilder{}
+	mustDecode("channel.chat.message_delete", "1", &b.event)
+	return b
+}
+
+func (b *ChannelChatMessageDeleteEventBuilder) WithBroadcasterUserID(v string) *ChannelChatMessageDeleteEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelChatMessageDeleteEventBuilder) WithBroadcasterUserName(v string) *ChannelChatMessageDeleteEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelChatMessageDeleteEventBuilder) WithBroadcasterUserLogin(v string) *ChannelChatMessageDeleteEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelChatMessageDeleteEventBuilder) WithTargetUserID(v string) *ChannelChatMessageDeleteEventBuilder {
+	b.event.TargetUserID = v
+	return b
+}
+
+func (b *ChannelChatMessageDeleteEventBuilder) WithTargetUserName(v string) *ChannelChatMessageDeleteEventBuilder {
+	b.event.TargetUserName = v
+	return b
+}
+
+func (b *ChannelChatMessageDeleteEventBuilder) WithTargetUserLogin(v string) *ChannelChatMessageDeleteEventBuilder {
+	b.event.TargetUserLogin = v
+	return b
+}
+
+func (b *ChannelChatMessageDeleteEventBuilder) WithMessageID(v string) *ChannelChatMessageDeleteEventBuilder {
+	b.event.MessageID = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelChatMessageDeleteEventBuilder) With(f func(e *twitcheventsub.ChannelChatMessageDeleteEvent)) *ChannelChatMessageDeleteEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelChatMessageDeleteEventBuilder) Build() twitcheventsub.ChannelChatMessageDeleteEvent {
+	return b.event
+}
+
+func (b *ChannelChatMessageDeleteEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.chat.message_delete fixture carrying the built event.
+func (b *ChannelChatMessageDeleteEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.chat.message_delete", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelChatNotificationEventBuilder builds channel.chat.notification events starting from the v1 fixture.
+type ChannelChatNotificationEventBuilder struct {
+	event twitcheventsub.ChannelChatNotificationEvent
+}
+
+// NewChannelChatNotificationEvent returns a builder holding the channel.chat.notification fixture event.
+func NewChannelChatNotificationEvent() *ChannelChatNotificationEventBuilder {
+	b := &ChannelChatNotificationEventBuilder{}
+	mustDecode("channel.chat.notification", "1", &b.event)
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithBroadcasterUserID(v string) *ChannelChatNotificationEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithBroadcasterUserName(v string) *ChannelChatNotificationEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithBroadcasterUserLogin(v string) *ChannelChatNotificationEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithChatterUserID(v string) *ChannelChatNotificationEventBuilder {
+	b.event.ChatterUserID = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithChatterUserLogin(v string) *ChannelChatNotificationEventBuilder {
+	b.event.ChatterUserLogin = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithChatterUserName(v string) *ChannelChatNotificationEventBuilder {
+	b.event.ChatterUserName = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithChatterIsAnonymous(v bool) *ChannelChatNotificationEventBuilder {
+	b.event.ChatterIsAnonymous = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithColor(v string) *ChannelChatNotificationEventBuilder {
+	b.event.Color = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithBadges(v []twitcheventsub.Badges) *ChannelChatNotificationEventBuilder {
+	b.event.Badges = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithSystemMessage(v string) *ChannelChatNotificationEventBuilder {
+	b.event.SystemMessage = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithMessageID(v string) *ChannelChatNotificationEventBuilder {
+	b.event.MessageID = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithMessage(v twitcheventsub.NotificationMessage) *ChannelChatNotificationEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithNoticeType(v string) *ChannelChatNotificationEventBuilder {
+	b.event.NoticeType = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithSub(v twitcheventsub.NotificationSub) *ChannelChatNotificationEventBuilder {
+	b.event.Sub = &v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithResub(v twitcheventsub.NotificationResub) *ChannelChatNotificationEventBuilder {
+	b.event.Resub = &v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithSubGift(v twitcheventsub.NotificationSubGift) *ChannelChatNotificationEventBuilder {
+	b.event.SubGift = &v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithCommunitySubGift(v twitcheventsub.NotificationCommunitySubGift) *ChannelChatNotificationEventBuilder {
+	b.event.CommunitySubGift = &v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithGiftPaidUpgrade(v twitcheventsub.NotificationGiftPaidUpgrade) *ChannelChatNotificationEventBuilder {
+	b.event.GiftPaidUpgrade = &v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithPrimePaidUpgrade(v twitcheventsub.NotificationPrimePaidUpgrade) *ChannelChatNotificationEventBuilder {
+	b.event.PrimePaidUpgrade = &v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithPayItForward(v twitcheventsub.NotificationPayItForward) *ChannelChatNotificationEventBuilder {
+	b.event.PayItForward = &v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithRaid(v twitcheventsub.NotificationRaid) *ChannelChatNotificationEventBuilder {
+	b.event.Raid = &v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithUnraid(v any) *ChannelChatNotificationEventBuilder {
+	b.event.Unraid = v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithAnnouncement(v twitcheventsub.NotificationAnnouncement) *ChannelChatNotificationEventBuilder {
+	b.event.Announcement = &v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithBitsBadgeTier(v twitcheventsub.NotificationBitsBadgeTier) *ChannelChatNotificationEventBuilder {
+	b.event.BitsBadgeTier = &v
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) WithCharityDonation(v twitcheventsub.NotificationCharityDonation) *ChannelChatNotificationEventBuilder {
+	b.event.CharityDonation = &v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelChatNotificationEventBuilder) With(f func(e *twitcheventsub.ChannelChatNotificationEvent)) *ChannelChatNotificationEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelChatNotificationEventBuilder) Build() twitcheventsub.ChannelChatNotificationEvent {
+	return b.event
+}
+
+func (b *ChannelChatNotificationEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.chat.notification fixture carrying the built event.
+func (b *ChannelChatNotificationEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.chat.notification", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelChatSettingsUpdateEventBuilder builds channel.chat_settings.update events starting from the v1 fixture.
+type ChannelChatSettingsUpdateEventBuilder struct {
+	event twitcheventsub.ChannelChatSettingsUpdateEvent
+}
+
+// NewChannelChatSettingsUpdateEvent returns a builder holding the channel.chat_settings.update fixture event.
+func NewChannelChatSettingsUpdateEvent() *ChannelChatSettingsUpdateEventBuilder {
+	b := &ChannelChatSettingsUpdateEventBuilder{}
+	mustDecode("channel.chat_settings.update", "1", &b.event)
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) WithBroadcasterUserID(v string) *ChannelChatSettingsUpdateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) WithBroadcasterUserLogin(v string) *ChannelChatSettingsUpdateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) WithBroadcasterUserName(v string) *ChannelChatSettingsUpdateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) WithEmoteMode(v bool) *ChannelChatSettingsUpdateEventBuilder {
+	b.event.EmoteMode = v
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) WithFollowerMode(v bool) *ChannelChatSettingsUpdateEventBuilder {
+	b.event.FollowerMode = v
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) WithFollowerModeDurationMinutes(v int) *ChannelChatSettingsUpdateEventBuilder {
+	b.event.FollowerModeDurationMinutes = &v
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) WithSlowMode(v bool) *ChannelChatSettingsUpdateEventBuilder {
+	b.event.SlowMode = v
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) WithSlowModeWaitTimeSeconds(v int) *ChannelChatSettingsUpdateEventBuilder {
+	b.event.SlowModeWaitTimeSeconds = v
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) WithSubscriberMode(v bool) *ChannelChatSettingsUpdateEventBuilder {
+	b.event.SubscriberMode = v
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) WithUniqueChatMode(v bool) *ChannelChatSettingsUpdateEventBuilder {
+	b.event.UniqueChatMode = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelChatSettingsUpdateEventBuilder) With(f func(e *twitcheventsub.ChannelChatSettingsUpdateEvent)) *ChannelChatSettingsUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) Build() twitcheventsub.ChannelChatSettingsUpdateEvent {
+	return b.event
+}
+
+func (b *ChannelChatSettingsUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.chat_settings.update fixture carrying the built event.
+func (b *ChannelChatSettingsUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.chat_settings.update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelChatUserMessageHoldEventBuilder builds channel.chat.user_message_hold events starting from the v1 fixture.
+type ChannelChatUserMessageHoldEventBuilder struct {
+	event twitcheventsub.ChannelChatUserMessageHoldEvent
+}
+
+// NewChannelChatUserMessageHoldEvent returns a builder holding the channel.chat.user_message_hold fixture event.
+func NewChannelChatUserMessageHoldEvent() *ChannelChatUserMessageHoldEventBuilder {
+	b := &ChannelChatUserMessageHoldEventBuilder{}
+	mustDecode("channel.chat.user_message_hold", "1", &b.event)
+	return b
+}
+
+func (b *ChannelChatUserMessageHoldEventBuilder) WithBroadcasterUserID(v string) *ChannelChatUserMessageHoldEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelChatUserMessageHoldEventBuilder) WithBroadcasterUserLogin(v string) *ChannelChatUserMessageHoldEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelChatUserMessageHoldEventBuilder) WithBroadcasterUserName(v string) *ChannelChatUserMessageHoldEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelChatUserMessageHoldEventBuilder) WithUserID(v string) *ChannelChatUserMessageHoldEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelChatUserMessageHoldEventBuilder) WithUserLogin(v string) *ChannelChatUserMessageHoldEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelChatUserMessageHoldEventBuilder) WithUserName(v string) *ChannelChatUserMessageHoldEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelChatUserMessageHoldEventBuilder) WithMessageID(v string) *ChannelChatUserMessageHoldEventBuilder {
+	b.event.MessageID = v
+	return b
+}
+
+func (b *ChannelChatUserMessageHoldEventBuilder) WithMessage(v twitcheventsub.Message) *ChannelChatUserMessageHoldEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelChatUserMessageHoldEventBuilder) With(f func(e *twitcheventsub.ChannelChatUserMessageHoldEvent)) *ChannelChatUserMessageHoldEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelChatUserMessageHoldEventBuilder) Build() twitcheventsub.ChannelChatUserMessageHoldEvent {
+	return b.event
+}
+
+func (b *ChannelChatUserMessageHoldEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.chat.user_message_hold fixture carrying the built event.
+func (b *ChannelChatUserMessageHoldEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.chat.user_message_hold", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelChatUserMessageUpdateEventBuilder builds channel.chat.user_message_update events starting from the v1 fixture.
+type ChannelChatUserMessageUpdateEventBuilder struct {
+	event twitcheventsub.ChannelChatUserMessageUpdateEvent
+}
+
+// NewChannelChatUserMessageUpdateEvent returns a builder holding the channel.chat.user_message_update fixture event.
+func NewChannelChatUserMessageUpdateEvent() *ChannelChatUserMessageUpdateEventBuilder {
+	b := &ChannelChatUserMessageUpdateEventBuilder{}
+	mustDecode("channel.chat.user_message_update", "1", &b.event)
+	return b
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) WithBroadcasterUserID(v string) *ChannelChatUserMessageUpdateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) WithBroadcasterUserLogin(v string) *ChannelChatUserMessageUpdateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) WithBroadcasterUserName(v string) *ChannelChatUserMessageUpdateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) WithUserID(v string) *ChannelChatUserMessageUpdateEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) WithUserLogin(v string) *ChannelChatUserMessageUpdateEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) WithUserName(v string) *ChannelChatUserMessageUpdateEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) WithStatus(v string) *ChannelChatUserMessageUpdateEventBuilder {
+	b.event.Status = v
+	return b
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) WithMessageID(v string) *ChannelChatUserMessageUpdateEventBuilder {
+	b.event.MessageID = v
+	return b
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) WithMessage(v twitcheventsub.Message) *ChannelChatUserMessageUpdateEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelChatUserMessageUpdateEventBuilder) With(f func(e *twitcheventsub.ChannelChatUserMessageUpdateEvent)) *ChannelChatUserMessageUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) Build() twitcheventsub.ChannelChatUserMessageUpdateEvent {
+	return b.event
+}
+
+func (b *ChannelChatUserMessageUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.chat.user_message_update fixture carrying the built event.
+func (b *ChannelChatUserMessageUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.chat.user_message_update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelSubscribeEventBuilder builds channel.subscribe events starting from the v1 fixture.
+type ChannelSubscribeEventBuilder struct {
+	event twitcheventsub.ChannelSubscribeEvent
+}
+
+// NewChannelSubscribeEvent returns a builder holding the channel.subscribe fixture event.
+func NewChannelSubscribeEvent() *ChannelSubscribeEventBuilder {
+	b := &ChannelSubscribeEventBuilder{}
+	mustDecode("channel.subscribe", "1", &b.event)
+	return b
+}
+
+func (b *ChannelSubscribeEventBuilder) WithUserID(v string) *ChannelSubscribeEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelSubscribeEventBuilder) WithUserLogin(v string) *ChannelSubscribeEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelSubscribeEventBuilder) WithUserName(v string) *ChannelSubscribeEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelSubscribeEventBuilder) WithBroadcasterUserID(v string) *ChannelSubscribeEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelSubscribeEventBuilder) WithBroadcasterUserLogin(v string) *ChannelSubscribeEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelSubscribeEventBuilder) WithBroadcasterUserName(v string) *ChannelSubscribeEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelSubscribeEventBuilder) WithTier(v string) *ChannelSubscribeEventBuilder {
+	b.event.Tier = v
+	return b
+}
+
+func (b *ChannelSubscribeEventBuilder) WithIsGift(v bool) *ChannelSubscribeEventBuilder {
+	b.event.IsGift = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelSubscribeEventBuilder) With(f func(e *twitcheventsub.ChannelSubscribeEvent)) *ChannelSubscribeEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelSubscribeEventBuilder) Build() twitcheventsub.ChannelSubscribeEvent {
+	return b.event
+}
+
+func (b *ChannelSubscribeEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.subscribe fixture carrying the built event.
+func (b *ChannelSubscribeEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.subscribe", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelSubscriptionEndEventBuilder builds channel.subscription.end events starting from the v1 fixture.
+type ChannelSubscriptionEndEventBuilder struct {
+	event twitcheventsub.ChannelSubscriptionEndEvent
+}
+
+// NewChannelSubscriptionEndEvent returns a builder holding the channel.subscription.end fixture event.
+func NewChannelSubscriptionEndEvent() *ChannelSubscriptionEndEventBuilder {
+	b := &ChannelSubscriptionEndEventBuilder{}
+	mustDecode("channel.subscription.end", "1", &b.event)
+	return b
+}
+
+func (b *ChannelSubscriptionEndEventBuilder) WithUserID(v string) *ChannelSubscriptionEndEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelSubscriptionEndEventBuilder) WithUserLogin(v string) *ChannelSubscriptionEndEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelSubscriptionEndEventBuilder) WithUserName(v string) *ChannelSubscriptionEndEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelSubscriptionEndEventBuilder) WithBroadcasterUserID(v string) *ChannelSubscriptionEndEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelSubscriptionEndEventBuilder) WithBroadcasterUserLogin(v string) *ChannelSubscriptionEndEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelSubscriptionEndEventBuilder) WithBroadcasterUserName(v string) *ChannelSubscriptionEndEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelSubscriptionEndEventBuilder) WithTier(v string) *ChannelSubscriptionEndEventBuilder {
+	b.event.Tier = v
+	return b
+}
+
+func (b *ChannelSubscriptionEndEventBuilder) WithIsGift(v bool) *ChannelSubscriptionEndEventBuilder {
+	b.event.IsGift = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelSubscriptionEndEventBuilder) With(f func(e *twitcheventsub.ChannelSubscriptionEndEvent)) *ChannelSubscriptionEndEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelSubscriptionEndEventBuilder) Build() twitcheventsub.ChannelSubscriptionEndEvent {
+	return b.event
+}
+
+func (b *ChannelSubscriptionEndEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.subscription.end fixture carrying the built event.
+func (b *ChannelSubscriptionEndEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.subscription.end", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelSubscriptionGiftEventBuilder builds channel.subscription.gift events starting from the v1 fixture.
+type ChannelSubscriptionGiftEventBuilder struct {
+	event twitcheventsub.ChannelSubscriptionGiftEvent
+}
+
+// NewChannelSubscriptionGiftEvent returns a builder holding the channel.subscription.gift fixture event.
+func NewChannelSubscriptionGiftEvent() *ChannelSubscriptionGiftEventBuilder {
+	b := &ChannelSubscriptionGiftEventBuilder{}
+	mustDecode("channel.subscription.gift", "1", &b.event)
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) WithUserID(v string) *ChannelSubscriptionGiftEventBuilder {
+	b.event.UserID = &v
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) WithUserLogin(v string) *ChannelSubscriptionGiftEventBuilder {
+	b.event.UserLogin = &v
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) WithUserName(v string) *ChannelSubscriptionGiftEventBuilder {
+	b.event.UserName = &v
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) WithBroadcasterUserID(v string) *ChannelSubscriptionGiftEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) WithBroadcasterUserLogin(v string) *ChannelSubscriptionGiftEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) WithBroadcasterUserName(v string) *ChannelSubscriptionGiftEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) WithTotal(v int) *ChannelSubscriptionGiftEventBuilder {
+	b.event.Total = v
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) WithTier(v string) *ChannelSubscriptionGiftEventBuilder {
+	b.event.Tier = v
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) WithCumulativeTotal(v int) *ChannelSubscriptionGiftEventBuilder {
+	b.event.CumulativeTotal = &v
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) WithIsAnonymous(v bool) *ChannelSubscriptionGiftEventBuilder {
+	b.event.IsAnonymous = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelSubscriptionGiftEventBuilder) With(f func(e *twitcheventsub.ChannelSubscriptionGiftEvent)) *ChannelSubscriptionGiftEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) Build() twitcheventsub.ChannelSubscriptionGiftEvent {
+	return b.event
+}
+
+func (b *ChannelSubscriptionGiftEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.subscription.gift fixture carrying the built event.
+func (b *ChannelSubscriptionGiftEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.subscription.gift", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelSubscriptionMessageEventBuilder builds channel.subscription.message events starting from the v1 fixture.
+type ChannelSubscriptionMessageEventBuilder struct {
+	event twitcheventsub.ChannelSubscriptionMessageEvent
+}
+
+// NewChannelSubscriptionMessageEvent returns a builder holding the channel.subscription.message fixture event.
+func NewChannelSubscriptionMessageEvent() *ChannelSubscriptionMessageEventBuilder {
+	b := &ChannelSubscriptionMessageEventBuilder{}
+	mustDecode("channel.subscription.message", "1", &b.event)
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithUserID(v string) *ChannelSubscriptionMessageEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithUserLogin(v string) *ChannelSubscriptionMessageEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithUserName(v string) *ChannelSubscriptionMessageEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithBroadcasterUserID(v string) *ChannelSubscriptionMessageEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithBroadcasterUserLogin(v string) *ChannelSubscriptionMessageEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithBroadcasterUserName(v string) *ChannelSubscriptionMessageEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithTier(v string) *ChannelSubscriptionMessageEventBuilder {
+	b.event.Tier = v
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithMessage(v twitcheventsub.SubscriptionMessageStruct) *ChannelSubscriptionMessageEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithCumulativeMonths(v int) *ChannelSubscriptionMessageEventBuilder {
+	b.event.CumulativeMonths = v
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithStreakMonths(v int) *ChannelSubscriptionMessageEventBuilder {
+	b.event.StreakMonths = &v
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) WithDurationMonths(v int) *ChannelSubscriptionMessageEventBuilder {
+	b.event.DurationMonths = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelSubscriptionMessageEventBuilder) With(f func(e *twitcheventsub.ChannelSubscriptionMessageEvent)) *ChannelSubscriptionMessageEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) Build() twitcheventsub.ChannelSubscriptionMessageEvent {
+	return b.event
+}
+
+func (b *ChannelSubscriptionMessageEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.subscription.message fixture carrying the built event.
+func (b *ChannelSubscriptionMessageEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.subscription.message", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelCheerEventBuilder builds channel.cheer events starting from the v1 fixture.
+type ChannelCheerEventBuilder struct {
+	event twitcheventsub.ChannelCheerEvent
+}
+
+// NewChannelCheerEvent returns a builder holding the channel.cheer fixture event.
+func NewChannelCheerEvent() *ChannelCheerEventBuilder {
+	b := &ChannelCheerEventBuilder{}
+	mustDecode("channel.cheer", "1", &b.event)
+	return b
+}
+
+func (b *ChannelCheerEventBuilder) WithIsAnonymous(v bool) *ChannelCheerEventBuilder {
+	b.event.IsAnonymous = v
+	return b
+}
+
+func (b *ChannelCheerEventBuilder) WithUserID(v string) *ChannelCheerEventBuilder {
+	b.event.UserID = &v
+	return b
+}
+
+func (b *ChannelCheerEventBuilder) WithUserLogin(v string) *ChannelCheerEventBuilder {
+	b.event.UserLogin = &v
+	return b
+}
+
+func (b *ChannelCheerEventBuilder) WithUserName(v string) *ChannelCheerEventBuilder {
+	b.event.UserName = &v
+	return b
+}
+
+func (b *ChannelCheerEventBuilder) WithBroadcasterUserID(v string) *ChannelCheerEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelCheerEventBuilder) WithBroadcasterUserLogin(v string) *ChannelCheerEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelCheerEventBuilder) WithBroadcasterUserName(v string) *ChannelCheerEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelCheerEventBuilder) WithMessage(v string) *ChannelCheerEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+func (b *ChannelCheerEventBuilder) WithBits(v int) *ChannelCheerEventBuilder {
+	b.event.Bits = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelCheerEventBuilder) With(f func(e *twitcheventsub.ChannelCheerEvent)) *ChannelCheerEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelCheerEventBuilder) Build() twitcheventsub.ChannelCheerEvent {
+	return b.event
+}
+
+func (b *ChannelCheerEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.cheer fixture carrying the built event.
+func (b *ChannelCheerEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.cheer", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelRaidEventBuilder builds channel.raid events starting from the v1 fixture.
+type ChannelRaidEventBuilder struct {
+	event twitcheventsub.ChannelRaidEvent
+}
+
+// NewChannelRaidEvent returns a builder holding the channel.raid fixture event.
+func NewChannelRaidEvent() *ChannelRaidEventBuilder {
+	b := &ChannelRaidEventBuilder{}
+	mustDecode("channel.raid", "1", &b.event)
+	return b
+}
+
+func (b *ChannelRaidEventBuilder) WithFromBroadcasterUserID(v string) *ChannelRaidEventBuilder {
+	b.event.FromBroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelRaidEventBuilder) WithFromBroadcasterUserLogin(v string) *ChannelRaidEventBuilder {
+	b.event.FromBroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelRaidEventBuilder) WithFromBroadcasterUserName(v string) *ChannelRaidEventBuilder {
+	b.event.FromBroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelRaidEventBuilder) WithToBroadcasterUserID(v string) *ChannelRaidEventBuilder {
+	b.event.ToBroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelRaidEventBuilder) WithToBroadcasterUserLogin(v string) *ChannelRaidEventBuilder {
+	b.event.ToBroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelRaidEventBuilder) WithToBroadcasterUserName(v string) *ChannelRaidEventBuilder {
+	b.event.ToBroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelRaidEventBuilder) WithViewers(v int) *ChannelRaidEventBuilder {
+	b.event.Viewers = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelRaidEventBuilder) With(f func(e *twitcheventsub.ChannelRaidEvent)) *ChannelRaidEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelRaidEventBuilder) Build() twitcheventsub.ChannelRaidEvent {
+	return b.event
+}
+
+func (b *ChannelRaidEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.raid fixture carrying the built event.
+func (b *ChannelRaidEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.raid", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelBanEventBuilder builds channel.ban events starting from the v1 fixture.
+type ChannelBanEventBuilder struct {
+	event twitcheventsub.ChannelBanEvent
+}
+
+// NewChannelBanEvent returns a builder holding the channel.ban fixture event.
+func NewChannelBanEvent() *ChannelBanEventBuilder {
+	b := &ChannelBanEventBuilder{}
+	mustDecode("channel.ban", "1", &b.event)
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithUserID(v string) *ChannelBanEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithUserLogin(v string) *ChannelBanEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithUserName(v string) *ChannelBanEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithBroadcasterUserID(v string) *ChannelBanEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithBroadcasterUserLogin(v string) *ChannelBanEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithBroadcasterUserName(v string) *ChannelBanEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithModeratorUserID(v string) *ChannelBanEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithModeratorUserLogin(v string) *ChannelBanEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithModeratorUserName(v string) *ChannelBanEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithReason(v string) *ChannelBanEventBuilder {
+	b.event.Reason = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithBannedAt(v time.Time) *ChannelBanEventBuilder {
+	b.event.BannedAt = v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithEndsAt(v time.Time) *ChannelBanEventBuilder {
+	b.event.EndsAt = &v
+	return b
+}
+
+func (b *ChannelBanEventBuilder) WithIsPermanent(v bool) *ChannelBanEventBuilder {
+	b.event.IsPermanent = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelBanEventBuilder) With(f func(e *twitcheventsub.ChannelBanEvent)) *ChannelBanEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelBanEventBuilder) Build() twitcheventsub.ChannelBanEvent {
+	return b.event
+}
+
+func (b *ChannelBanEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.ban fixture carrying the built event.
+func (b *ChannelBanEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.ban", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelUnbanEventBuilder builds channel.unban events starting from the v1 fixture.
+type ChannelUnbanEventBuilder struct {
+	event twitcheventsub.ChannelUnbanEvent
+}
+
+// NewChannelUnbanEvent returns a builder holding the channel.unban fixture event.
+func NewChannelUnbanEvent() *ChannelUnbanEventBuilder {
+	b := &ChannelUnbanEventBuilder{}
+	mustDecode("channel.unban", "1", &b.event)
+	return b
+}
+
+func (b *ChannelUnbanEventBuilder) WithUserID(v string) *ChannelUnbanEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelUnbanEventBuilder) WithUserLogin(v string) *ChannelUnbanEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelUnbanEventBuilder) WithUserName(v string) *ChannelUnbanEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelUnbanEventBuilder) WithBroadcasterUserID(v string) *ChannelUnbanEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelUnbanEventBuilder) WithBroadcasterUserLogin(v string) *ChannelUnbanEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelUnbanEventBuilder) WithBroadcasterUserName(v string) *ChannelUnbanEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelUnbanEventBuilder) WithModeratorUserID(v string) *ChannelUnbanEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelUnbanEventBuilder) WithModeratorUserLogin(v string) *ChannelUnbanEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelUnbanEventBuilder) WithModeratorUserName(v string) *ChannelUnbanEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelUnbanEventBuilder) With(f func(e *twitcheventsub.ChannelUnbanEvent)) *ChannelUnbanEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelUnbanEventBuilder) Build() twitcheventsub.ChannelUnbanEvent {
+	return b.event
+}
+
+func (b *ChannelUnbanEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.unban fixture carrying the built event.
+func (b *ChannelUnbanEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.unban", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelUnbanRequestCreateEventBuilder builds channel.unban_request.create events starting from the v1 fixture.
+type ChannelUnbanRequestCreateEventBuilder struct {
+	event twitcheventsub.ChannelUnbanRequestCreateEvent
+}
+
+// NewChannelUnbanRequestCreateEvent returns a builder holding the channel.unban_request.create fixture event.
+func NewChannelUnbanRequestCreateEvent() *ChannelUnbanRequestCreateEventBuilder {
+	b := &ChannelUnbanRequestCreateEventBuilder{}
+	mustDecode("channel.unban_request.create", "1", &b.event)
+	return b
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) WithID(v string) *ChannelUnbanRequestCreateEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) WithBroadcasterUserID(v string) *ChannelUnbanRequestCreateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) WithBroadcasterUserLogin(v string) *ChannelUnbanRequestCreateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) WithBroadcasterUserName(v string) *ChannelUnbanRequestCreateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) WithUserID(v string) *ChannelUnbanRequestCreateEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) WithUserLogin(v string) *ChannelUnbanRequestCreateEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) WithUserName(v string) *ChannelUnbanRequestCreateEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) WithText(v string) *ChannelUnbanRequestCreateEventBuilder {
+	b.event.Text = v
+	return b
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) WithCreatedAt(v time.Time) *ChannelUnbanRequestCreateEventBuilder {
+	b.event.CreatedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelUnbanRequestCreateEventBuilder) With(f func(e *twitcheventsub.ChannelUnbanRequestCreateEvent)) *ChannelUnbanRequestCreateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) Build() twitcheventsub.ChannelUnbanRequestCreateEvent {
+	return b.event
+}
+
+func (b *ChannelUnbanRequestCreateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.unban_request.create fixture carrying the built event.
+func (b *ChannelUnbanRequestCreateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.unban_request.create", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelUnbanRequestResolveEventBuilder builds channel.unban_request.resolve events starting from the v1 fixture.
+type ChannelUnbanRequestResolveEventBuilder struct {
+	event twitcheventsub.ChannelUnbanRequestResolveEvent
+}
+
+// NewChannelUnbanRequestResolveEvent returns a builder holding the channel.unban_request.resolve fixture event.
+func NewChannelUnbanRequestResolveEvent() *ChannelUnbanRequestResolveEventBuilder {
+	b := &ChannelUnbanRequestResolveEventBuilder{}
+	mustDecode("channel.unban_request.resolve", "1", &b.event)
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithID(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithBroadcasterUserID(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithBroadcasterUserLogin(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithBroadcasterUserName(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithModeratorUserID(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithModeratorUserLogin(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithModeratorUserName(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithUserID(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithUserLogin(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithUserName(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithResolutionText(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.ResolutionText = v
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) WithStatus(v string) *ChannelUnbanRequestResolveEventBuilder {
+	b.event.Status = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelUnbanRequestResolveEventBuilder) With(f func(e *twitcheventsub.ChannelUnbanRequestResolveEvent)) *ChannelUnbanRequestResolveEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) Build() twitcheventsub.ChannelUnbanRequestResolveEvent {
+	return b.event
+}
+
+func (b *ChannelUnbanRequestResolveEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.unban_request.resolve fixture carrying the built event.
+func (b *ChannelUnbanRequestResolveEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.unban_request.resolve", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelModerateEventV2Builder builds channel.moderate events starting from the v2 fixture.
+type ChannelModerateEventV2Builder struct {
+	event twitcheventsub.ChannelModerateEventV2
+}
+
+// NewChannelModerateEventV2 returns a builder holding the channel.moderate fixture event.
+func NewChannelModerateEventV2() *ChannelModerateEventV2Builder {
+	b := &ChannelModerateEventV2Builder{}
+	mustDecode("channel.moderate", "2", &b.event)
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithBroadcasterUserID(v string) *ChannelModerateEventV2Builder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithBroadcasterUserLogin(v string) *ChannelModerateEventV2Builder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithBroadcasterUserName(v string) *ChannelModerateEventV2Builder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithSourceBroadcasterUserID(v string) *ChannelModerateEventV2Builder {
+	b.event.SourceBroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithSourceBroadcasterUserLogin(v string) *ChannelModerateEventV2Builder {
+	b.event.SourceBroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithSourceBroadcasterUserName(v string) *ChannelModerateEventV2Builder {
+	b.event.SourceBroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithModeratorUserID(v string) *ChannelModerateEventV2Builder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithModeratorUserLogin(v string) *ChannelModerateEventV2Builder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithModeratorUserName(v string) *ChannelModerateEventV2Builder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithAction(v string) *ChannelModerateEventV2Builder {
+	b.event.Action = v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithFollowers(v twitcheventsub.FollowDurationMinutes) *ChannelModerateEventV2Builder {
+	b.event.Followers = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithSlow(v twitcheventsub.Slow) *ChannelModerateEventV2Builder {
+	b.event.Slow = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithVip(v twitcheventsub.UserData) *ChannelModerateEventV2Builder {
+	b.event.Vip = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithUnvip(v twitcheventsub.UserData) *ChannelModerateEventV2Builder {
+	b.event.Unvip = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithMod(v twitcheventsub.UserData) *ChannelModerateEventV2Builder {
+	b.event.Mod = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithUnmod(v twitcheventsub.UserData) *ChannelModerateEventV2Builder {
+	b.event.Unmod = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithBan(v twitcheventsub.EventBan) *ChannelModerateEventV2Builder {
+	b.event.Ban = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithUnban(v twitcheventsub.UserData) *ChannelModerateEventV2Builder {
+	b.event.Unban = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithTimeout(v twitcheventsub.Timeout) *ChannelModerateEventV2Builder {
+	b.event.Timeout = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithUntimeout(v twitcheventsub.UserData) *ChannelModerateEventV2Builder {
+	b.event.Untimeout = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithRaid(v twitcheventsub.EventRaid) *ChannelModerateEventV2Builder {
+	b.event.Raid = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithUnraid(v twitcheventsub.UserData) *ChannelModerateEventV2Builder {
+	b.event.Unraid = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithDelete(v twitcheventsub.Delete) *ChannelModerateEventV2Builder {
+	b.event.Delete = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithAutomodTerms(v twitcheventsub.AutoModTerms) *ChannelModerateEventV2Builder {
+	b.event.AutomodTerms = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithUnbanRequest(v twitcheventsub.UnBanRequest) *ChannelModerateEventV2Builder {
+	b.event.UnbanRequest = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithWarn(v twitcheventsub.WarnEvent) *ChannelModerateEventV2Builder {
+	b.event.Warn = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithSharedChatBan(v twitcheventsub.EventBan) *ChannelModerateEventV2Builder {
+	b.event.SharedChatBan = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithSharedChatUnban(v twitcheventsub.UserData) *ChannelModerateEventV2Builder {
+	b.event.SharedChatUnban = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithSharedChatTimeout(v twitcheventsub.Timeout) *ChannelModerateEventV2Builder {
+	b.event.SharedChatTimeout = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithSharedChatUntimeout(v twitcheventsub.UserData) *ChannelModerateEventV2Builder {
+	b.event.SharedChatUntimeout = &v
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) WithSharedChatDelete(v twitcheventsub.Delete) *ChannelModerateEventV2Builder {
+	b.event.SharedChatDelete = &v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelModerateEventV2Builder) With(f func(e *twitcheventsub.ChannelModerateEventV2)) *ChannelModerateEventV2Builder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelModerateEventV2Builder) Build() twitcheventsub.ChannelModerateEventV2 {
+	return b.event
+}
+
+func (b *ChannelModerateEventV2Builder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.moderate fixture carrying the built event.
+func (b *ChannelModerateEventV2Builder) Fixture() Fixture {
+	f := mustLoad("channel.moderate", "2")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelModeratorAddEventBuilder builds channel.moderator.add events starting from the v1 fixture.
+type ChannelModeratorAddEventBuilder struct {
+	event twitcheventsub.ChannelModeratorAddEvent
+}
+
+// NewChannelModeratorAddEvent returns a builder holding the channel.moderator.add fixture event.
+func NewChannelModeratorAddEvent() *ChannelModeratorAddEventBuilder {
+	b := &ChannelModeratorAddEventBuilder{}
+	mustDecode("channel.moderator.add", "1", &b.event)
+	return b
+}
+
+func (b *ChannelModeratorAddEventBuilder) WithUserID(v string) *ChannelModeratorAddEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelModeratorAddEventBuilder) WithUserLogin(v string) *ChannelModeratorAddEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelModeratorAddEventBuilder) WithUserName(v string) *ChannelModeratorAddEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelModeratorAddEventBuilder) WithBroadcasterUserID(v string) *ChannelModeratorAddEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelModeratorAddEventBuilder) WithBroadcasterUserLogin(v string) *ChannelModeratorAddEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelModeratorAddEventBuilder) WithBroadcasterUserName(v string) *ChannelModeratorAddEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelModeratorAddEventBuilder) With(f func(e *twitcheventsub.ChannelModeratorAddEvent)) *ChannelModeratorAddEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelModeratorAddEventBuilder) Build() twitcheventsub.ChannelModeratorAddEvent {
+	return b.event
+}
+
+func (b *ChannelModeratorAddEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.moderator.add fixture carrying the built event.
+func (b *ChannelModeratorAddEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.moderator.add", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelModeratorRemoveEventBuilder builds channel.moderator.remove events starting from the v1 fixture.
+type ChannelModeratorRemoveEventBuilder struct {
+	event twitcheventsub.ChannelModeratorRemoveEvent
+}
+
+// NewChannelModeratorRemoveEvent returns a builder holding the channel.moderator.remove fixture event.
+func NewChannelModeratorRemoveEvent() *ChannelModeratorRemoveEventBuilder {
+	b := &ChannelModeratorRemoveEventBuilder{}
+	mustDecode("channel.moderator.remove", "1", &b.event)
+	return b
+}
+
+func (b *ChannelModeratorRemoveEventBuilder) WithUserID(v string) *ChannelModeratorRemoveEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelModeratorRemoveEventBuilder) WithUserLogin(v string) *ChannelModeratorRemoveEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelModeratorRemoveEventBuilder) WithUserName(v string) *ChannelModeratorRemoveEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelModeratorRemoveEventBuilder) WithBroadcasterUserID(v string) *ChannelModeratorRemoveEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelModeratorRemoveEventBuilder) WithBroadcasterUserLogin(v string) *ChannelModeratorRemoveEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelModeratorRemoveEventBuilder) WithBroadcasterUserName(v string) *ChannelModeratorRemoveEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelModeratorRemoveEventBuilder) With(f func(e *twitcheventsub.ChannelModeratorRemoveEvent)) *ChannelModeratorRemoveEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelModeratorRemoveEventBuilder) Build() twitcheventsub.ChannelModeratorRemoveEvent {
+	return b.event
+}
+
+func (b *ChannelModeratorRemoveEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.moderator.remove fixture carrying the built event.
+func (b *ChannelModeratorRemoveEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.moderator.remove", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelGuestStarSessionBeginEventBuilder builds channel.guest_star_session.begin events starting from the v1 fixture.
+type ChannelGuestStarSessionBeginEventBuilder struct {
+	event twitcheventsub.ChannelGuestStarSessionBeginEvent
+}
+
+// NewChannelGuestStarSessionBeginEvent returns a builder holding the channel.guest_star_session.begin fixture event.
+func NewChannelGuestStarSessionBeginEvent() *ChannelGuestStarSessionBeginEventBuilder {
+	b := &ChannelGuestStarSessionBeginEventBuilder{}
+	mustDecode("channel.guest_star_session.begin", "1", &b.event)
+	return b
+}
+
+func (b *ChannelGuestStarSessionBeginEventBuilder) WithBroadcasterUserID(v string) *ChannelGuestStarSessionBeginEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionBeginEventBuilder) WithBroadcasterUserName(v string) *ChannelGuestStarSessionBeginEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionBeginEventBuilder) WithBroadcasterUserLogin(v string) *ChannelGuestStarSessionBeginEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionBeginEventBuilder) WithModeratorUserID(v string) *ChannelGuestStarSessionBeginEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionBeginEventBuilder) WithModeratorUserName(v string) *ChannelGuestStarSessionBeginEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionBeginEventBuilder) WithModeratorUserLogin(v string) *ChannelGuestStarSessionBeginEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionBeginEventBuilder) WithSessionID(v string) *ChannelGuestStarSessionBeginEventBuilder {
+	b.event.SessionID = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionBeginEventBuilder) WithStartedAt(v time.Time) *ChannelGuestStarSessionBeginEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelGuestStarSessionBeginEventBuilder) With(f func(e *twitcheventsub.ChannelGuestStarSessionBeginEvent)) *ChannelGuestStarSessionBeginEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelGuestStarSessionBeginEventBuilder) Build() twitcheventsub.ChannelGuestStarSessionBeginEvent {
+	return b.event
+}
+
+func (b *ChannelGuestStarSessionBeginEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.guest_star_session.begin fixture carrying the built event.
+func (b *ChannelGuestStarSessionBeginEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.guest_star_session.begin", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelGuestStarSessionEndEventBuilder builds channel.guest_star_session.end events starting from the v1 fixture.
+type ChannelGuestStarSessionEndEventBuilder struct {
+	event twitcheventsub.ChannelGuestStarSessionEndEvent
+}
+
+// NewChannelGuestStarSessionEndEvent returns a builder holding the channel.guest_star_session.end fixture event.
+func NewChannelGuestStarSessionEndEvent() *ChannelGuestStarSessionEndEventBuilder {
+	b := &ChannelGuestStarSessionEndEventBuilder{}
+	mustDecode("channel.guest_star_session.end", "1", &b.event)
+	return b
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) WithBroadcasterUserID(v string) *ChannelGuestStarSessionEndEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) WithBroadcasterUserName(v string) *ChannelGuestStarSessionEndEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) WithBroadcasterUserLogin(v string) *ChannelGuestStarSessionEndEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) WithModeratorUserID(v string) *ChannelGuestStarSessionEndEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) WithModeratorUserName(v string) *ChannelGuestStarSessionEndEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) WithModeratorUserLogin(v string) *ChannelGuestStarSessionEndEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) WithSessionID(v string) *ChannelGuestStarSessionEndEventBuilder {
+	b.event.SessionID = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) WithStartedAt(v time.Time) *ChannelGuestStarSessionEndEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) WithEndedAt(v time.Time) *ChannelGuestStarSessionEndEventBuilder {
+	b.event.EndedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelGuestStarSessionEndEventBuilder) With(f func(e *twitcheventsub.ChannelGuestStarSessionEndEvent)) *ChannelGuestStarSessionEndEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) Build() twitcheventsub.ChannelGuestStarSessionEndEvent {
+	return b.event
+}
+
+func (b *ChannelGuestStarSessionEndEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.guest_star_session.end fixture carrying the built event.
+func (b *ChannelGuestStarSessionEndEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.guest_star_session.end", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelGuestStarGuestUpdateEventBuilder builds channel.guest_star_guest.update events starting from the v1 fixture.
+type ChannelGuestStarGuestUpdateEventBuilder struct {
+	event twitcheventsub.ChannelGuestStarGuestUpdateEvent
+}
+
+// NewChannelGuestStarGuestUpdateEvent returns a builder holding the channel.guest_star_guest.update fixture event.
+func NewChannelGuestStarGuestUpdateEvent() *ChannelGuestStarGuestUpdateEventBuilder {
+	b := &ChannelGuestStarGuestUpdateEventBuilder{}
+	mustDecode("channel.guest_star_guest.update", "1", &b.event)
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithBroadcasterUserID(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithBroadcasterUserName(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithBroadcasterUserLogin(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithSessionID(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.SessionID = v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithModeratorUserID(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.ModeratorUserID = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithModeratorUserName(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.ModeratorUserName = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithModeratorUserLogin(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.ModeratorUserLogin = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithGuestUserID(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.GuestUserID = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithGuestUserName(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.GuestUserName = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithGuestUserLogin(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.GuestUserLogin = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithSlotID(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.SlotID = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithState(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.State = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithHostVideoEnabled(v bool) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.HostVideoEnabled = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithHostAudioEnabled(v bool) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.HostAudioEnabled = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithHostVolume(v int) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.HostVolume = &v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithHostUserID(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.HostUserID = v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithHostUserName(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.HostUserName = v
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) WithHostUserLogin(v string) *ChannelGuestStarGuestUpdateEventBuilder {
+	b.event.HostUserLogin = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelGuestStarGuestUpdateEventBuilder) With(f func(e *twitcheventsub.ChannelGuestStarGuestUpdateEvent)) *ChannelGuestStarGuestUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) Build() twitcheventsub.ChannelGuestStarGuestUpdateEvent {
+	return b.event
+}
+
+func (b *ChannelGuestStarGuestUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.guest_star_guest.update fixture carrying the built event.
+func (b *ChannelGuestStarGuestUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.guest_star_guest.update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelGuestStarSettingsUpdateEventBuilder builds channel.guest_star_settings.update events starting from the v1 fixture.
+type ChannelGuestStarSettingsUpdateEventBuilder struct {
+	event twitcheventsub.ChannelGuestStarSettingsUpdateEvent
+}
+
+// NewChannelGuestStarSettingsUpdateEvent returns a builder holding the channel.guest_star_settings.update fixture event.
+func NewChannelGuestStarSettingsUpdateEvent() *ChannelGuestStarSettingsUpdateEventBuilder {
+	b := &ChannelGuestStarSettingsUpdateEventBuilder{}
+	mustDecode("channel.guest_star_settings.update", "1", &b.event)
+	return b
+}
+
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) WithBroadcasterUserID(v string) *ChannelGuestStarSettingsUpdateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) WithBroadcasterUserName(v string) *ChannelGuestStarSettingsUpdateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) WithBroadcasterUserLogin(v string) *ChannelGuestStarSettingsUpdateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) WithIsModeratorSendLiveEnabled(v bool) *ChannelGuestStarSettingsUpdateEventBuilder {
+	b.event.IsModeratorSendLiveEnabled = v
+	return b
+}
+
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) WithSlotCount(v int) *ChannelGuestStarSettingsUpdateEventBuilder {
+	b.event.SlotCount = v
+	return b
+}
+
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) WithIsBrowserSourceAudioEnabled(v bool) *ChannelGuestStarSettingsUpdateEventBuilder {
+	b.event.IsBrowserSourceAudioEnabled = v
+	return b
+}
+
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) WithGroupLayout(v string) *ChannelGuestStarSettingsUpdateEventBuilder {
+	b.event.GroupLayout = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) With(f func(e *twitcheventsub.ChannelGuestStarSettingsUpdateEvent)) *ChannelGuestStarSettingsUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) Build() twitcheventsub.ChannelGuestStarSettingsUpdateEvent {
+	return b.event
+}
+
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.guest_star_settings.update fixture carrying the built event.
+func (b *ChannelGuestStarSettingsUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.guest_star_settings.update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPointsAutomaticRewardRedemptionAddEventBuilder builds channel.channel_points_automatic_reward_redemption.add events starting from the v2 fixture.
+type ChannelPointsAutomaticRewardRedemptionAddEventBuilder struct {
+	event twitcheventsub.ChannelPointsAutomaticRewardRedemptionAddEvent
+}
+
+// NewChannelPointsAutomaticRewardRedemptionAddEvent returns a builder holding the channel.channel_points_automatic_reward_redemption.add fixture event.
+func NewChannelPointsAutomaticRewardRedemptionAddEvent() *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b := &ChannelPointsAutomaticRewardRedemptionAddEventBuilder{}
+	mustDecode("channel.channel_points_automatic_reward_redemption.add", "2", &b.event)
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithBroadcasterUserID(v string) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithBroadcasterUserName(v string) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithUserID(v string) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithUserName(v string) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithUserLogin(v string) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithID(v string) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithReward(v twitcheventsub.ChannelPointsReward) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.Reward = v
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithMessage(v twitcheventsub.ChannelPointsRewardMessage) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithUserInput(v string) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.UserInput = v
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) WithRedeemedAt(v time.Time) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	b.event.RedeemedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) With(f func(e *twitcheventsub.ChannelPointsAutomaticRewardRedemptionAddEvent)) *ChannelPointsAutomaticRewardRedemptionAddEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) Build() twitcheventsub.ChannelPointsAutomaticRewardRedemptionAddEvent {
+	return b.event
+}
+
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.channel_points_automatic_reward_redemption.add fixture carrying the built event.
+func (b *ChannelPointsAutomaticRewardRedemptionAddEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.channel_points_automatic_reward_redemption.add", "2")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPointsCustomRewardAddEventBuilder builds channel.channel_points_custom_reward.add events starting from the v1 fixture.
+type ChannelPointsCustomRewardAddEventBuilder struct {
+	event twitcheventsub.ChannelPointsCustomRewardAddEvent
+}
+
+// NewChannelPointsCustomRewardAddEvent returns a builder holding the channel.channel_points_custom_reward.add fixture event.
+func NewChannelPointsCustomRewardAddEvent() *ChannelPointsCustomRewardAddEventBuilder {
+	b := &ChannelPointsCustomRewardAddEventBuilder{}
+	mustDecode("channel.channel_points_custom_reward.add", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithID(v string) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithBroadcasterUserID(v string) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithBroadcasterUserName(v string) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithIsEnabled(v bool) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.IsEnabled = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithIsPaused(v bool) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.IsPaused = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithIsInStock(v bool) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.IsInStock = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithTitle(v string) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithCost(v int) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.Cost = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithPrompt(v string) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.Prompt = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithIsUserInputRequired(v bool) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.IsUserInputRequired = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithShouldRedemptionsSkipRequestQueue(v bool) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.ShouldRedemptionsSkipRequestQueue = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithCooldownExpiresAt(v time.Time) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.CooldownExpiresAt = &v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithRedemptionsRedeemedCurrentStream(v int) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.RedemptionsRedeemedCurrentStream = &v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithMaxPerStream(v twitcheventsub.MaxPer) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.MaxPerStream = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithMaxPerUserPerStream(v twitcheventsub.MaxPer) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.MaxPerUserPerStream = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithGlobalCooldown(v twitcheventsub.Cooldown) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.GlobalCooldown = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithBackgroundColor(v string) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.BackgroundColor = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithImage(v twitcheventsub.Image) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.Image = &v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) WithDefaultImage(v twitcheventsub.Image) *ChannelPointsCustomRewardAddEventBuilder {
+	b.event.DefaultImage = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPointsCustomRewardAddEventBuilder) With(f func(e *twitcheventsub.ChannelPointsCustomRewardAddEvent)) *ChannelPointsCustomRewardAddEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) Build() twitcheventsub.ChannelPointsCustomRewardAddEvent {
+	return b.event
+}
+
+func (b *ChannelPointsCustomRewardAddEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.channel_points_custom_reward.add fixture carrying the built event.
+func (b *ChannelPointsCustomRewardAddEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.channel_points_custom_reward.add", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPointsCustomRewardUpdateEventBuilder builds channel.channel_points_custom_reward.update events starting from the v1 fixture.
+type ChannelPointsCustomRewardUpdateEventBuilder struct {
+	event twitcheventsub.ChannelPointsCustomRewardUpdateEvent
+}
+
+// NewChannelPointsCustomRewardUpdateEvent returns a builder holding the channel.channel_points_custom_reward.update fixture event.
+func NewChannelPointsCustomRewardUpdateEvent() *ChannelPointsCustomRewardUpdateEventBuilder {
+	b := &ChannelPointsCustomRewardUpdateEventBuilder{}
+	mustDecode("channel.channel_points_custom_reward.update", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithID(v string) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithBroadcasterUserID(v string) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithBroadcasterUserName(v string) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithIsEnabled(v bool) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.IsEnabled = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithIsPaused(v bool) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.IsPaused = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithIsInStock(v bool) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.IsInStock = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithTitle(v string) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithCost(v int) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.Cost = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithPrompt(v string) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.Prompt = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithIsUserInputRequired(v bool) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.IsUserInputRequired = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithShouldRedemptionsSkipRequestQueue(v bool) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.ShouldRedemptionsSkipRequestQueue = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithCooldownExpiresAt(v time.Time) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.CooldownExpiresAt = &v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithRedemptionsRedeemedCurrentStream(v int) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.RedemptionsRedeemedCurrentStream = &v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithMaxPerStream(v twitcheventsub.MaxPer) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.MaxPerStream = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithMaxPerUserPerStream(v twitcheventsub.MaxPer) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.MaxPerUserPerStream = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithGlobalCooldown(v twitcheventsub.Cooldown) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.GlobalCooldown = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithBackgroundColor(v string) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.BackgroundColor = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithImage(v twitcheventsub.Image) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.Image = &v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) WithDefaultImage(v twitcheventsub.Image) *ChannelPointsCustomRewardUpdateEventBuilder {
+	b.event.DefaultImage = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) With(f func(e *twitcheventsub.ChannelPointsCustomRewardUpdateEvent)) *ChannelPointsCustomRewardUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) Build() twitcheventsub.ChannelPointsCustomRewardUpdateEvent {
+	return b.event
+}
+
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.channel_points_custom_reward.update fixture carrying the built event.
+func (b *ChannelPointsCustomRewardUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.channel_points_custom_reward.update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPointsCustomRewardRemoveEventBuilder builds channel.channel_points_custom_reward.remove events starting from the v1 fixture.
+type ChannelPointsCustomRewardRemoveEventBuilder struct {
+	event twitcheventsub.ChannelPointsCustomRewardRemoveEvent
+}
+
+// NewChannelPointsCustomRewardRemoveEvent returns a builder holding the channel.channel_points_custom_reward.remove fixture event.
+func NewChannelPointsCustomRewardRemoveEvent() *ChannelPointsCustomRewardRemoveEventBuilder {
+	b := &ChannelPointsCustomRewardRemoveEventBuilder{}
+	mustDecode("channel.channel_points_custom_reward.remove", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithID(v string) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithBroadcasterUserID(v string) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithBroadcasterUserName(v string) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithIsEnabled(v bool) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.IsEnabled = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithIsPaused(v bool) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.IsPaused = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithIsInStock(v bool) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.IsInStock = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithTitle(v string) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithCost(v int) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.Cost = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithPrompt(v string) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.Prompt = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithIsUserInputRequired(v bool) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.IsUserInputRequired = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithShouldRedemptionsSkipRequestQueue(v bool) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.ShouldRedemptionsSkipRequestQueue = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithCooldownExpiresAt(v time.Time) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.CooldownExpiresAt = &v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithRedemptionsRedeemedCurrentStream(v int) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.RedemptionsRedeemedCurrentStream = &v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithMaxPerStream(v twitcheventsub.MaxPer) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.MaxPerStream = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithMaxPerUserPerStream(v twitcheventsub.MaxPer) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.MaxPerUserPerStream = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithGlobalCooldown(v twitcheventsub.Cooldown) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.GlobalCooldown = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithBackgroundColor(v string) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.BackgroundColor = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithImage(v twitcheventsub.Image) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.Image = &v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) WithDefaultImage(v twitcheventsub.Image) *ChannelPointsCustomRewardRemoveEventBuilder {
+	b.event.DefaultImage = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) With(f func(e *twitcheventsub.ChannelPointsCustomRewardRemoveEvent)) *ChannelPointsCustomRewardRemoveEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) Build() twitcheventsub.ChannelPointsCustomRewardRemoveEvent {
+	return b.event
+}
+
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.channel_points_custom_reward.remove fixture carrying the built event.
+func (b *ChannelPointsCustomRewardRemoveEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.channel_points_custom_reward.remove", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPointsCustomRewardRedemptionAddEventBuilder builds channel.channel_points_custom_reward_redemption.add events starting from the v1 fixture.
+type ChannelPointsCustomRewardRedemptionAddEventBuilder struct {
+	event twitcheventsub.ChannelPointsCustomRewardRedemptionAddEvent
+}
+
+// NewChannelPointsCustomRewardRedemptionAddEvent returns a builder holding the channel.channel_points_custom_reward_redemption.add fixture event.
+func NewChannelPointsCustomRewardRedemptionAddEvent() *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b := &ChannelPointsCustomRewardRedemptionAddEventBuilder{}
+	mustDecode("channel.channel_points_custom_reward_redemption.add", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithID(v string) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithBroadcasterUserID(v string) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithBroadcasterUserName(v string) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithUserID(v string) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithUserLogin(v string) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithUserName(v string) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithUserInput(v string) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.UserInput = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithStatus(v string) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.Status = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithReward(v twitcheventsub.Reward) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.Reward = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) WithRedeemedAt(v time.Time) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	b.event.RedeemedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) With(f func(e *twitcheventsub.ChannelPointsCustomRewardRedemptionAddEvent)) *ChannelPointsCustomRewardRedemptionAddEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) Build() twitcheventsub.ChannelPointsCustomRewardRedemptionAddEvent {
+	return b.event
+}
+
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.channel_points_custom_reward_redemption.add fixture carrying the built event.
+func (b *ChannelPointsCustomRewardRedemptionAddEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.channel_points_custom_reward_redemption.add", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPointsCustomRewardRedemptionUpdateEventBuilder builds channel.channel_points_custom_reward_redemption.update events starting from the v1 fixture.
+type ChannelPointsCustomRewardRedemptionUpdateEventBuilder struct {
+	event twitcheventsub.ChannelPointsCustomRewardRedemptionUpdateEvent
+}
+
+// NewChannelPointsCustomRewardRedemptionUpdateEvent returns a builder holding the channel.channel_points_custom_reward_redemption.update fixture event.
+func NewChannelPointsCustomRewardRedemptionUpdateEvent() *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b := &ChannelPointsCustomRewardRedemptionUpdateEventBuilder{}
+	mustDecode("channel.channel_points_custom_reward_redemption.update", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithID(v string) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithBroadcasterUserID(v string) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithBroadcasterUserName(v string) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithUserID(v string) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithUserLogin(v string) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithUserName(v string) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithUserInput(v string) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.UserInput = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithStatus(v string) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.Status = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithReward(v twitcheventsub.Reward) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.Reward = v
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) WithRedeemedAt(v time.Time) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	b.event.RedeemedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) With(f func(e *twitcheventsub.ChannelPointsCustomRewardRedemptionUpdateEvent)) *ChannelPointsCustomRewardRedemptionUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) Build() twitcheventsub.ChannelPointsCustomRewardRedemptionUpdateEvent {
+	return b.event
+}
+
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.channel_points_custom_reward_redemption.update fixture carrying the built event.
+func (b *ChannelPointsCustomRewardRedemptionUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.channel_points_custom_reward_redemption.update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPollBeginEventBuilder builds channel.poll.begin events starting from the v1 fixture.
+type ChannelPollBeginEventBuilder struct {
+	event twitcheventsub.ChannelPollBeginEvent
+}
+
+// NewChannelPollBeginEvent returns a builder holding the channel.poll.begin fixture event.
+func NewChannelPollBeginEvent() *ChannelPollBeginEventBuilder {
+	b := &ChannelPollBeginEventBuilder{}
+	mustDecode("channel.poll.begin", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPollBeginEventBuilder) WithID(v string) *ChannelPollBeginEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPollBeginEventBuilder) WithBroadcasterUserID(v string) *ChannelPollBeginEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPollBeginEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPollBeginEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPollBeginEventBuilder) WithBroadcasterUserName(v string) *ChannelPollBeginEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPollBeginEventBuilder) WithTitle(v string) *ChannelPollBeginEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelPollBeginEventBuilder) WithChoices(v []twitcheventsub.Choices) *ChannelPollBeginEventBuilder {
+	b.event.Choices = v
+	return b
+}
+
+func (b *ChannelPollBeginEventBuilder) WithChannelPointsVoting(v twitcheventsub.ChannelPointsVoting) *ChannelPollBeginEventBuilder {
+	b.event.ChannelPointsVoting = v
+	return b
+}
+
+func (b *ChannelPollBeginEventBuilder) WithStartedAt(v time.Time) *ChannelPollBeginEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelPollBeginEventBuilder) WithEndsAt(v time.Time) *ChannelPollBeginEventBuilder {
+	b.event.EndsAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPollBeginEventBuilder) With(f func(e *twitcheventsub.ChannelPollBeginEvent)) *ChannelPollBeginEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPollBeginEventBuilder) Build() twitcheventsub.ChannelPollBeginEvent {
+	return b.event
+}
+
+func (b *ChannelPollBeginEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.poll.begin fixture carrying the built event.
+func (b *ChannelPollBeginEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.poll.begin", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPollProgressEventBuilder builds channel.poll.progress events starting from the v1 fixture.
+type ChannelPollProgressEventBuilder struct {
+	event twitcheventsub.ChannelPollProgressEvent
+}
+
+// NewChannelPollProgressEvent returns a builder holding the channel.poll.progress fixture event.
+func NewChannelPollProgressEvent() *ChannelPollProgressEventBuilder {
+	b := &ChannelPollProgressEventBuilder{}
+	mustDecode("channel.poll.progress", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPollProgressEventBuilder) WithID(v string) *ChannelPollProgressEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPollProgressEventBuilder) WithBroadcasterUserID(v string) *ChannelPollProgressEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPollProgressEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPollProgressEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPollProgressEventBuilder) WithBroadcasterUserName(v string) *ChannelPollProgressEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPollProgressEventBuilder) WithTitle(v string) *ChannelPollProgressEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelPollProgressEventBuilder) WithChoices(v []twitcheventsub.Choices) *ChannelPollProgressEventBuilder {
+	b.event.Choices = v
+	return b
+}
+
+func (b *ChannelPollProgressEventBuilder) WithChannelPointsVoting(v twitcheventsub.ChannelPointsVoting) *ChannelPollProgressEventBuilder {
+	b.event.ChannelPointsVoting = v
+	return b
+}
+
+func (b *ChannelPollProgressEventBuilder) WithStartedAt(v time.Time) *ChannelPollProgressEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelPollProgressEventBuilder) WithEndsAt(v time.Time) *ChannelPollProgressEventBuilder {
+	b.event.EndsAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPollProgressEventBuilder) With(f func(e *twitcheventsub.ChannelPollProgressEvent)) *ChannelPollProgressEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPollProgressEventBuilder) Build() twitcheventsub.ChannelPollProgressEvent {
+	return b.event
+}
+
+func (b *ChannelPollProgressEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.poll.progress fixture carrying the built event.
+func (b *ChannelPollProgressEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.poll.progress", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPollEndEventBuilder builds channel.poll.end events starting from the v1 fixture.
+type ChannelPollEndEventBuilder struct {
+	event twitcheventsub.ChannelPollEndEvent
+}
+
+// NewChannelPollEndEvent returns a builder holding the channel.poll.end fixture event.
+func NewChannelPollEndEvent() *ChannelPollEndEventBuilder {
+	b := &ChannelPollEndEventBuilder{}
+	mustDecode("channel.poll.end", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) WithID(v string) *ChannelPollEndEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) WithBroadcasterUserID(v string) *ChannelPollEndEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPollEndEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) WithBroadcasterUserName(v string) *ChannelPollEndEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) WithTitle(v string) *ChannelPollEndEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) WithChoices(v []twitcheventsub.Choices) *ChannelPollEndEventBuilder {
+	b.event.Choices = v
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) WithChannelPointsVoting(v twitcheventsub.ChannelPointsVoting) *ChannelPollEndEventBuilder {
+	b.event.ChannelPointsVoting = v
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) WithStatus(v string) *ChannelPollEndEventBuilder {
+	b.event.Status = v
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) WithStartedAt(v time.Time) *ChannelPollEndEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) WithEndedAt(v time.Time) *ChannelPollEndEventBuilder {
+	b.event.EndedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPollEndEventBuilder) With(f func(e *twitcheventsub.ChannelPollEndEvent)) *ChannelPollEndEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPollEndEventBuilder) Build() twitcheventsub.ChannelPollEndEvent {
+	return b.event
+}
+
+func (b *ChannelPollEndEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.poll.end fixture carrying the built event.
+func (b *ChannelPollEndEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.poll.end", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPredictionBeginEventBuilder builds channel.prediction.begin events starting from the v1 fixture.
+type ChannelPredictionBeginEventBuilder struct {
+	event twitcheventsub.ChannelPredictionBeginEvent
+}
+
+// NewChannelPredictionBeginEvent returns a builder holding the channel.prediction.begin fixture event.
+func NewChannelPredictionBeginEvent() *ChannelPredictionBeginEventBuilder {
+	b := &ChannelPredictionBeginEventBuilder{}
+	mustDecode("channel.prediction.begin", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPredictionBeginEventBuilder) WithID(v string) *ChannelPredictionBeginEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPredictionBeginEventBuilder) WithBroadcasterUserID(v string) *ChannelPredictionBeginEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPredictionBeginEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPredictionBeginEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPredictionBeginEventBuilder) WithBroadcasterUserName(v string) *ChannelPredictionBeginEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPredictionBeginEventBuilder) WithTitle(v string) *ChannelPredictionBeginEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelPredictionBeginEventBuilder) WithOutcomes(v []twitcheventsub.Outcomes) *ChannelPredictionBeginEventBuilder {
+	b.event.Outcomes = v
+	return b
+}
+
+func (b *ChannelPredictionBeginEventBuilder) WithStartedAt(v time.Time) *ChannelPredictionBeginEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelPredictionBeginEventBuilder) WithLocksAt(v time.Time) *ChannelPredictionBeginEventBuilder {
+	b.event.LocksAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPredictionBeginEventBuilder) With(f func(e *twitcheventsub.ChannelPredictionBeginEvent)) *ChannelPredictionBeginEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPredictionBeginEventBuilder) Build() twitcheventsub.ChannelPredictionBeginEvent {
+	return b.event
+}
+
+func (b *ChannelPredictionBeginEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.prediction.begin fixture carrying the built event.
+func (b *ChannelPredictionBeginEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.prediction.begin", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPredictionProgressEventBuilder builds channel.prediction.progress events starting from the v1 fixture.
+type ChannelPredictionProgressEventBuilder struct {
+	event twitcheventsub.ChannelPredictionProgressEvent
+}
+
+// NewChannelPredictionProgressEvent returns a builder holding the channel.prediction.progress fixture event.
+func NewChannelPredictionProgressEvent() *ChannelPredictionProgressEventBuilder {
+	b := &ChannelPredictionProgressEventBuilder{}
+	mustDecode("channel.prediction.progress", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPredictionProgressEventBuilder) WithID(v string) *ChannelPredictionProgressEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPredictionProgressEventBuilder) WithBroadcasterUserID(v string) *ChannelPredictionProgressEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPredictionProgressEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPredictionProgressEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPredictionProgressEventBuilder) WithBroadcasterUserName(v string) *ChannelPredictionProgressEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPredictionProgressEventBuilder) WithTitle(v string) *ChannelPredictionProgressEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelPredictionProgressEventBuilder) WithOutcomes(v []twitcheventsub.Outcomes) *ChannelPredictionProgressEventBuilder {
+	b.event.Outcomes = v
+	return b
+}
+
+func (b *ChannelPredictionProgressEventBuilder) WithStartedAt(v time.Time) *ChannelPredictionProgressEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelPredictionProgressEventBuilder) WithLocksAt(v time.Time) *ChannelPredictionProgressEventBuilder {
+	b.event.LocksAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPredictionProgressEventBuilder) With(f func(e *twitcheventsub.ChannelPredictionProgressEvent)) *ChannelPredictionProgressEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPredictionProgressEventBuilder) Build() twitcheventsub.ChannelPredictionProgressEvent {
+	return b.event
+}
+
+func (b *ChannelPredictionProgressEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.prediction.progress fixture carrying the built event.
+func (b *ChannelPredictionProgressEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.prediction.progress", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPredictionLockEventBuilder builds channel.prediction.lock events starting from the v1 fixture.
+type ChannelPredictionLockEventBuilder struct {
+	event twitcheventsub.ChannelPredictionLockEvent
+}
+
+// NewChannelPredictionLockEvent returns a builder holding the channel.prediction.lock fixture event.
+func NewChannelPredictionLockEvent() *ChannelPredictionLockEventBuilder {
+	b := &ChannelPredictionLockEventBuilder{}
+	mustDecode("channel.prediction.lock", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPredictionLockEventBuilder) WithID(v string) *ChannelPredictionLockEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPredictionLockEventBuilder) WithBroadcasterUserID(v string) *ChannelPredictionLockEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPredictionLockEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPredictionLockEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPredictionLockEventBuilder) WithBroadcasterUserName(v string) *ChannelPredictionLockEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPredictionLockEventBuilder) WithTitle(v string) *ChannelPredictionLockEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelPredictionLockEventBuilder) WithOutcomes(v []twitcheventsub.Outcomes) *ChannelPredictionLockEventBuilder {
+	b.event.Outcomes = v
+	return b
+}
+
+func (b *ChannelPredictionLockEventBuilder) WithStartedAt(v time.Time) *ChannelPredictionLockEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelPredictionLockEventBuilder) WithLockedAt(v time.Time) *ChannelPredictionLockEventBuilder {
+	b.event.LockedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPredictionLockEventBuilder) With(f func(e *twitcheventsub.ChannelPredictionLockEvent)) *ChannelPredictionLockEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPredictionLockEventBuilder) Build() twitcheventsub.ChannelPredictionLockEvent {
+	return b.event
+}
+
+func (b *ChannelPredictionLockEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.prediction.lock fixture carrying the built event.
+func (b *ChannelPredictionLockEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.prediction.lock", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelPredictionEndEventBuilder builds channel.prediction.end events starting from the v1 fixture.
+type ChannelPredictionEndEventBuilder struct {
+	event twitcheventsub.ChannelPredictionEndEvent
+}
+
+// NewChannelPredictionEndEvent returns a builder holding the channel.prediction.end fixture event.
+func NewChannelPredictionEndEvent() *ChannelPredictionEndEventBuilder {
+	b := &ChannelPredictionEndEventBuilder{}
+	mustDecode("channel.prediction.end", "1", &b.event)
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) WithID(v string) *ChannelPredictionEndEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) WithBroadcasterUserID(v string) *ChannelPredictionEndEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) WithBroadcasterUserLogin(v string) *ChannelPredictionEndEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) WithBroadcasterUserName(v string) *ChannelPredictionEndEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) WithTitle(v string) *ChannelPredictionEndEventBuilder {
+	b.event.Title = v
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) WithWinningOutcomeID(v string) *ChannelPredictionEndEventBuilder {
+	b.event.WinningOutcomeID = v
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) WithOutcomes(v []twitcheventsub.Outcomes) *ChannelPredictionEndEventBuilder {
+	b.event.Outcomes = v
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) WithStatus(v string) *ChannelPredictionEndEventBuilder {
+	b.event.Status = v
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) WithStartedAt(v time.Time) *ChannelPredictionEndEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) WithEndedAt(v time.Time) *ChannelPredictionEndEventBuilder {
+	b.event.EndedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelPredictionEndEventBuilder) With(f func(e *twitcheventsub.ChannelPredictionEndEvent)) *ChannelPredictionEndEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelPredictionEndEventBuilder) Build() twitcheventsub.ChannelPredictionEndEvent {
+	return b.event
+}
+
+func (b *ChannelPredictionEndEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.prediction.end fixture carrying the built event.
+func (b *ChannelPredictionEndEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.prediction.end", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelVIPAddEventBuilder builds channel.vip.add events starting from the v1 fixture.
+type ChannelVIPAddEventBuilder struct {
+	event twitcheventsub.ChannelVIPAddEvent
+}
+
+// NewChannelVIPAddEvent returns a builder holding the channel.vip.add fixture event.
+func NewChannelVIPAddEvent() *ChannelVIPAddEventBuilder {
+	b := &ChannelVIPAddEventBuilder{}
+	mustDecode("channel.vip.add", "1", &b.event)
+	return b
+}
+
+func (b *ChannelVIPAddEventBuilder) WithUserID(v string) *ChannelVIPAddEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelVIPAddEventBuilder) WithUserLogin(v string) *ChannelVIPAddEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelVIPAddEventBuilder) WithUserName(v string) *ChannelVIPAddEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelVIPAddEventBuilder) WithBroadcasterUserID(v string) *ChannelVIPAddEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelVIPAddEventBuilder) WithBroadcasterUserLogin(v string) *ChannelVIPAddEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelVIPAddEventBuilder) WithBroadcasterUserName(v string) *ChannelVIPAddEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelVIPAddEventBuilder) With(f func(e *twitcheventsub.ChannelVIPAddEvent)) *ChannelVIPAddEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelVIPAddEventBuilder) Build() twitcheventsub.ChannelVIPAddEvent {
+	return b.event
+}
+
+func (b *ChannelVIPAddEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.vip.add fixture carrying the built event.
+func (b *ChannelVIPAddEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.vip.add", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelVIPRemoveEventBuilder builds channel.vip.remove events starting from the v1 fixture.
+type ChannelVIPRemoveEventBuilder struct {
+	event twitcheventsub.ChannelVIPRemoveEvent
+}
+
+// NewChannelVIPRemoveEvent returns a builder holding the channel.vip.remove fixture event.
+func NewChannelVIPRemoveEvent() *ChannelVIPRemoveEventBuilder {
+	b := &ChannelVIPRemoveEventBuilder{}
+	mustDecode("channel.vip.remove", "1", &b.event)
+	return b
+}
+
+func (b *ChannelVIPRemoveEventBuilder) WithUserID(v string) *ChannelVIPRemoveEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelVIPRemoveEventBuilder) WithUserLogin(v string) *ChannelVIPRemoveEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelVIPRemoveEventBuilder) WithUserName(v string) *ChannelVIPRemoveEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelVIPRemoveEventBuilder) WithBroadcasterUserID(v string) *ChannelVIPRemoveEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelVIPRemoveEventBuilder) WithBroadcasterUserLogin(v string) *ChannelVIPRemoveEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelVIPRemoveEventBuilder) WithBroadcasterUserName(v string) *ChannelVIPRemoveEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelVIPRemoveEventBuilder) With(f func(e *twitcheventsub.ChannelVIPRemoveEvent)) *ChannelVIPRemoveEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelVIPRemoveEventBuilder) Build() twitcheventsub.ChannelVIPRemoveEvent {
+	return b.event
+}
+
+func (b *ChannelVIPRemoveEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.vip.remove fixture carrying the built event.
+func (b *ChannelVIPRemoveEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.vip.remove", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// CharityCampaignDonateEventBuilder builds channel.charity_campaign.donate events starting from the v1 fixture.
+type CharityCampaignDonateEventBuilder struct {
+	event twitcheventsub.CharityCampaignDonateEvent
+}
+
+// NewCharityCampaignDonateEvent returns a builder holding the channel.charity_campaign.donate fixture event.
+func NewCharityCampaignDonateEvent() *CharityCampaignDonateEventBuilder {
+	b := &CharityCampaignDonateEventBuilder{}
+	mustDecode("channel.charity_campaign.donate", "1", &b.event)
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithID(v string) *CharityCampaignDonateEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithCampaignID(v string) *CharityCampaignDonateEventBuilder {
+	b.event.CampaignID = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithBroadcasterUserID(v string) *CharityCampaignDonateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithBroadcasterUserName(v string) *CharityCampaignDonateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithBroadcasterUserLogin(v string) *CharityCampaignDonateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithUserID(v string) *CharityCampaignDonateEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithUserLogin(v string) *CharityCampaignDonateEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithUserName(v string) *CharityCampaignDonateEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithCharityName(v string) *CharityCampaignDonateEventBuilder {
+	b.event.CharityName = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithCharityDescription(v string) *CharityCampaignDonateEventBuilder {
+	b.event.CharityDescription = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithCharityLogo(v string) *CharityCampaignDonateEventBuilder {
+	b.event.CharityLogo = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithCharityWebsite(v string) *CharityCampaignDonateEventBuilder {
+	b.event.CharityWebsite = v
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) WithAmount(v twitcheventsub.Amount) *CharityCampaignDonateEventBuilder {
+	b.event.Amount = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *CharityCampaignDonateEventBuilder) With(f func(e *twitcheventsub.CharityCampaignDonateEvent)) *CharityCampaignDonateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *CharityCampaignDonateEventBuilder) Build() twitcheventsub.CharityCampaignDonateEvent {
+	return b.event
+}
+
+func (b *CharityCampaignDonateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.charity_campaign.donate fixture carrying the built event.
+func (b *CharityCampaignDonateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.charity_campaign.donate", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// CharityCampaignStartEventBuilder builds channel.charity_campaign.start events starting from the v1 fixture.
+type CharityCampaignStartEventBuilder struct {
+	event twitcheventsub.CharityCampaignStartEvent
+}
+
+// NewCharityCampaignStartEvent returns a builder holding the channel.charity_campaign.start fixture event.
+func NewCharityCampaignStartEvent() *CharityCampaignStartEventBuilder {
+	b := &CharityCampaignStartEventBuilder{}
+	mustDecode("channel.charity_campaign.start", "1", &b.event)
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithID(v string) *CharityCampaignStartEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithBroadcasterID(v string) *CharityCampaignStartEventBuilder {
+	b.event.BroadcasterID = v
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithBroadcasterName(v string) *CharityCampaignStartEventBuilder {
+	b.event.BroadcasterName = v
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithBroadcasterLogin(v string) *CharityCampaignStartEventBuilder {
+	b.event.BroadcasterLogin = v
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithCharityName(v string) *CharityCampaignStartEventBuilder {
+	b.event.CharityName = v
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithCharityDescription(v string) *CharityCampaignStartEventBuilder {
+	b.event.CharityDescription = v
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithCharityLogo(v string) *CharityCampaignStartEventBuilder {
+	b.event.CharityLogo = v
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithCharityWebsite(v string) *CharityCampaignStartEventBuilder {
+	b.event.CharityWebsite = v
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithCurrentAmount(v twitcheventsub.Amount) *CharityCampaignStartEventBuilder {
+	b.event.CurrentAmount = v
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithTargetAmount(v twitcheventsub.Amount) *CharityCampaignStartEventBuilder {
+	b.event.TargetAmount = v
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) WithStartedAt(v time.Time) *CharityCampaignStartEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *CharityCampaignStartEventBuilder) With(f func(e *twitcheventsub.CharityCampaignStartEvent)) *CharityCampaignStartEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *CharityCampaignStartEventBuilder) Build() twitcheventsub.CharityCampaignStartEvent {
+	return b.event
+}
+
+func (b *CharityCampaignStartEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.charity_campaign.start fixture carrying the built event.
+func (b *CharityCampaignStartEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.charity_campaign.start", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// CharityCampaignProgressEventBuilder builds channel.charity_campaign.progress events starting from the v1 fixture.
+type CharityCampaignProgressEventBuilder struct {
+	event twitcheventsub.CharityCampaignProgressEvent
+}
+
+// NewCharityCampaignProgressEvent returns a builder holding the channel.charity_campaign.progress fixture event.
+func NewCharityCampaignProgressEvent() *CharityCampaignProgressEventBuilder {
+	b := &CharityCampaignProgressEventBuilder{}
+	mustDecode("channel.charity_campaign.progress", "1", &b.event)
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) WithID(v string) *CharityCampaignProgressEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) WithBroadcasterID(v string) *CharityCampaignProgressEventBuilder {
+	b.event.BroadcasterID = v
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) WithBroadcasterName(v string) *CharityCampaignProgressEventBuilder {
+	b.event.BroadcasterName = v
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) WithBroadcasterLogin(v string) *CharityCampaignProgressEventBuilder {
+	b.event.BroadcasterLogin = v
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) WithCharityName(v string) *CharityCampaignProgressEventBuilder {
+	b.event.CharityName = v
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) WithCharityDescription(v string) *CharityCampaignProgressEventBuilder {
+	b.event.CharityDescription = v
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) WithCharityLogo(v string) *CharityCampaignProgressEventBuilder {
+	b.event.CharityLogo = v
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) WithCharityWebsite(v string) *CharityCampaignProgressEventBuilder {
+	b.event.CharityWebsite = v
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) WithCurrentAmount(v twitcheventsub.Amount) *CharityCampaignProgressEventBuilder {
+	b.event.CurrentAmount = v
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) WithTargetAmount(v twitcheventsub.Amount) *CharityCampaignProgressEventBuilder {
+	b.event.TargetAmount = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *CharityCampaignProgressEventBuilder) With(f func(e *twitcheventsub.CharityCampaignProgressEvent)) *CharityCampaignProgressEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *CharityCampaignProgressEventBuilder) Build() twitcheventsub.CharityCampaignProgressEvent {
+	return b.event
+}
+
+func (b *CharityCampaignProgressEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.charity_campaign.progress fixture carrying the built event.
+func (b *CharityCampaignProgressEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.charity_campaign.progress", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// CharityCampaignStopEventBuilder builds channel.charity_campaign.stop events starting from the v1 fixture.
+type CharityCampaignStopEventBuilder struct {
+	event twitcheventsub.CharityCampaignStopEvent
+}
+
+// NewCharityCampaignStopEvent returns a builder holding the channel.charity_campaign.stop fixture event.
+func NewCharityCampaignStopEvent() *CharityCampaignStopEventBuilder {
+	b := &CharityCampaignStopEventBuilder{}
+	mustDecode("channel.charity_campaign.stop", "1", &b.event)
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithID(v string) *CharityCampaignStopEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithBroadcasterID(v string) *CharityCampaignStopEventBuilder {
+	b.event.BroadcasterID = v
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithBroadcasterName(v string) *CharityCampaignStopEventBuilder {
+	b.event.BroadcasterName = v
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithBroadcasterLogin(v string) *CharityCampaignStopEventBuilder {
+	b.event.BroadcasterLogin = v
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithCharityName(v string) *CharityCampaignStopEventBuilder {
+	b.event.CharityName = v
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithCharityDescription(v string) *CharityCampaignStopEventBuilder {
+	b.event.CharityDescription = v
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithCharityLogo(v string) *CharityCampaignStopEventBuilder {
+	b.event.CharityLogo = v
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithCharityWebsite(v string) *CharityCampaignStopEventBuilder {
+	b.event.CharityWebsite = v
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithCurrentAmount(v twitcheventsub.Amount) *CharityCampaignStopEventBuilder {
+	b.event.CurrentAmount = v
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithTargetAmount(v twitcheventsub.Amount) *CharityCampaignStopEventBuilder {
+	b.event.TargetAmount = v
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) WithStoppedAt(v time.Time) *CharityCampaignStopEventBuilder {
+	b.event.StoppedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *CharityCampaignStopEventBuilder) With(f func(e *twitcheventsub.CharityCampaignStopEvent)) *CharityCampaignStopEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *CharityCampaignStopEventBuilder) Build() twitcheventsub.CharityCampaignStopEvent {
+	return b.event
+}
+
+func (b *CharityCampaignStopEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.charity_campaign.stop fixture carrying the built event.
+func (b *CharityCampaignStopEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.charity_campaign.stop", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ConduitShardDisabledEventBuilder builds conduit.shard.disabled events starting from the v1 fixture.
+type ConduitShardDisabledEventBuilder struct {
+	event twitcheventsub.ConduitShardDisabledEvent
+}
+
+// NewConduitShardDisabledEvent returns a builder holding the conduit.shard.disabled fixture event.
+func NewConduitShardDisabledEvent() *ConduitShardDisabledEventBuilder {
+	b := &ConduitShardDisabledEventBuilder{}
+	mustDecode("conduit.shard.disabled", "1", &b.event)
+	return b
+}
+
+func (b *ConduitShardDisabledEventBuilder) WithConduitID(v string) *ConduitShardDisabledEventBuilder {
+	b.event.ConduitID = v
+	return b
+}
+
+func (b *ConduitShardDisabledEventBuilder) WithShardID(v string) *ConduitShardDisabledEventBuilder {
+	b.event.ShardID = v
+	return b
+}
+
+func (b *ConduitShardDisabledEventBuilder) WithStatus(v string) *ConduitShardDisabledEventBuilder {
+	b.event.Status = v
+	return b
+}
+
+func (b *ConduitShardDisabledEventBuilder) WithTransport(v twitcheventsub.ConduitTransport) *ConduitShardDisabledEventBuilder {
+	b.event.Transport = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ConduitShardDisabledEventBuilder) With(f func(e *twitcheventsub.ConduitShardDisabledEvent)) *ConduitShardDisabledEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ConduitShardDisabledEventBuilder) Build() twitcheventsub.ConduitShardDisabledEvent {
+	return b.event
+}
+
+func (b *ConduitShardDisabledEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the conduit.shard.disabled fixture carrying the built event.
+func (b *ConduitShardDisabledEventBuilder) Fixture() Fixture {
+	f := mustLoad("conduit.shard.disabled", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// DropEntitlementGrantEventBuilder builds drop.entitlement.grant events starting from the v1 fixture.
+type DropEntitlementGrantEventBuilder struct {
+	event twitcheventsub.DropEntitlementGrantEvent
+}
+
+// NewDropEntitlementGrantEvent returns a builder holding the drop.entitlement.grant fixture event.
+func NewDropEntitlementGrantEvent() *DropEntitlementGrantEventBuilder {
+	b := &DropEntitlementGrantEventBuilder{}
+	mustDecode("drop.entitlement.grant", "1", &b.event)
+	return b
+}
+
+func (b *DropEntitlementGrantEventBuilder) WithID(v string) *DropEntitlementGrantEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *DropEntitlementGrantEventBuilder) WithData(v twitcheventsub.DropData) *DropEntitlementGrantEventBuilder {
+	b.event.Data = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *DropEntitlementGrantEventBuilder) With(f func(e *twitcheventsub.DropEntitlementGrantEvent)) *DropEntitlementGrantEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *DropEntitlementGrantEventBuilder) Build() twitcheventsub.DropEntitlementGrantEvent {
+	return b.event
+}
+
+func (b *DropEntitlementGrantEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the drop.entitlement.grant fixture with a batch holding only the built event.
+func (b *DropEntitlementGrantEventBuilder) Fixture() Fixture {
+	f := mustLoad("drop.entitlement.grant", "1")
+	f.Events, _ = json.Marshal([]twitcheventsub.DropEntitlementGrantEvent{b.event})
+	return f
+}
+
+// ExtensionBitsTransactionCreateEventBuilder builds extension.bits_transaction.create events starting from the v1 fixture.
+type ExtensionBitsTransactionCreateEventBuilder struct {
+	event twitcheventsub.ExtensionBitsTransactionCreateEvent
+}
+
+// NewExtensionBitsTransactionCreateEvent returns a builder holding the extension.bits_transaction.create fixture event.
+func NewExtensionBitsTransactionCreateEvent() *ExtensionBitsTransactionCreateEventBuilder {
+	b := &ExtensionBitsTransactionCreateEventBuilder{}
+	mustDecode("extension.bits_transaction.create", "1", &b.event)
+	return b
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) WithID(v string) *ExtensionBitsTransactionCreateEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) WithExtensionClientID(v string) *ExtensionBitsTransactionCreateEventBuilder {
+	b.event.ExtensionClientID = v
+	return b
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) WithBroadcasterUserID(v string) *ExtensionBitsTransactionCreateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) WithBroadcasterUserLogin(v string) *ExtensionBitsTransactionCreateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) WithBroadcasterUserName(v string) *ExtensionBitsTransactionCreateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) WithUserName(v string) *ExtensionBitsTransactionCreateEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) WithUserLogin(v string) *ExtensionBitsTransactionCreateEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) WithUserID(v string) *ExtensionBitsTransactionCreateEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) WithProduct(v twitcheventsub.Product) *ExtensionBitsTransactionCreateEventBuilder {
+	b.event.Product = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ExtensionBitsTransactionCreateEventBuilder) With(f func(e *twitcheventsub.ExtensionBitsTransactionCreateEvent)) *ExtensionBitsTransactionCreateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) Build() twitcheventsub.ExtensionBitsTransactionCreateEvent {
+	return b.event
+}
+
+func (b *ExtensionBitsTransactionCreateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the extension.bits_transaction.create fixture carrying the built event.
+func (b *ExtensionBitsTransactionCreateEventBuilder) Fixture() Fixture {
+	f := mustLoad("extension.bits_transaction.create", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelGoalBeginEventBuilder builds channel.goal.begin events starting from the v1 fixture.
+type ChannelGoalBeginEventBuilder struct {
+	event twitcheventsub.ChannelGoalBeginEvent
+}
+
+// NewChannelGoalBeginEvent returns a builder holding the channel.goal.begin fixture event.
+func NewChannelGoalBeginEvent() *ChannelGoalBeginEventBuilder {
+	b := &ChannelGoalBeginEventBuilder{}
+	mustDecode("channel.goal.begin", "1", &b.event)
+	return b
+}
+
+func (b *ChannelGoalBeginEventBuilder) WithID(v string) *ChannelGoalBeginEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelGoalBeginEventBuilder) WithBroadcasterUserID(v string) *ChannelGoalBeginEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelGoalBeginEventBuilder) WithBroadcasterUserName(v string) *ChannelGoalBeginEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelGoalBeginEventBuilder) WithBroadcasterUserLogin(v string) *ChannelGoalBeginEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelGoalBeginEventBuilder) WithType(v string) *ChannelGoalBeginEventBuilder {
+	b.event.Type = v
+	return b
+}
+
+func (b *ChannelGoalBeginEventBuilder) WithDescription(v string) *ChannelGoalBeginEventBuilder {
+	b.event.Description = v
+	return b
+}
+
+func (b *ChannelGoalBeginEventBuilder) WithCurrentAmount(v int) *ChannelGoalBeginEventBuilder {
+	b.event.CurrentAmount = v
+	return b
+}
+
+func (b *ChannelGoalBeginEventBuilder) WithTargetAmount(v int) *ChannelGoalBeginEventBuilder {
+	b.event.TargetAmount = v
+	return b
+}
+
+func (b *ChannelGoalBeginEventBuilder) WithStartedAt(v time.Time) *ChannelGoalBeginEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelGoalBeginEventBuilder) With(f func(e *twitcheventsub.ChannelGoalBeginEvent)) *ChannelGoalBeginEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelGoalBeginEventBuilder) Build() twitcheventsub.ChannelGoalBeginEvent {
+	return b.event
+}
+
+func (b *ChannelGoalBeginEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.goal.begin fixture carrying the built event.
+func (b *ChannelGoalBeginEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.goal.begin", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelGoalProgressEventBuilder builds channel.goal.progress events starting from the v1 fixture.
+type ChannelGoalProgressEventBuilder struct {
+	event twitcheventsub.ChannelGoalProgressEvent
+}
+
+// NewChannelGoalProgressEvent returns a builder holding the channel.goal.progress fixture event.
+func NewChannelGoalProgressEvent() *ChannelGoalProgressEventBuilder {
+	b := &ChannelGoalProgressEventBuilder{}
+	mustDecode("channel.goal.progress", "1", &b.event)
+	return b
+}
+
+func (b *ChannelGoalProgressEventBuilder) WithID(v string) *ChannelGoalProgressEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelGoalProgressEventBuilder) WithBroadcasterUserID(v string) *ChannelGoalProgressEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelGoalProgressEventBuilder) WithBroadcasterUserName(v string) *ChannelGoalProgressEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelGoalProgressEventBuilder) WithBroadcasterUserLogin(v string) *ChannelGoalProgressEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelGoalProgressEventBuilder) WithType(v string) *ChannelGoalProgressEventBuilder {
+	b.event.Type = v
+	return b
+}
+
+func (b *ChannelGoalProgressEventBuilder) WithDescription(v string) *ChannelGoalProgressEventBuilder {
+	b.event.Description = v
+	return b
+}
+
+func (b *ChannelGoalProgressEventBuilder) WithCurrentAmount(v int) *ChannelGoalProgressEventBuilder {
+	b.event.CurrentAmount = v
+	return b
+}
+
+func (b *ChannelGoalProgressEventBuilder) WithTargetAmount(v int) *ChannelGoalProgressEventBuilder {
+	b.event.TargetAmount = v
+	return b
+}
+
+func (b *ChannelGoalProgressEventBuilder) WithStartedAt(v time.Time) *ChannelGoalProgressEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelGoalProgressEventBuilder) With(f func(e *twitcheventsub.ChannelGoalProgressEvent)) *ChannelGoalProgressEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelGoalProgressEventBuilder) Build() twitcheventsub.ChannelGoalProgressEvent {
+	return b.event
+}
+
+func (b *ChannelGoalProgressEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.goal.progress fixture carrying the built event.
+func (b *ChannelGoalProgressEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.goal.progress", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelGoalEndEventBuilder builds channel.goal.end events starting from the v1 fixture.
+type ChannelGoalEndEventBuilder struct {
+	event twitcheventsub.ChannelGoalEndEvent
+}
+
+// NewChannelGoalEndEvent returns a builder holding the channel.goal.end fixture event.
+func NewChannelGoalEndEvent() *ChannelGoalEndEventBuilder {
+	b := &ChannelGoalEndEventBuilder{}
+	mustDecode("channel.goal.end", "1", &b.event)
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithID(v string) *ChannelGoalEndEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithBroadcasterUserID(v string) *ChannelGoalEndEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithBroadcasterUserName(v string) *ChannelGoalEndEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithBroadcasterUserLogin(v string) *ChannelGoalEndEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithType(v string) *ChannelGoalEndEventBuilder {
+	b.event.Type = v
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithDescription(v string) *ChannelGoalEndEventBuilder {
+	b.event.Description = v
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithIsAchieved(v bool) *ChannelGoalEndEventBuilder {
+	b.event.IsAchieved = v
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithCurrentAmount(v int) *ChannelGoalEndEventBuilder {
+	b.event.CurrentAmount = v
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithTargetAmount(v int) *ChannelGoalEndEventBuilder {
+	b.event.TargetAmount = v
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithStartedAt(v time.Time) *ChannelGoalEndEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) WithEndedAt(v time.Time) *ChannelGoalEndEventBuilder {
+	b.event.EndedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelGoalEndEventBuilder) With(f func(e *twitcheventsub.ChannelGoalEndEvent)) *ChannelGoalEndEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelGoalEndEventBuilder) Build() twitcheventsub.ChannelGoalEndEvent {
+	return b.event
+}
+
+func (b *ChannelGoalEndEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.goal.end fixture carrying the built event.
+func (b *ChannelGoalEndEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.goal.end", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelHypeTrainBeginEventV2Builder builds channel.hype_train.begin events starting from the v2 fixture.
+type ChannelHypeTrainBeginEventV2Builder struct {
+	event twitcheventsub.ChannelHypeTrainBeginEventV2
+}
+
+// NewChannelHypeTrainBeginEventV2 returns a builder holding the channel.hype_train.begin fixture event.
+func NewChannelHypeTrainBeginEventV2() *ChannelHypeTrainBeginEventV2Builder {
+	b := &ChannelHypeTrainBeginEventV2Builder{}
+	mustDecode("channel.hype_train.begin", "2", &b.event)
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithID(v string) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithBroadcasterUserID(v string) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithBroadcasterUserLogin(v string) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithBroadcasterUserName(v string) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithTotal(v int) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.Total = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithProgress(v int) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.Progress = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithGoal(v int) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.Goal = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithTopContributions(v []twitcheventsub.Contribution) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.TopContributions = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithLevel(v int) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.Level = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithAllTimeHighLevel(v int) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.AllTimeHighLevel = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithAllTimeHighTotal(v int) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.AllTimeHighTotal = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithSharedTrainParticipants(v []twitcheventsub.SharedTrainParticipant) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.SharedTrainParticipants = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithStartedAt(v time.Time) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithExpiresAt(v time.Time) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.ExpiresAt = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithType(v string) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.Type = v
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) WithIsSharedTrain(v bool) *ChannelHypeTrainBeginEventV2Builder {
+	b.event.IsSharedTrain = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelHypeTrainBeginEventV2Builder) With(f func(e *twitcheventsub.ChannelHypeTrainBeginEventV2)) *ChannelHypeTrainBeginEventV2Builder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) Build() twitcheventsub.ChannelHypeTrainBeginEventV2 {
+	return b.event
+}
+
+func (b *ChannelHypeTrainBeginEventV2Builder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.hype_train.begin fixture carrying the built event.
+func (b *ChannelHypeTrainBeginEventV2Builder) Fixture() Fixture {
+	f := mustLoad("channel.hype_train.begin", "2")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelHypeTrainProgressEventV2Builder builds channel.hype_train.progress events starting from the v2 fixture.
+type ChannelHypeTrainProgressEventV2Builder struct {
+	event twitcheventsub.ChannelHypeTrainProgressEventV2
+}
+
+// NewChannelHypeTrainProgressEventV2 returns a builder holding the channel.hype_train.progress fixture event.
+func NewChannelHypeTrainProgressEventV2() *ChannelHypeTrainProgressEventV2Builder {
+	b := &ChannelHypeTrainProgressEventV2Builder{}
+	mustDecode("channel.hype_train.progress", "2", &b.event)
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithID(v string) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithBroadcasterUserID(v string) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithBroadcasterUserLogin(v string) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithBroadcasterUserName(v string) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithTotal(v int) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.Total = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithProgress(v int) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.Progress = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithGoal(v int) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.Goal = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithTopContributions(v []twitcheventsub.Contribution) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.TopContributions = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithLevel(v int) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.Level = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithSharedTrainParticipants(v []twitcheventsub.SharedTrainParticipant) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.SharedTrainParticipants = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithStartedAt(v time.Time) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithExpiresAt(v time.Time) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.ExpiresAt = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithType(v string) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.Type = v
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) WithIsSharedTrain(v bool) *ChannelHypeTrainProgressEventV2Builder {
+	b.event.IsSharedTrain = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelHypeTrainProgressEventV2Builder) With(f func(e *twitcheventsub.ChannelHypeTrainProgressEventV2)) *ChannelHypeTrainProgressEventV2Builder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) Build() twitcheventsub.ChannelHypeTrainProgressEventV2 {
+	return b.event
+}
+
+func (b *ChannelHypeTrainProgressEventV2Builder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.hype_train.progress fixture carrying the built event.
+func (b *ChannelHypeTrainProgressEventV2Builder) Fixture() Fixture {
+	f := mustLoad("channel.hype_train.progress", "2")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelHypeTrainEndEventV2Builder builds channel.hype_train.end events starting from the v2 fixture.
+type ChannelHypeTrainEndEventV2Builder struct {
+	event twitcheventsub.ChannelHypeTrainEndEventV2
+}
+
+// NewChannelHypeTrainEndEventV2 returns a builder holding the channel.hype_train.end fixture event.
+func NewChannelHypeTrainEndEventV2() *ChannelHypeTrainEndEventV2Builder {
+	b := &ChannelHypeTrainEndEventV2Builder{}
+	mustDecode("channel.hype_train.end", "2", &b.event)
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithID(v string) *ChannelHypeTrainEndEventV2Builder {
+	b.event.ID = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithBroadcasterUserID(v string) *ChannelHypeTrainEndEventV2Builder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithBroadcasterUserLogin(v string) *ChannelHypeTrainEndEventV2Builder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithBroadcasterUserName(v string) *ChannelHypeTrainEndEventV2Builder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithTotal(v int) *ChannelHypeTrainEndEventV2Builder {
+	b.event.Total = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithTopContributions(v []twitcheventsub.Contribution) *ChannelHypeTrainEndEventV2Builder {
+	b.event.TopContributions = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithLevel(v int) *ChannelHypeTrainEndEventV2Builder {
+	b.event.Level = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithSharedTrainParticipants(v []twitcheventsub.SharedTrainParticipant) *ChannelHypeTrainEndEventV2Builder {
+	b.event.SharedTrainParticipants = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithStartedAt(v time.Time) *ChannelHypeTrainEndEventV2Builder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithCooldownEndsAt(v time.Time) *ChannelHypeTrainEndEventV2Builder {
+	b.event.CooldownEndsAt = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithEndedAt(v time.Time) *ChannelHypeTrainEndEventV2Builder {
+	b.event.EndedAt = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithType(v string) *ChannelHypeTrainEndEventV2Builder {
+	b.event.Type = v
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) WithIsSharedTrain(v bool) *ChannelHypeTrainEndEventV2Builder {
+	b.event.IsSharedTrain = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelHypeTrainEndEventV2Builder) With(f func(e *twitcheventsub.ChannelHypeTrainEndEventV2)) *ChannelHypeTrainEndEventV2Builder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) Build() twitcheventsub.ChannelHypeTrainEndEventV2 {
+	return b.event
+}
+
+func (b *ChannelHypeTrainEndEventV2Builder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.hype_train.end fixture carrying the built event.
+func (b *ChannelHypeTrainEndEventV2Builder) Fixture() Fixture {
+	f := mustLoad("channel.hype_train.end", "2")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelShieldModeBeginEventBuilder builds channel.shield_mode.begin events starting from the v1 fixture.
+type ChannelShieldModeBeginEventBuilder struct {
+	event twitcheventsub.ChannelShieldModeBeginEvent
+}
+
+// NewChannelShieldModeBeginEvent returns a builder holding the channel.shield_mode.begin fixture event.
+func NewChannelShieldModeBeginEvent() *ChannelShieldModeBeginEventBuilder {
+	b := &ChannelShieldModeBeginEventBuilder{}
+	mustDecode("channel.shield_mode.begin", "1", &b.event)
+	return b
+}
+
+func (b *ChannelShieldModeBeginEventBuilder) WithBroadcasterUserID(v string) *ChannelShieldModeBeginEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelShieldModeBeginEventBuilder) WithBroadcasterUserName(v string) *ChannelShieldModeBeginEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelShieldModeBeginEventBuilder) WithBroadcasterUserLogin(v string) *ChannelShieldModeBeginEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelShieldModeBeginEventBuilder) WithModeratorUserID(v string) *ChannelShieldModeBeginEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelShieldModeBeginEventBuilder) WithModeratorUserName(v string) *ChannelShieldModeBeginEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *ChannelShieldModeBeginEventBuilder) WithModeratorUserLogin(v string) *ChannelShieldModeBeginEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelShieldModeBeginEventBuilder) WithStartedAt(v time.Time) *ChannelShieldModeBeginEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelShieldModeBeginEventBuilder) With(f func(e *twitcheventsub.ChannelShieldModeBeginEvent)) *ChannelShieldModeBeginEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelShieldModeBeginEventBuilder) Build() twitcheventsub.ChannelShieldModeBeginEvent {
+	return b.event
+}
+
+func (b *ChannelShieldModeBeginEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.shield_mode.begin fixture carrying the built event.
+func (b *ChannelShieldModeBeginEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.shield_mode.begin", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelShieldModeEndEventBuilder builds channel.shield_mode.end events starting from the v1 fixture.
+type ChannelShieldModeEndEventBuilder struct {
+	event twitcheventsub.ChannelShieldModeEndEvent
+}
+
+// NewChannelShieldModeEndEvent returns a builder holding the channel.shield_mode.end fixture event.
+func NewChannelShieldModeEndEvent() *ChannelShieldModeEndEventBuilder {
+	b := &ChannelShieldModeEndEventBuilder{}
+	mustDecode("channel.shield_mode.end", "1", &b.event)
+	return b
+}
+
+func (b *ChannelShieldModeEndEventBuilder) WithBroadcasterUserID(v string) *ChannelShieldModeEndEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelShieldModeEndEventBuilder) WithBroadcasterUserName(v string) *ChannelShieldModeEndEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelShieldModeEndEventBuilder) WithBroadcasterUserLogin(v string) *ChannelShieldModeEndEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelShieldModeEndEventBuilder) WithModeratorUserID(v string) *ChannelShieldModeEndEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelShieldModeEndEventBuilder) WithModeratorUserName(v string) *ChannelShieldModeEndEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *ChannelShieldModeEndEventBuilder) WithModeratorUserLogin(v string) *ChannelShieldModeEndEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelShieldModeEndEventBuilder) WithEndedAt(v time.Time) *ChannelShieldModeEndEventBuilder {
+	b.event.EndedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelShieldModeEndEventBuilder) With(f func(e *twitcheventsub.ChannelShieldModeEndEvent)) *ChannelShieldModeEndEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelShieldModeEndEventBuilder) Build() twitcheventsub.ChannelShieldModeEndEvent {
+	return b.event
+}
+
+func (b *ChannelShieldModeEndEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.shield_mode.end fixture carrying the built event.
+func (b *ChannelShieldModeEndEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.shield_mode.end", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelShoutOutCreateEventBuilder builds channel.shoutout.create events starting from the v1 fixture.
+type ChannelShoutOutCreateEventBuilder struct {
+	event twitcheventsub.ChannelShoutOutCreateEvent
+}
+
+// NewChannelShoutOutCreateEvent returns a builder holding the channel.shoutout.create fixture event.
+func NewChannelShoutOutCreateEvent() *ChannelShoutOutCreateEventBuilder {
+	b := &ChannelShoutOutCreateEventBuilder{}
+	mustDecode("channel.shoutout.create", "1", &b.event)
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithBroadcasterUserID(v string) *ChannelShoutOutCreateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithBroadcasterUserName(v string) *ChannelShoutOutCreateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithBroadcasterUserLogin(v string) *ChannelShoutOutCreateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithModeratorUserID(v string) *ChannelShoutOutCreateEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithModeratorUserName(v string) *ChannelShoutOutCreateEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithModeratorUserLogin(v string) *ChannelShoutOutCreateEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithToBroadcasterUserID(v string) *ChannelShoutOutCreateEventBuilder {
+	b.event.ToBroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithToBroadcasterUserName(v string) *ChannelShoutOutCreateEventBuilder {
+	b.event.ToBroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithToBroadcasterUserLogin(v string) *ChannelShoutOutCreateEventBuilder {
+	b.event.ToBroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithStartedAt(v time.Time) *ChannelShoutOutCreateEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithViewerCount(v int) *ChannelShoutOutCreateEventBuilder {
+	b.event.ViewerCount = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithCooldownEndsAt(v time.Time) *ChannelShoutOutCreateEventBuilder {
+	b.event.CooldownEndsAt = v
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) WithTargetCooldownEndsAt(v time.Time) *ChannelShoutOutCreateEventBuilder {
+	b.event.TargetCooldownEndsAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelShoutOutCreateEventBuilder) With(f func(e *twitcheventsub.ChannelShoutOutCreateEvent)) *ChannelShoutOutCreateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) Build() twitcheventsub.ChannelShoutOutCreateEvent {
+	return b.event
+}
+
+func (b *ChannelShoutOutCreateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.shoutout.create fixture carrying the built event.
+func (b *ChannelShoutOutCreateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.shoutout.create", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelShoutOutReceivedEventBuilder builds channel.shoutout.receive events starting from the v1 fixture.
+type ChannelShoutOutReceivedEventBuilder struct {
+	event twitcheventsub.ChannelShoutOutReceivedEvent
+}
+
+// NewChannelShoutOutReceivedEvent returns a builder holding the channel.shoutout.receive fixture event.
+func NewChannelShoutOutReceivedEvent() *ChannelShoutOutReceivedEventBuilder {
+	b := &ChannelShoutOutReceivedEventBuilder{}
+	mustDecode("channel.shoutout.receive", "1", &b.event)
+	return b
+}
+
+func (b *ChannelShoutOutReceivedEventBuilder) WithBroadcasterUserID(v string) *ChannelShoutOutReceivedEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelShoutOutReceivedEventBuilder) WithBroadcasterUserName(v string) *ChannelShoutOutReceivedEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelShoutOutReceivedEventBuilder) WithBroadcasterUserLogin(v string) *ChannelShoutOutReceivedEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelShoutOutReceivedEventBuilder) WithFromBroadcasterUserID(v string) *ChannelShoutOutReceivedEventBuilder {
+	b.event.FromBroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelShoutOutReceivedEventBuilder) WithFromBroadcasterUserName(v string) *ChannelShoutOutReceivedEventBuilder {
+	b.event.FromBroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelShoutOutReceivedEventBuilder) WithFromBroadcasterUserLogin(v string) *ChannelShoutOutReceivedEventBuilder {
+	b.event.FromBroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelShoutOutReceivedEventBuilder) WithViewerCount(v int) *ChannelShoutOutReceivedEventBuilder {
+	b.event.ViewerCount = v
+	return b
+}
+
+func (b *ChannelShoutOutReceivedEventBuilder) WithStartedAt(v time.Time) *ChannelShoutOutReceivedEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelShoutOutReceivedEventBuilder) With(f func(e *twitcheventsub.ChannelShoutOutReceivedEvent)) *ChannelShoutOutReceivedEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelShoutOutReceivedEventBuilder) Build() twitcheventsub.ChannelShoutOutReceivedEvent {
+	return b.event
+}
+
+func (b *ChannelShoutOutReceivedEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.shoutout.receive fixture carrying the built event.
+func (b *ChannelShoutOutReceivedEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.shoutout.receive", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// StreamOnlineEventBuilder builds stream.online events starting from the v1 fixture.
+type StreamOnlineEventBuilder struct {
+	event twitcheventsub.StreamOnlineEvent
+}
+
+// NewStreamOnlineEvent returns a builder holding the stream.online fixture event.
+func NewStreamOnlineEvent() *StreamOnlineEventBuilder {
+	b := &StreamOnlineEventBuilder{}
+	mustDecode("stream.online", "1", &b.event)
+	return b
+}
+
+func (b *StreamOnlineEventBuilder) WithID(v string) *StreamOnlineEventBuilder {
+	b.event.ID = v
+	return b
+}
+
+func (b *StreamOnlineEventBuilder) WithBroadcasterUserID(v string) *StreamOnlineEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *StreamOnlineEventBuilder) WithBroadcasterUserLogin(v string) *StreamOnlineEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *StreamOnlineEventBuilder) WithBroadcasterUserName(v string) *StreamOnlineEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *StreamOnlineEventBuilder) WithType(v string) *StreamOnlineEventBuilder {
+	b.event.Type = v
+	return b
+}
+
+func (b *StreamOnlineEventBuilder) WithStartedAt(v time.Time) *StreamOnlineEventBuilder {
+	b.event.StartedAt = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *StreamOnlineEventBuilder) With(f func(e *twitcheventsub.StreamOnlineEvent)) *StreamOnlineEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *StreamOnlineEventBuilder) Build() twitcheventsub.StreamOnlineEvent {
+	return b.event
+}
+
+func (b *StreamOnlineEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the stream.online fixture carrying the built event.
+func (b *StreamOnlineEventBuilder) Fixture() Fixture {
+	f := mustLoad("stream.online", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// StreamOfflineEventBuilder builds stream.offline events starting from the v1 fixture.
+type StreamOfflineEventBuilder struct {
+	event twitcheventsub.StreamOfflineEvent
+}
+
+// NewStreamOfflineEvent returns a builder holding the stream.offline fixture event.
+func NewStreamOfflineEvent() *StreamOfflineEventBuilder {
+	b := &StreamOfflineEventBuilder{}
+	mustDecode("stream.offline", "1", &b.event)
+	return b
+}
+
+func (b *StreamOfflineEventBuilder) WithBroadcasterUserID(v string) *StreamOfflineEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *StreamOfflineEventBuilder) WithBroadcasterUserLogin(v string) *StreamOfflineEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *StreamOfflineEventBuilder) WithBroadcasterUserName(v string) *StreamOfflineEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *StreamOfflineEventBuilder) With(f func(e *twitcheventsub.StreamOfflineEvent)) *StreamOfflineEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *StreamOfflineEventBuilder) Build() twitcheventsub.StreamOfflineEvent {
+	return b.event
+}
+
+func (b *StreamOfflineEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the stream.offline fixture carrying the built event.
+func (b *StreamOfflineEventBuilder) Fixture() Fixture {
+	f := mustLoad("stream.offline", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// UserAuthorizationGrantEventBuilder builds user.authorization.grant events starting from the v1 fixture.
+type UserAuthorizationGrantEventBuilder struct {
+	event twitcheventsub.UserAuthorizationGrantEvent
+}
+
+// NewUserAuthorizationGrantEvent returns a builder holding the user.authorization.grant fixture event.
+func NewUserAuthorizationGrantEvent() *UserAuthorizationGrantEventBuilder {
+	b := &UserAuthorizationGrantEventBuilder{}
+	mustDecode("user.authorization.grant", "1", &b.event)
+	return b
+}
+
+func (b *UserAuthorizationGrantEventBuilder) WithClientID(v string) *UserAuthorizationGrantEventBuilder {
+	b.event.ClientID = v
+	return b
+}
+
+func (b *UserAuthorizationGrantEventBuilder) WithUserID(v string) *UserAuthorizationGrantEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *UserAuthorizationGrantEventBuilder) WithUserLogin(v string) *UserAuthorizationGrantEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *UserAuthorizationGrantEventBuilder) WithUserName(v string) *UserAuthorizationGrantEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *UserAuthorizationGrantEventBuilder) With(f func(e *twitcheventsub.UserAuthorizationGrantEvent)) *UserAuthorizationGrantEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *UserAuthorizationGrantEventBuilder) Build() twitcheventsub.UserAuthorizationGrantEvent {
+	return b.event
+}
+
+func (b *UserAuthorizationGrantEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the user.authorization.grant fixture carrying the built event.
+func (b *UserAuthorizationGrantEventBuilder) Fixture() Fixture {
+	f := mustLoad("user.authorization.grant", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// UserAuthorizationRevokeEventBuilder builds user.authorization.revoke events starting from the v1 fixture.
+type UserAuthorizationRevokeEventBuilder struct {
+	event twitcheventsub.UserAuthorizationRevokeEvent
+}
+
+// NewUserAuthorizationRevokeEvent returns a builder holding the user.authorization.revoke fixture event.
+func NewUserAuthorizationRevokeEvent() *UserAuthorizationRevokeEventBuilder {
+	b := &UserAuthorizationRevokeEventBuilder{}
+	mustDecode("user.authorization.revoke", "1", &b.event)
+	return b
+}
+
+func (b *UserAuthorizationRevokeEventBuilder) WithClientID(v string) *UserAuthorizationRevokeEventBuilder {
+	b.event.ClientID = v
+	return b
+}
+
+func (b *UserAuthorizationRevokeEventBuilder) WithUserID(v string) *UserAuthorizationRevokeEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *UserAuthorizationRevokeEventBuilder) WithUserLogin(v string) *UserAuthorizationRevokeEventBuilder {
+	b.event.UserLogin = &v
+	return b
+}
+
+func (b *UserAuthorizationRevokeEventBuilder) WithUserName(v string) *UserAuthorizationRevokeEventBuilder {
+	b.event.UserName = &v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *UserAuthorizationRevokeEventBuilder) With(f func(e *twitcheventsub.UserAuthorizationRevokeEvent)) *UserAuthorizationRevokeEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *UserAuthorizationRevokeEventBuilder) Build() twitcheventsub.UserAuthorizationRevokeEvent {
+	return b.event
+}
+
+func (b *UserAuthorizationRevokeEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the user.authorization.revoke fixture carrying the built event.
+func (b *UserAuthorizationRevokeEventBuilder) Fixture() Fixture {
+	f := mustLoad("user.authorization.revoke", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// UserUpdateEventBuilder builds user.update events starting from the v1 fixture.
+type UserUpdateEventBuilder struct {
+	event twitcheventsub.UserUpdateEvent
+}
+
+// NewUserUpdateEvent returns a builder holding the user.update fixture event.
+func NewUserUpdateEvent() *UserUpdateEventBuilder {
+	b := &UserUpdateEventBuilder{}
+	mustDecode("user.update", "1", &b.event)
+	return b
+}
+
+func (b *UserUpdateEventBuilder) WithUserID(v string) *UserUpdateEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *UserUpdateEventBuilder) WithUserLogin(v string) *UserUpdateEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *UserUpdateEventBuilder) WithUserName(v string) *UserUpdateEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *UserUpdateEventBuilder) WithEmail(v string) *UserUpdateEventBuilder {
+	b.event.Email = v
+	return b
+}
+
+func (b *UserUpdateEventBuilder) WithEmailVerified(v bool) *UserUpdateEventBuilder {
+	b.event.EmailVerified = v
+	return b
+}
+
+func (b *UserUpdateEventBuilder) WithDescription(v string) *UserUpdateEventBuilder {
+	b.event.Description = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *UserUpdateEventBuilder) With(f func(e *twitcheventsub.UserUpdateEvent)) *UserUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *UserUpdateEventBuilder) Build() twitcheventsub.UserUpdateEvent {
+	return b.event
+}
+
+func (b *UserUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the user.update fixture carrying the built event.
+func (b *UserUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("user.update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// UserWhisperMessageEventBuilder builds user.whisper.message events starting from the v1 fixture.
+type UserWhisperMessageEventBuilder struct {
+	event twitcheventsub.UserWhisperMessageEvent
+}
+
+// NewUserWhisperMessageEvent returns a builder holding the user.whisper.message fixture event.
+func NewUserWhisperMessageEvent() *UserWhisperMessageEventBuilder {
+	b := &UserWhisperMessageEventBuilder{}
+	mustDecode("user.whisper.message", "1", &b.event)
+	return b
+}
+
+func (b *UserWhisperMessageEventBuilder) WithFromUserID(v string) *UserWhisperMessageEventBuilder {
+	b.event.FromUserID = v
+	return b
+}
+
+func (b *UserWhisperMessageEventBuilder) WithFromUserLogin(v string) *UserWhisperMessageEventBuilder {
+	b.event.FromUserLogin = v
+	return b
+}
+
+func (b *UserWhisperMessageEventBuilder) WithFromUserName(v string) *UserWhisperMessageEventBuilder {
+	b.event.FromUserName = v
+	return b
+}
+
+func (b *UserWhisperMessageEventBuilder) WithToUserID(v string) *UserWhisperMessageEventBuilder {
+	b.event.ToUserID = v
+	return b
+}
+
+func (b *UserWhisperMessageEventBuilder) WithToUserLogin(v string) *UserWhisperMessageEventBuilder {
+	b.event.ToUserLogin = v
+	return b
+}
+
+func (b *UserWhisperMessageEventBuilder) WithToUserName(v string) *UserWhisperMessageEventBuilder {
+	b.event.ToUserName = v
+	return b
+}
+
+func (b *UserWhisperMessageEventBuilder) WithWhisperID(v string) *UserWhisperMessageEventBuilder {
+	b.event.WhisperID = v
+	return b
+}
+
+func (b *UserWhisperMessageEventBuilder) WithWhisper(v twitcheventsub.Whisper) *UserWhisperMessageEventBuilder {
+	b.event.Whisper = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *UserWhisperMessageEventBuilder) With(f func(e *twitcheventsub.UserWhisperMessageEvent)) *UserWhisperMessageEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *UserWhisperMessageEventBuilder) Build() twitcheventsub.UserWhisperMessageEvent {
+	return b.event
+}
+
+func (b *UserWhisperMessageEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the user.whisper.message fixture carrying the built event.
+func (b *UserWhisperMessageEventBuilder) Fixture() Fixture {
+	f := mustLoad("user.whisper.message", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelSuspiciousUserUpdateEventBuilder builds channel.suspicious_user.update events starting from the v1 fixture.
+type ChannelSuspiciousUserUpdateEventBuilder struct {
+	event twitcheventsub.ChannelSuspiciousUserUpdateEvent
+}
+
+// NewChannelSuspiciousUserUpdateEvent returns a builder holding the channel.suspicious_user.update fixture event.
+func NewChannelSuspiciousUserUpdateEvent() *ChannelSuspiciousUserUpdateEventBuilder {
+	b := &ChannelSuspiciousUserUpdateEventBuilder{}
+	mustDecode("channel.suspicious_user.update", "1", &b.event)
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) WithBroadcasterUserID(v string) *ChannelSuspiciousUserUpdateEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) WithBroadcasterUserName(v string) *ChannelSuspiciousUserUpdateEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) WithBroadcasterUserLogin(v string) *ChannelSuspiciousUserUpdateEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) WithModeratorUserID(v string) *ChannelSuspiciousUserUpdateEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) WithModeratorUserName(v string) *ChannelSuspiciousUserUpdateEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) WithModeratorUserLogin(v string) *ChannelSuspiciousUserUpdateEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) WithUserID(v string) *ChannelSuspiciousUserUpdateEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) WithUserName(v string) *ChannelSuspiciousUserUpdateEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) WithUserLogin(v string) *ChannelSuspiciousUserUpdateEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) WithLowTrustStatus(v string) *ChannelSuspiciousUserUpdateEventBuilder {
+	b.event.LowTrustStatus = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelSuspiciousUserUpdateEventBuilder) With(f func(e *twitcheventsub.ChannelSuspiciousUserUpdateEvent)) *ChannelSuspiciousUserUpdateEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) Build() twitcheventsub.ChannelSuspiciousUserUpdateEvent {
+	return b.event
+}
+
+func (b *ChannelSuspiciousUserUpdateEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.suspicious_user.update fixture carrying the built event.
+func (b *ChannelSuspiciousUserUpdateEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.suspicious_user.update", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelBitsUseEventBuilder builds channel.bits.use events starting from the v1 fixture.
+type ChannelBitsUseEventBuilder struct {
+	event twitcheventsub.ChannelBitsUseEvent
+}
+
+// NewChannelBitsUseEvent returns a builder holding the channel.bits.use fixture event.
+func NewChannelBitsUseEvent() *ChannelBitsUseEventBuilder {
+	b := &ChannelBitsUseEventBuilder{}
+	mustDecode("channel.bits.use", "1", &b.event)
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) WithBroadcasterUserID(v string) *ChannelBitsUseEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) WithBroadcasterUserLogin(v string) *ChannelBitsUseEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) WithBroadcasterUserName(v string) *ChannelBitsUseEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) WithUserID(v string) *ChannelBitsUseEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) WithUserLogin(v string) *ChannelBitsUseEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) WithUserName(v string) *ChannelBitsUseEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) WithBits(v int) *ChannelBitsUseEventBuilder {
+	b.event.Bits = v
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) WithType(v string) *ChannelBitsUseEventBuilder {
+	b.event.Type = v
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) WithMessage(v twitcheventsub.ChannelBitsUseEventMessage) *ChannelBitsUseEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) WithPowerUp(v twitcheventsub.ChannelBitsUseEventPowerUp) *ChannelBitsUseEventBuilder {
+	b.event.PowerUp = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelBitsUseEventBuilder) With(f func(e *twitcheventsub.ChannelBitsUseEvent)) *ChannelBitsUseEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelBitsUseEventBuilder) Build() twitcheventsub.ChannelBitsUseEvent {
+	return b.event
+}
+
+func (b *ChannelBitsUseEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.bits.use fixture carrying the built event.
+func (b *ChannelBitsUseEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.bits.use", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelSuspiciousUserMessageEventBuilder builds channel.suspicious_user.message events starting from the v1 fixture.
+type ChannelSuspiciousUserMessageEventBuilder struct {
+	event twitcheventsub.ChannelSuspiciousUserMessageEvent
+}
+
+// NewChannelSuspiciousUserMessageEvent returns a builder holding the channel.suspicious_user.message fixture event.
+func NewChannelSuspiciousUserMessageEvent() *ChannelSuspiciousUserMessageEventBuilder {
+	b := &ChannelSuspiciousUserMessageEventBuilder{}
+	mustDecode("channel.suspicious_user.message", "1", &b.event)
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithBroadcasterUserID(v string) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithBroadcasterUserLogin(v string) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithBroadcasterUserName(v string) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithUserID(v string) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithUserLogin(v string) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithUserName(v string) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithLowTrustStatus(v string) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.LowTrustStatus = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithSharedBanChannelIDs(v []string) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.SharedBanChannelIDs = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithTypes(v []string) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.Types = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithBanEvasionEvaluation(v string) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.BanEvasionEvaluation = v
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) WithMessage(v twitcheventsub.SuspiciousMessage) *ChannelSuspiciousUserMessageEventBuilder {
+	b.event.Message = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelSuspiciousUserMessageEventBuilder) With(f func(e *twitcheventsub.ChannelSuspiciousUserMessageEvent)) *ChannelSuspiciousUserMessageEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) Build() twitcheventsub.ChannelSuspiciousUserMessageEvent {
+	return b.event
+}
+
+func (b *ChannelSuspiciousUserMessageEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.suspicious_user.message fixture carrying the built event.
+func (b *ChannelSuspiciousUserMessageEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.suspicious_user.message", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelWarningAcknowledgeEventBuilder builds channel.warning.acknowledge events starting from the v1 fixture.
+type ChannelWarningAcknowledgeEventBuilder struct {
+	event twitcheventsub.ChannelWarningAcknowledgeEvent
+}
+
+// NewChannelWarningAcknowledgeEvent returns a builder holding the channel.warning.acknowledge fixture event.
+func NewChannelWarningAcknowledgeEvent() *ChannelWarningAcknowledgeEventBuilder {
+	b := &ChannelWarningAcknowledgeEventBuilder{}
+	mustDecode("channel.warning.acknowledge", "1", &b.event)
+	return b
+}
+
+func (b *ChannelWarningAcknowledgeEventBuilder) WithBroadcasterUserID(v string) *ChannelWarningAcknowledgeEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelWarningAcknowledgeEventBuilder) WithBroadcasterUserLogin(v string) *ChannelWarningAcknowledgeEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelWarningAcknowledgeEventBuilder) WithBroadcasterUserName(v string) *ChannelWarningAcknowledgeEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelWarningAcknowledgeEventBuilder) WithUserID(v string) *ChannelWarningAcknowledgeEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelWarningAcknowledgeEventBuilder) WithUserLogin(v string) *ChannelWarningAcknowledgeEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelWarningAcknowledgeEventBuilder) WithUserName(v string) *ChannelWarningAcknowledgeEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelWarningAcknowledgeEventBuilder) With(f func(e *twitcheventsub.ChannelWarningAcknowledgeEvent)) *ChannelWarningAcknowledgeEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelWarningAcknowledgeEventBuilder) Build() twitcheventsub.ChannelWarningAcknowledgeEvent {
+	return b.event
+}
+
+func (b *ChannelWarningAcknowledgeEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.warning.acknowledge fixture carrying the built event.
+func (b *ChannelWarningAcknowledgeEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.warning.acknowledge", "1")
+	f.Event = b.JSON()
+	return f
+}
+
+// ChannelWarningSendEventBuilder builds channel.warning.send events starting from the v1 fixture.
+type ChannelWarningSendEventBuilder struct {
+	event twitcheventsub.ChannelWarningSendEvent
+}
+
+// NewChannelWarningSendEvent returns a builder holding the channel.warning.send fixture event.
+func NewChannelWarningSendEvent() *ChannelWarningSendEventBuilder {
+	b := &ChannelWarningSendEventBuilder{}
+	mustDecode("channel.warning.send", "1", &b.event)
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithBroadcasterUserID(v string) *ChannelWarningSendEventBuilder {
+	b.event.BroadcasterUserID = v
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithBroadcasterUserLogin(v string) *ChannelWarningSendEventBuilder {
+	b.event.BroadcasterUserLogin = v
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithBroadcasterUserName(v string) *ChannelWarningSendEventBuilder {
+	b.event.BroadcasterUserName = v
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithModeratorUserID(v string) *ChannelWarningSendEventBuilder {
+	b.event.ModeratorUserID = v
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithModeratorUserLogin(v string) *ChannelWarningSendEventBuilder {
+	b.event.ModeratorUserLogin = v
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithModeratorUserName(v string) *ChannelWarningSendEventBuilder {
+	b.event.ModeratorUserName = v
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithUserID(v string) *ChannelWarningSendEventBuilder {
+	b.event.UserID = v
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithUserLogin(v string) *ChannelWarningSendEventBuilder {
+	b.event.UserLogin = v
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithUserName(v string) *ChannelWarningSendEventBuilder {
+	b.event.UserName = v
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithReason(v string) *ChannelWarningSendEventBuilder {
+	b.event.Reason = &v
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) WithChatRulesCited(v []string) *ChannelWarningSendEventBuilder {
+	b.event.ChatRulesCited = &v
+	return b
+}
+
+// With applies f to the event, for changes the With field methods do not cover.
+func (b *ChannelWarningSendEventBuilder) With(f func(e *twitcheventsub.ChannelWarningSendEvent)) *ChannelWarningSendEventBuilder {
+	f(&b.event)
+	return b
+}
+
+func (b *ChannelWarningSendEventBuilder) Build() twitcheventsub.ChannelWarningSendEvent {
+	return b.event
+}
+
+func (b *ChannelWarningSendEventBuilder) JSON() json.RawMessage {
+	raw, _ := json.Marshal(b.event)
+	return raw
+}
+
+// Fixture returns the channel.warning.send fixture carrying the built event.
+func (b *ChannelWarningSendEventBuilder) Fixture() Fixture {
+	f := mustLoad("channel.warning.send", "1")
+	f.Event = b.JSON()
+	return f
+}
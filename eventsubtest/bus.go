@@ -0,0 +1,100 @@
+package eventsubtest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// NATSMessage is a message published to a NATS stand-in.
+type NATSMessage struct {
+	Subject string
+	Data    []byte
+}
+
+// NATS is an in-process stand-in for a *nats.Conn, for testing a twitcheventsub.NATSPublisher. Published
+// messages are only acknowledged by a flush, like with a real connection.
+type NATS struct {
+	// Err, when set, is returned by every publish.
+	Err error
+
+	mu       sync.Mutex
+	pending  []NATSMessage
+	received []NATSMessage
+}
+
+func (n *NATS) Publish(subject string, data []byte) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.Err != nil {
+		return n.Err
+	}
+	n.pending = append(n.pending, NATSMessage{Subject: subject, Data: slices.Clone(data)})
+	return nil
+}
+
+func (n *NATS) FlushWithContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.received = append(n.received, n.pending...)
+	n.pending = nil
+	return nil
+}
+
+// Messages returns the flushed messages, optionally only the ones published to subject.
+func (n *NATS) Messages(subject ...string) []NATSMessage {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var msgs []NATSMessage
+	for _, m := range n.received {
+		if len(subject) == 0 || slices.Contains(subject, m.Subject) {
+			msgs = append(msgs, m)
+		}
+	}
+	return msgs
+}
+
+// StreamEntry is an entry added to a RedisStreams stand-in.
+type StreamEntry struct {
+	ID     string
+	Values map[string]any
+}
+
+// RedisStreams is an in-process stand-in for Redis streams, for testing a twitcheventsub.RedisStreamPublisher.
+type RedisStreams struct {
+	// Err, when set, is returned by every XAdd.
+	Err error
+
+	mu      sync.Mutex
+	seq     int
+	streams map[string][]StreamEntry
+}
+
+func (r *RedisStreams) XAdd(ctx context.Context, stream string, values map[string]any) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.Err != nil {
+		return "", r.Err
+	}
+	if r.streams == nil {
+		r.streams = map[string][]StreamEntry{}
+	}
+	r.seq++
+	id := fmt.Sprintf("%d-0", r.seq)
+	r.streams[stream] = append(r.streams[stream], StreamEntry{ID: id, Values: values})
+	return id, nil
+}
+
+// Stream returns the entries of a stream, in the order they were added.
+func (r *RedisStreams) Stream(stream string) []StreamEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.streams[stream])
+}
@@ -0,0 +1,165 @@
+package eventsubtest
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+)
+
+//go:generate go run ./internal/gen
+
+//go:embed fixtures/*.json
+var fixtureFS embed.FS
+
+// Fixture is a golden notification sample for a subscription type and version.
+type Fixture struct {
+	Subscription twitcheventsub.Subscription `json:"subscription"`
+	Event        json.RawMessage             `json:"event,omitempty"`
+	Events       json.RawMessage             `json:"events,omitempty"`
+}
+
+// LoadFixture returns the sample notification for the given subscription type and version.
+func LoadFixture(subType, version string) (Fixture, error) {
+	b, err := fixtureFS.ReadFile(path.Join("fixtures", fmt.Sprintf("%s.v%s.json", subType, version)))
+	if err != nil {
+		return Fixture{}, fmt.Errorf("no fixture for %s version %s", subType, version)
+	}
+	var f Fixture
+	if err := json.Unmarshal(b, &f); err != nil {
+		return Fixture{}, fmt.Errorf("error decoding fixture for %s version %s: %w", subType, version, err)
+	}
+	return f, nil
+}
+
+// Fixtures returns every sample notification, sorted by subscription type and version.
+func Fixtures() []Fixture {
+	names, _ := fs.Glob(fixtureFS, "fixtures/*.json")
+	fixtures := make([]Fixture, 0, len(names))
+	for _, name := range names {
+		subType, version, _ := strings.Cut(strings.TrimSuffix(path.Base(name), ".json"), ".v")
+		fixtures = append(fixtures, mustLoad(subType, version))
+	}
+	return fixtures
+}
+
+// LatestFixture returns the sample notification for the newest version of a subscription type.
+func LatestFixture(subType string) (Fixture, error) {
+	var latest *Fixture
+	for _, f := range Fixtures() {
+		if f.Subscription.Type != subType {
+			continue
+		}
+		if latest == nil || newer(f.Subscription.Version, latest.Subscription.Version) {
+			latest = &f
+		}
+	}
+	if latest == nil {
+		return Fixture{}, fmt.Errorf("no fixture for %s", subType)
+	}
+	return *latest, nil
+}
+
+func newer(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) > len(b)
+	}
+	return a > b
+}
+
+// Payload returns the event, or the events list of batched subscription types.
+func (f Fixture) Payload() json.RawMessage {
+	if len(f.Events) > 0 {
+		return f.Events
+	}
+	return f.Event
+}
+
+// Body returns the notification body Twitch would send for the fixture.
+func (f Fixture) Body() ([]byte, error) {
+	return marshalMessage(twitcheventsub.Response{Subscription: f.Subscription, Event: f.Event, Events: f.Events})
+}
+
+// WithField returns a copy of the fixture with a top level event field set to value, for batched types the field
+// is set on every entry of the events list.
+func (f Fixture) WithField(name string, value any) (Fixture, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return f, fmt.Errorf("error encoding %s: %w", name, err)
+	}
+	set := func(event json.RawMessage) (json.RawMessage, error) {
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(event, &m); err != nil {
+			return nil, err
+		}
+		m[name] = raw
+		return json.Marshal(m)
+	}
+	if len(f.Events) > 0 {
+		var events []json.RawMessage
+		if err := json.Unmarshal(f.Events, &events); err != nil {
+			return f, err
+		}
+		for i := range events {
+			if events[i], err = set(events[i]); err != nil {
+				return f, err
+			}
+		}
+		f.Events, err = json.Marshal(events)
+		return f, err
+	}
+	f.Event, err = set(f.Event)
+	return f, err
+}
+
+// WithBroadcaster returns a copy of the fixture where the broadcaster in the condition and in the event is id,
+// for channel.raid the receiving broadcaster is changed.
+func (f Fixture) WithBroadcaster(id string) (Fixture, error) {
+	field := "broadcaster_user_id"
+	switch {
+	case f.Subscription.Condition.BroadcasterUserId != "":
+		f.Subscription.Condition.BroadcasterUserId = id
+	case f.Subscription.Condition.ToBroadcasterUserId != "":
+		f.Subscription.Condition.ToBroadcasterUserId = id
+		field = "to_broadcaster_user_id"
+	default:
+		return f, fmt.Errorf("%s has no broadcaster condition", f.Subscription.Type)
+	}
+	var m map[string]json.RawMessage
+	if json.Unmarshal(f.Event, &m) == nil {
+		if _, ok := m[field]; ok {
+			return f.WithField(field, id)
+		}
+	}
+	return f, nil
+}
+
+func mustLoad(subType, version string) Fixture {
+	f, err := LoadFixture(subType, version)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// mustDecode decodes a fixture strictly into event, so fixtures that drift from the models fail loudly.
+func mustDecode(subType, version string, event any) {
+	payload := mustLoad(subType, version).Payload()
+	if payload[0] == '[' {
+		var events []json.RawMessage
+		if err := json.Unmarshal(payload, &events); err != nil || len(events) == 0 {
+			panic(fmt.Sprintf("invalid events in fixture for %s version %s", subType, version))
+		}
+		payload = events[0]
+	}
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(event); err != nil {
+		panic(fmt.Sprintf("fixture for %s version %s does not match the model: %s", subType, version, err))
+	}
+}
@@ -0,0 +1,432 @@
+// Command gen writes the event builders of the eventsubtest package and creates a fixture for every
+// subscription type that does not have one yet. Existing fixtures are golden samples and are never rewritten.
+// It must run from the eventsubtest directory, which go generate does.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// versions lists the subscription types whose model is not version 1.
+var versions = map[string]string{
+	"channel.update":   "2",
+	"channel.follow":   "2",
+	"channel.moderate": "2",
+	"channel.channel_points_automatic_reward_redemption.add": "2",
+	"channel.hype_train.begin":                               "2",
+	"channel.hype_train.progress":                            "2",
+	"channel.hype_train.end":                                 "2",
+}
+
+type event struct {
+	subType  string
+	version  string
+	typeName string
+	batched  bool
+}
+
+type generator struct {
+	types map[string]*ast.StructType
+	seen  map[string]bool
+}
+
+func main() {
+	fset := token.NewFileSet()
+	models, err := parser.ParseFile(fset, filepath.Join("..", "models.go"), nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	eventTypes, err := parser.ParseFile(fset, filepath.Join("..", "events.go"), nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &generator{types: map[string]*ast.StructType{}}
+	for _, decl := range models.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			if st, ok := ts.Type.(*ast.StructType); ok {
+				g.types[ts.Name.Name] = st
+			}
+		}
+	}
+	events := findEvents(eventTypes)
+	if err := os.MkdirAll("fixtures", 0o755); err != nil {
+		log.Fatal(err)
+	}
+	for _, e := range events {
+		path := filepath.Join("fixtures", fmt.Sprintf("%s.v%s.json", e.subType, e.version))
+		if _, err := os.Stat(path); err == nil {
+			continue
+		} else if !errors.Is(err, os.ErrNotExist) {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(path, g.fixture(e), 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	src, err := format.Source(g.builders(events))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("builders_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// findEvents reads the subscription types and their event types from the eventTypes map, whose entries are
+// either on calls, typed by the On function getter they take, or eventType literals with a decode[T] field.
+func findEvents(f *ast.File) []event {
+	var events []event
+	ast.Inspect(f, func(n ast.Node) bool {
+		vs, ok := n.(*ast.ValueSpec)
+		if !ok || vs.Names[0].Name != "eventTypes" {
+			return true
+		}
+		for _, elt := range vs.Values[0].(*ast.CompositeLit).Elts {
+			kv := elt.(*ast.KeyValueExpr)
+			subType, _ := strconv.Unquote(kv.Key.(*ast.BasicLit).Value)
+			e := event{subType: subType, version: "1"}
+			if v, ok := versions[subType]; ok {
+				e.version = v
+			}
+			var t ast.Expr
+			switch v := kv.Value.(type) {
+			case *ast.CallExpr:
+				getter := v.Args[0].(*ast.FuncLit).Type.Results.List[0].Type.(*ast.FuncType)
+				t = getter.Params.List[0].Type
+			case *ast.CompositeLit:
+				for _, field := range v.Elts {
+					if field := field.(*ast.KeyValueExpr); field.Key.(*ast.Ident).Name == "decode" {
+						t = field.Value.(*ast.IndexExpr).Index
+					}
+				}
+			}
+			switch t := t.(type) {
+			case *ast.Ident:
+				e.typeName = t.Name
+			case *ast.ArrayType:
+				e.typeName = t.Elt.(*ast.Ident).Name
+				e.batched = true
+			default:
+				log.Fatalf("unable to find the event type of %s", subType)
+			}
+			events = append(events, e)
+		}
+		return false
+	})
+	return events
+}
+
+// object keeps the field order of the struct it was built from when marshalled.
+type object []field
+
+type field struct {
+	key   string
+	value any
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, f := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		k, _ := json.Marshal(f.key)
+		v, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(k)
+		b.WriteByte(':')
+		b.Write(v)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+func (g *generator) fixture(e event) []byte {
+	g.seen = map[string]bool{}
+	payload := g.value(&ast.Ident{Name: e.typeName}, "")
+	key := "event"
+	if e.batched {
+		key = "events"
+		payload = []any{payload}
+	}
+	sub := object{
+		{"id", "f1c2a387-161a-49f9-a165-0f21d7a4e1c4"},
+		{"status", "enabled"},
+		{"type", e.subType},
+		{"version", e.version},
+		{"cost", 0},
+		{"condition", condition(e.subType)},
+		{"transport", object{{"method", "webhook"}, {"callback", "https://example.com/webhooks/callback"}}},
+		{"created_at", "2025-01-01T12:00:00.000000000Z"},
+	}
+	b, err := json.MarshalIndent(object{{"subscription", sub}, {key, payload}}, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	return append(b, '\n')
+}
+
+func condition(subType string) object {
+	switch {
+	case subType == "user.authorization.grant" || subType == "user.authorization.revoke" ||
+		subType == "conduit.shard.disabled":
+		return object{{"client_id", "crq72vsaoijkc83xx42hz6i37"}}
+	case strings.HasPrefix(subType, "user."):
+		return object{{"user_id", "1337"}}
+	case subType == "channel.raid":
+		return object{{"to_broadcaster_user_id", "1337"}}
+	case subType == "drop.entitlement.grant":
+		return object{{"organization_id", "9001"}}
+	case subType == "extension.bits_transaction.create":
+		return object{}
+	case strings.HasPrefix(subType, "channel.chat"):
+		return object{{"broadcaster_user_id", "1337"}, {"user_id", "9001"}}
+	case subType == "channel.follow" || subType == "channel.moderate" || strings.HasPrefix(subType, "automod.") ||
+		strings.HasPrefix(subType, "channel.shield_mode") || strings.HasPrefix(subType, "channel.shoutout") ||
+		strings.HasPrefix(subType, "channel.unban_request") || strings.HasPrefix(subType, "channel.suspicious_user") ||
+		strings.HasPrefix(subType, "channel.warning") || strings.HasPrefix(subType, "channel.guest_star"):
+		return object{{"broadcaster_user_id", "1337"}, {"moderator_user_id", "9001"}}
+	}
+	return object{{"broadcaster_user_id", "1337"}}
+}
+
+func (g *generator) value(expr ast.Expr, name string) any {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return g.value(t.X, name)
+	case *ast.ArrayType:
+		return []any{g.value(t.Elt, name)}
+	case *ast.MapType:
+		return object{}
+	case *ast.SelectorExpr:
+		if t.Sel.Name == "Time" {
+			return "2025-01-01T12:00:00Z"
+		}
+		return object{}
+	case *ast.StructType:
+		return g.object(t)
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return stringValue(name)
+		case "int", "int64", "int32":
+			return intValue(name)
+		case "float64", "float32":
+			return 1.5
+		case "bool":
+			return strings.HasPrefix(name, "is_") && strings.HasSuffix(name, "_enabled")
+		}
+		st, ok := g.types[t.Name]
+		if !ok || g.seen[t.Name] {
+			return nil
+		}
+		g.seen[t.Name] = true
+		defer delete(g.seen, t.Name)
+		return g.object(st)
+	}
+	return nil
+}
+
+func (g *generator) object(st *ast.StructType) object {
+	var o object
+	for _, f := range st.Fields.List {
+		if f.Tag == nil {
+			continue
+		}
+		tag, _ := strconv.Unquote(f.Tag.Value)
+		key := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+		if key == "" || key == "-" {
+			continue
+		}
+		o = append(o, field{key, g.value(f.Type, key)})
+	}
+	return o
+}
+
+func stringValue(name string) string {
+	role := "user"
+	for _, r := range []string{"from_broadcaster", "to_broadcaster", "broadcaster", "moderator", "requester", "gifter", "recipient"} {
+		if strings.HasPrefix(name, r) {
+			role = r
+			break
+		}
+	}
+	ids := map[string]string{"from_broadcaster": "1234", "to_broadcaster": "1337", "broadcaster": "1337",
+		"moderator": "9001", "requester": "2345", "gifter": "3456", "recipient": "4567", "user": "4242"}
+	switch {
+	case strings.HasSuffix(name, "user_id") || name == "broadcaster_id" || name == "moderator_id" || name == "user_id":
+		return ids[role]
+	case strings.HasSuffix(name, "_login") || name == "login":
+		return "cool_" + role
+	case strings.HasSuffix(name, "user_name") || name == "broadcaster_name" || name == "user_name":
+		return "Cool_" + strings.ToUpper(role[:1]) + role[1:]
+	case name == "tier" || strings.HasSuffix(name, "_tier"):
+		return "1000"
+	case name == "set_id":
+		return "subscriber"
+	case name == "info":
+		return "12"
+	case name == "notice_type":
+		return "sub"
+	case name == "action":
+		return "ban"
+	case name == "format":
+		return "static"
+	case name == "system_message":
+		return "Cool_User subscribed at Tier 1."
+	case name == "category_id":
+		return "509658"
+	case name == "category_name":
+		return "Just Chatting"
+	case name == "language":
+		return "en"
+	case name == "color":
+		return "#9146FF"
+	case name == "currency":
+		return "USD"
+	case name == "prefix":
+		return "Cheer"
+	case name == "email":
+		return "user@example.com"
+	case name == "type":
+		return "text"
+	case name == "client_id" || name == "extension_client_id":
+		return "crq72vsaoijkc83xx42hz6i37"
+	case strings.HasSuffix(name, "url"):
+		return "https://static-cdn.jtvnw.net/example.png"
+	case name == "id" || strings.HasSuffix(name, "_id"):
+		return "6c6d8a6a-64ba-4c3b-a4b9-d4b3a3e0f2a1"
+	case strings.Contains(name, "status"):
+		return "active"
+	case name == "title":
+		return "Example stream title"
+	}
+	return "Hello world"
+}
+
+func intValue(name string) int {
+	switch {
+	case strings.Contains(name, "bits"):
+		return 100
+	case strings.Contains(name, "viewer"):
+		return 9001
+	case strings.Contains(name, "months"):
+		return 3
+	case strings.Contains(name, "seconds") || strings.Contains(name, "duration"):
+		return 60
+	case strings.Contains(name, "amount") || strings.Contains(name, "total") || strings.Contains(name, "goal"):
+		return 500
+	case strings.Contains(name, "points") || strings.Contains(name, "cost"):
+		return 50
+	case strings.Contains(name, "votes"):
+		return 10
+	}
+	return 1
+}
+
+func (g *generator) builders(events []event) []byte {
+	var b bytes.Buffer
+	for _, e := range events {
+		name := e.typeName + "Builder"
+		typ := "twitcheventsub." + e.typeName
+		fmt.Fprintf(&b, "\n// %s builds %s events starting from the v%s fixture.\n", name, e.subType, e.version)
+		fmt.Fprintf(&b, "type %s struct {\n\tevent %s\n}\n\n", name, typ)
+		fmt.Fprintf(&b, "// New%s returns a builder holding the %s fixture event.\n", e.typeName, e.subType)
+		fmt.Fprintf(&b, "func New%s() *%s {\n\tb := &%s{}\n\tmustDecode(%q, %q, &b.event)\n\treturn b\n}\n\n",
+			e.typeName, name, name, e.subType, e.version)
+		for _, f := range g.types[e.typeName].Fields.List {
+			param, pointer, ok := g.render(f.Type)
+			if !ok {
+				continue
+			}
+			for _, n := range f.Names {
+				value := "v"
+				if pointer {
+					value = "&v"
+				}
+				fmt.Fprintf(&b, "func (b *%s) With%s(v %s) *%s {\n\tb.event.%s = %s\n\treturn b\n}\n\n",
+					name, n.Name, param, name, n.Name, value)
+			}
+		}
+		fmt.Fprintf(&b, "// With applies f to the event, for changes the With field methods do not cover.\n")
+		fmt.Fprintf(&b, "func (b *%s) With(f func(e *%s)) *%s {\n\tf(&b.event)\n\treturn b\n}\n\n", name, typ, name)
+		fmt.Fprintf(&b, "func (b *%s) Build() %s {\n\treturn b.event\n}\n\n", name, typ)
+		fmt.Fprintf(&b, "func (b *%s) JSON() json.RawMessage {\n\traw, _ := json.Marshal(b.event)\n\treturn raw\n}\n\n", name)
+		if e.batched {
+			fmt.Fprintf(&b, "// Fixture returns the %s fixture with a batch holding only the built event.\n", e.subType)
+			fmt.Fprintf(&b, "func (b *%s) Fixture() Fixture {\n\tf := mustLoad(%q, %q)\n\tf.Events, _ = json.Marshal([]%s{b.event})\n\treturn f\n}\n",
+				name, e.subType, e.version, typ)
+		} else {
+			fmt.Fprintf(&b, "// Fixture returns the %s fixture carrying the built event.\n", e.subType)
+			fmt.Fprintf(&b, "func (b *%s) Fixture() Fixture {\n\tf := mustLoad(%q, %q)\n\tf.Event = b.JSON()\n\treturn f\n}\n",
+				name, e.subType, e.version)
+		}
+	}
+	var src bytes.Buffer
+	src.WriteString("// Code generated by go run ./internal/gen; DO NOT EDIT.\n\n")
+	src.WriteString("package eventsubtest\n\nimport (\n\t\"encoding/json\"\n")
+	if bytes.Contains(b.Bytes(), []byte("time.Time")) {
+		src.WriteString("\t\"time\"\n")
+	}
+	src.WriteString("\n\ttwitcheventsub \"github.com/Aiuzu42/go-twitch-eventsub\"\n)\n")
+	src.Write(b.Bytes())
+	return src.Bytes()
+}
+
+// render returns the parameter type of a With method, pointer fields take the value they point to.
+// Fields of anonymous struct types are only reachable through With.
+func (g *generator) render(expr ast.Expr) (string, bool, bool) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		s, ok := g.typeString(star.X)
+		return s, true, ok
+	}
+	s, ok := g.typeString(expr)
+	return s, false, ok
+}
+
+func (g *generator) typeString(expr ast.Expr) (string, bool) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if _, ok := g.types[t.Name]; ok {
+			return "twitcheventsub." + t.Name, true
+		}
+		return t.Name, true
+	case *ast.SelectorExpr:
+		return t.X.(*ast.Ident).Name + "." + t.Sel.Name, true
+	case *ast.StarExpr:
+		s, ok := g.typeString(t.X)
+		return "*" + s, ok
+	case *ast.ArrayType:
+		s, ok := g.typeString(t.Elt)
+		return "[]" + s, ok
+	case *ast.MapType:
+		k, ok1 := g.typeString(t.Key)
+		v, ok2 := g.typeString(t.Value)
+		return "map[" + k + "]" + v, ok1 && ok2
+	}
+	return "", false
+}
@@ -0,0 +1,99 @@
+package eventsubtest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+)
+
+const (
+	HeaderId                  = "Twitch-Eventsub-Message-Id"
+	HeaderRetry               = "Twitch-Eventsub-Message-Retry"
+	HeaderType                = "Twitch-Eventsub-Message-Type"
+	HeaderSignature           = "Twitch-Eventsub-Message-Signature"
+	HeaderTimestamp           = "Twitch-Eventsub-Message-Timestamp"
+	HeaderSubscriptionType    = "Twitch-Eventsub-Subscription-Type"
+	HeaderSubscriptionVersion = "Twitch-Eventsub-Subscription-Version"
+
+	MessageTypeChallenge    = "webhook_callback_verification"
+	MessageTypeNotification = "notification"
+	MessageTypeRevocation   = "revocation"
+)
+
+// NewMessageID returns a random message id in the format Twitch uses.
+func NewMessageID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return fmt.Sprintf("%s-%s-%s-%s-%s", hex.EncodeToString(b[:4]), hex.EncodeToString(b[4:6]),
+		hex.EncodeToString(b[6:8]), hex.EncodeToString(b[8:10]), hex.EncodeToString(b[10:]))
+}
+
+// NotificationBody returns the body of a notification for sub, event is marshalled unless it already is a
+// json.RawMessage. Batched subscription types like drop.entitlement.grant put event in the events field.
+func NotificationBody(sub twitcheventsub.Subscription, event any) ([]byte, error) {
+	raw, ok := event.(json.RawMessage)
+	if !ok {
+		b, err := json.Marshal(event)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding event: %w", err)
+		}
+		raw = b
+	}
+	payload := twitcheventsub.Response{Subscription: sub}
+	if sub.Type == twitcheventsub.DropEntitlementGrant {
+		payload.Events = raw
+	} else {
+		payload.Event = raw
+	}
+	return marshalMessage(payload)
+}
+
+// ChallengeBody returns the body of a webhook_callback_verification message for sub.
+func ChallengeBody(sub twitcheventsub.Subscription, challenge string) ([]byte, error) {
+	return marshalMessage(twitcheventsub.Response{Subscription: sub, Challenge: challenge})
+}
+
+// RevocationBody returns the body of a revocation message for sub.
+func RevocationBody(sub twitcheventsub.Subscription) ([]byte, error) {
+	return marshalMessage(twitcheventsub.Response{Subscription: sub})
+}
+
+// marshalMessage drops the fields a message type does not carry, Twitch never sends them empty.
+func marshalMessage(r twitcheventsub.Response) ([]byte, error) {
+	m := map[string]any{"subscription": r.Subscription}
+	if r.Challenge != "" {
+		m["challenge"] = r.Challenge
+	}
+	if len(r.Event) > 0 {
+		m["event"] = r.Event
+	}
+	if len(r.Events) > 0 {
+		m["events"] = r.Events
+	}
+	return json.Marshal(m)
+}
+
+// NewRequest builds a POST to callback carrying body with the headers Twitch sends, signed with secret.
+func NewRequest(callback, secret, messageType string, sub twitcheventsub.Subscription, body []byte) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodPost, callback, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	id := NewMessageID()
+	timestamp := time.Now().UTC().Format(time.RFC3339Nano)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderId, id)
+	req.Header.Set(HeaderRetry, "0")
+	req.Header.Set(HeaderType, messageType)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, twitcheventsub.Sign(secret, id, timestamp, body))
+	req.Header.Set(HeaderSubscriptionType, sub.Type)
+	req.Header.Set(HeaderSubscriptionVersion, sub.Version)
+	return req, nil
+}
@@ -0,0 +1,377 @@
+// Package eventsubtest provides an in-process imitation of the Twitch EventSub subscriptions API that delivers
+// signed webhook messages to a callback, for testing code built on twitcheventsub without a Twitch account.
+package eventsubtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+)
+
+const subscriptionsPath = "/eventsub/subscriptions"
+
+// Server imitates the Helix /eventsub/subscriptions endpoints. Creating a webhook subscription sends a signed
+// challenge to its callback, the subscription becomes enabled if the callback echoes it back and
+// webhook_callback_verification_failed otherwise.
+type Server struct {
+	// PageSize is the number of subscriptions returned per page when listing.
+	PageSize int
+	// MaxTotalCost is the cost limit, creating a subscription that exceeds it fails with a 429.
+	MaxTotalCost int
+	// Cost returns the cost of a new subscription, by default every subscription costs 1 except
+	// the user.authorization ones, which are free.
+	Cost func(req twitcheventsub.SubscriptionRequest) int
+	// HTTPClient delivers messages to the callbacks.
+	HTTPClient *http.Client
+
+	srv     *httptest.Server
+	mu      sync.Mutex
+	subs    []*subscription
+	nextId  int
+	pending sync.WaitGroup
+}
+
+type subscription struct {
+	sub    twitcheventsub.Subscription
+	secret string
+}
+
+// NewServer starts a Server, it must be closed with Close.
+func NewServer() *Server {
+	s := &Server{PageSize: 100, MaxTotalCost: 10000, Cost: defaultCost, HTTPClient: &http.Client{Timeout: 10 * time.Second}}
+	mux := http.NewServeMux()
+	mux.HandleFunc(subscriptionsPath, s.handle)
+	s.srv = httptest.NewServer(mux)
+	return s
+}
+
+func defaultCost(req twitcheventsub.SubscriptionRequest) int {
+	switch req.Type {
+	case twitcheventsub.UserAuthorizationGrant, twitcheventsub.UserAuthorizationRevoke:
+		return 0
+	}
+	return 1
+}
+
+// URL returns the subscriptions endpoint to use with Client.SetBaseURL.
+func (s *Server) URL() string {
+	return s.srv.URL + subscriptionsPath
+}
+
+// Close waits for pending challenges and stops the server.
+func (s *Server) Close() {
+	s.pending.Wait()
+	s.srv.Close()
+}
+
+// Wait blocks until every challenge sent so far has been answered and the subscription status updated.
+func (s *Server) Wait() {
+	s.pending.Wait()
+}
+
+// Subscriptions returns a copy of every subscription, in creation order.
+func (s *Server) Subscriptions() []twitcheventsub.Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	subs := make([]twitcheventsub.Subscription, len(s.subs))
+	for i, sub := range s.subs {
+		subs[i] = sub.sub
+	}
+	return subs
+}
+
+// Subscription returns a copy of the subscription with the given id.
+func (s *Server) Subscription(id string) (twitcheventsub.Subscription, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sub := s.find(id); sub != nil {
+		return sub.sub, true
+	}
+	return twitcheventsub.Subscription{}, false
+}
+
+// SetStatus changes the status of a subscription without notifying its callback.
+func (s *Server) SetStatus(id, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sub := s.find(id)
+	if sub == nil {
+		return fmt.Errorf("subscription %s not found", id)
+	}
+	sub.sub.Status = status
+	return nil
+}
+
+// Notify delivers a signed notification carrying event to the callback of an enabled subscription and returns
+// the status code the callback answered with.
+func (s *Server) Notify(id string, event any) (int, error) {
+	sub, secret, err := s.get(id)
+	if err != nil {
+		return 0, err
+	}
+	if sub.Status != twitcheventsub.StatusEnabled {
+		return 0, fmt.Errorf("subscription %s is %s", id, sub.Status)
+	}
+	body, err := NotificationBody(sub, event)
+	if err != nil {
+		return 0, err
+	}
+	return s.send(sub, secret, MessageTypeNotification, body)
+}
+
+// Revoke sets the status of a subscription and delivers a signed revocation to its callback, status should be
+// one of the revocation reasons like twitcheventsub.StatusAuthorizationRevoked.
+func (s *Server) Revoke(id, status string) (int, error) {
+	if err := s.SetStatus(id, status); err != nil {
+		return 0, err
+	}
+	sub, secret, err := s.get(id)
+	if err != nil {
+		return 0, err
+	}
+	body, err := RevocationBody(sub)
+	if err != nil {
+		return 0, err
+	}
+	return s.send(sub, secret, MessageTypeRevocation, body)
+}
+
+func (s *Server) get(id string) (twitcheventsub.Subscription, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sub := s.find(id)
+	if sub == nil {
+		return twitcheventsub.Subscription{}, "", fmt.Errorf("subscription %s not found", id)
+	}
+	return sub.sub, sub.secret, nil
+}
+
+// find returns the subscription with the given id, s.mu must be held.
+func (s *Server) find(id string) *subscription {
+	for _, sub := range s.subs {
+		if sub.sub.Id == id {
+			return sub
+		}
+	}
+	return nil
+}
+
+func (s *Server) send(sub twitcheventsub.Subscription, secret, messageType string, body []byte) (int, error) {
+	req, err := NewRequest(sub.Transport.Callback, secret, messageType, sub, body)
+	if err != nil {
+		return 0, err
+	}
+	res, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+	return res.StatusCode, nil
+}
+
+func (s *Server) challenge(id string) {
+	defer s.pending.Done()
+	sub, secret, err := s.get(id)
+	if err != nil {
+		return
+	}
+	challenge := NewMessageID()
+	status := twitcheventsub.StatusVerificationFailed
+	body, _ := ChallengeBody(sub, challenge)
+	if req, err := NewRequest(sub.Transport.Callback, secret, MessageTypeChallenge, sub, body); err == nil {
+		if res, err := s.HTTPClient.Do(req); err == nil {
+			answer, _ := io.ReadAll(res.Body)
+			res.Body.Close()
+			if res.StatusCode == http.StatusOK && string(answer) == challenge {
+				status = twitcheventsub.StatusEnabled
+			}
+		}
+	}
+	s.SetStatus(id, status)
+}
+
+func (s *Server) handle(w http.ResponseWriter, req *http.Request) {
+	if req.Header.Get("Client-Id") == "" || len(req.Header.Get("Authorization")) <= len("Bearer ") {
+		writeError(w, http.StatusUnauthorized, "missing authorization")
+		return
+	}
+	switch req.Method {
+	case http.MethodPost:
+		s.create(w, req)
+	case http.MethodGet:
+		s.list(w, req)
+	case http.MethodDelete:
+		s.delete(w, req)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) create(w http.ResponseWriter, req *http.Request) {
+	var subReq twitcheventsub.SubscriptionRequest
+	if err := json.NewDecoder(req.Body).Decode(&subReq); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid body: "+err.Error())
+		return
+	}
+	if err := validate(subReq); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.mu.Lock()
+	for _, sub := range s.subs {
+		if sub.sub.Type == subReq.Type && sub.sub.Version == subReq.Version && sub.sub.Condition == subReq.Condition &&
+			sub.sub.Transport.Callback == subReq.Transport.Callback {
+			s.mu.Unlock()
+			writeError(w, http.StatusConflict, "subscription already exists")
+			return
+		}
+	}
+	cost := s.Cost(subReq)
+	if s.totalCost()+cost > s.MaxTotalCost {
+		s.mu.Unlock()
+		writeError(w, http.StatusTooManyRequests, "max total cost exceeded")
+		return
+	}
+	s.nextId++
+	sub := &subscription{secret: subReq.Transport.Secret, sub: twitcheventsub.Subscription{
+		Id:        fmt.Sprintf("%08d-0000-4000-8000-000000000000", s.nextId),
+		Status:    twitcheventsub.StatusVerificationPending,
+		Type:      subReq.Type,
+		Version:   subReq.Version,
+		Cost:      cost,
+		Condition: subReq.Condition,
+		Transport: twitcheventsub.Transport{Method: subReq.Transport.Method, Callback: subReq.Transport.Callback},
+		CreatedAt: time.Now().UTC(),
+	}}
+	s.subs = append(s.subs, sub)
+	res := s.response([]twitcheventsub.Subscription{sub.sub}, "")
+	s.pending.Add(1)
+	s.mu.Unlock()
+	go s.challenge(sub.sub.Id)
+	writeJSON(w, http.StatusAccepted, res)
+}
+
+func validate(req twitcheventsub.SubscriptionRequest) error {
+	switch {
+	case req.Type == "":
+		return errors.New("missing type")
+	case req.Version == "":
+		return errors.New("missing version")
+	case req.Transport.Method != "webhook":
+		return errors.New("only the webhook transport is supported")
+	case req.Transport.Callback == "":
+		return errors.New("missing callback")
+	case len(req.Transport.Secret) < 10 || len(req.Transport.Secret) > 100:
+		return errors.New("secret must be between 10 and 100 characters")
+	}
+	return nil
+}
+
+func (s *Server) list(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	statuses := q["status"]
+	subType := q.Get("type")
+	userId := q.Get("user_id")
+	id := q.Get("subscription_id")
+	// like Helix, the filters are mutually exclusive and take a single value
+	filters := 0
+	for _, key := range []string{"status", "type", "user_id", "subscription_id"} {
+		if n := len(q[key]); n > 1 {
+			writeError(w, http.StatusBadRequest, "only one "+key+" value may be specified")
+			return
+		} else if n == 1 {
+			filters++
+		}
+	}
+	if filters > 1 {
+		writeError(w, http.StatusBadRequest, "only one of status, type, user_id and subscription_id may be specified")
+		return
+	}
+	start := 0
+	if after := q.Get("after"); after != "" {
+		n, err := strconv.Atoi(after)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, "invalid cursor")
+			return
+		}
+		start = n
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var matches []twitcheventsub.Subscription
+	for _, sub := range s.subs {
+		c := sub.sub.Condition
+		switch {
+		case len(statuses) > 0 && !slices.Contains(statuses, sub.sub.Status):
+		case subType != "" && sub.sub.Type != subType:
+		case id != "" && sub.sub.Id != id:
+		case userId != "" && c.BroadcasterUserId != userId && c.UserID != userId && c.ModeratorUserId != userId &&
+			c.FromBroadcasterUserId != userId && c.ToBroadcasterUserId != userId:
+		default:
+			matches = append(matches, sub.sub)
+		}
+	}
+	cursor := ""
+	start = min(start, len(matches))
+	end := min(start+s.PageSize, len(matches))
+	if end < len(matches) {
+		cursor = strconv.Itoa(end)
+	}
+	res := s.response(matches[start:end], cursor)
+	res.Total = len(matches)
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) delete(w http.ResponseWriter, req *http.Request) {
+	id := req.URL.Query().Get("id")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, sub := range s.subs {
+		if sub.sub.Id == id {
+			s.subs = slices.Delete(s.subs, i, i+1)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "subscription not found")
+}
+
+// totalCost only counts enabled and pending subscriptions like Twitch does, s.mu must be held.
+func (s *Server) totalCost() int {
+	total := 0
+	for _, sub := range s.subs {
+		if sub.sub.Status == twitcheventsub.StatusEnabled || sub.sub.Status == twitcheventsub.StatusVerificationPending {
+			total += sub.sub.Cost
+		}
+	}
+	return total
+}
+
+// response builds a list response, s.mu must be held.
+func (s *Server) response(data []twitcheventsub.Subscription, cursor string) twitcheventsub.SubscriptionResponse {
+	if data == nil {
+		data = []twitcheventsub.Subscription{}
+	}
+	return twitcheventsub.SubscriptionResponse{Data: data, Total: len(s.subs), TotalCost: s.totalCost(),
+		MaxTotalCost: s.MaxTotalCost, Pagination: twitcheventsub.Pagination{Cursor: cursor}}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]any{"error": http.StatusText(status), "status": status, "message": msg})
+}
@@ -0,0 +1,206 @@
+package eventsubtest_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+const (
+	testSecret   = "test-secret-value"
+	testToken    = "test-token"
+	testClientID = "test-client-id"
+)
+
+// newClient returns a client whose callback answers the challenges of a new mock Helix, after calling
+// challenge when it is not nil.
+func newClient(t *testing.T, challenge func(sub twitcheventsub.Subscription) error) (*twitcheventsub.Client, *eventsubtest.Server) {
+	t.Helper()
+	var c *twitcheventsub.Client
+	callback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		c.HandleEvent(w, req)
+	}))
+	t.Cleanup(callback.Close)
+	c = twitcheventsub.NewClient(testSecret, callback.URL)
+	if challenge != nil {
+		c.OnChallenge(challenge)
+	}
+	helix := eventsubtest.NewServer()
+	t.Cleanup(helix.Close)
+	c.SetBaseURL(helix.URL())
+	return c, helix
+}
+
+func cheer(broadcaster string) twitcheventsub.SubscriptionRequest {
+	return twitcheventsub.SubscriptionRequest{Type: twitcheventsub.Cheer, Version: "1",
+		Condition: twitcheventsub.Condition{BroadcasterUserId: broadcaster}}
+}
+
+func create(t *testing.T, c *twitcheventsub.Client, subReq twitcheventsub.SubscriptionRequest) twitcheventsub.Subscription {
+	t.Helper()
+	res, err := c.CreateSubscription(subReq, testToken, testClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.Data[0]
+}
+
+func TestServerChallenge(t *testing.T) {
+	release := make(chan struct{})
+	c, helix := newClient(t, func(sub twitcheventsub.Subscription) error {
+		<-release
+		if sub.Condition.BroadcasterUserId == "refused" {
+			return errors.New("unknown broadcaster")
+		}
+		return nil
+	})
+	enabled := create(t, c, cheer("1"))
+	failed := create(t, c, cheer("refused"))
+	for _, sub := range []twitcheventsub.Subscription{enabled, failed} {
+		if sub.Status != twitcheventsub.StatusVerificationPending {
+			t.Errorf("created with status %s, want %s", sub.Status, twitcheventsub.StatusVerificationPending)
+		}
+		if got, _ := helix.Subscription(sub.Id); got.Status != twitcheventsub.StatusVerificationPending {
+			t.Errorf("status %s before the challenge was answered", got.Status)
+		}
+	}
+	close(release)
+	helix.Wait()
+	if got, _ := helix.Subscription(enabled.Id); got.Status != twitcheventsub.StatusEnabled {
+		t.Errorf("answered challenge: status %s, want %s", got.Status, twitcheventsub.StatusEnabled)
+	}
+	if got, _ := helix.Subscription(failed.Id); got.Status != twitcheventsub.StatusVerificationFailed {
+		t.Errorf("refused challenge: status %s, want %s", got.Status, twitcheventsub.StatusVerificationFailed)
+	}
+}
+
+func TestServerPagination(t *testing.T) {
+	c, helix := newClient(t, nil)
+	helix.PageSize = 2
+	for _, id := range []string{"1", "2", "3", "4", "5"} {
+		create(t, c, cheer(id))
+	}
+	helix.Wait()
+	var ids []string
+	pages, after := 0, ""
+	for {
+		res, err := c.GetSubscriptions(testToken, testClientID, "", "", after, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages++
+		if res.Total != 5 {
+			t.Errorf("page %d: total %d, want 5", pages, res.Total)
+		}
+		for _, sub := range res.Data {
+			ids = append(ids, sub.Condition.BroadcasterUserId)
+		}
+		if res.Pagination.Cursor == "" {
+			break
+		}
+		after = res.Pagination.Cursor
+	}
+	if pages != 3 || strings.Join(ids, ",") != "1,2,3,4,5" {
+		t.Errorf("listed %v in %d pages, want 1 to 5 in 3", ids, pages)
+	}
+}
+
+func TestServerCost(t *testing.T) {
+	c, helix := newClient(t, nil)
+	helix.MaxTotalCost = 2
+	first := create(t, c, cheer("1"))
+	res, err := c.CreateSubscription(cheer("2"), testToken, testClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalCost != 2 || res.MaxTotalCost != 2 {
+		t.Errorf("total cost %d of %d, want 2 of 2", res.TotalCost, res.MaxTotalCost)
+	}
+	if _, err := c.CreateSubscription(cheer("3"), testToken, testClientID); err == nil ||
+		!strings.Contains(err.Error(), "429") {
+		t.Errorf("CreateSubscription over the max total cost = %v, want a 429", err)
+	}
+	// authorization subscriptions are free
+	if _, err := c.CreateSubscription(twitcheventsub.SubscriptionRequest{Type: string(twitcheventsub.UserAuthorizationGrant),
+		Version: "1", Condition: twitcheventsub.Condition{ClientID: testClientID}}, testToken, testClientID); err != nil {
+		t.Errorf("free subscription refused: %v", err)
+	}
+	// failed subscriptions do not count
+	helix.Wait()
+	if err := helix.SetStatus(first.Id, twitcheventsub.StatusVerificationFailed); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateSubscription(cheer("3"), testToken, testClientID); err != nil {
+		t.Errorf("CreateSubscription after a failure = %v", err)
+	}
+}
+
+func TestServerDuplicate(t *testing.T) {
+	c, _ := newClient(t, nil)
+	create(t, c, cheer("1"))
+	if _, err := c.CreateSubscription(cheer("1"), testToken, testClientID); !errors.Is(err, twitcheventsub.ErrSubscriptionExists) {
+		t.Errorf("duplicate CreateSubscription = %v, want ErrSubscriptionExists", err)
+	}
+	other := cheer("1")
+	other.Transport = twitcheventsub.Transport{Method: "webhook", Callback: "https://example.com/other", Secret: testSecret}
+	if _, err := c.CreateSubscription(other, testToken, testClientID); err != nil {
+		t.Errorf("same subscription on another callback refused: %v", err)
+	}
+}
+
+func TestServerListFilters(t *testing.T) {
+	c, helix := newClient(t, nil)
+	create(t, c, cheer("1"))
+	create(t, c, twitcheventsub.SubscriptionRequest{Type: twitcheventsub.Follow, Version: "2",
+		Condition: twitcheventsub.Condition{BroadcasterUserId: "2", ModeratorUserId: "2"}})
+	helix.Wait()
+	tests := []struct {
+		query  url.Values
+		status int
+		n      int
+	}{
+		{url.Values{"type": {twitcheventsub.Follow}}, http.StatusOK, 1},
+		{url.Values{"user_id": {"1"}}, http.StatusOK, 1},
+		{url.Values{"status": {twitcheventsub.StatusEnabled}}, http.StatusOK, 2},
+		{url.Values{"type": {twitcheventsub.Follow}, "user_id": {"2"}}, http.StatusBadRequest, 0},
+		{url.Values{"status": {twitcheventsub.StatusEnabled, twitcheventsub.StatusVerificationFailed}},
+			http.StatusBadRequest, 0},
+		{url.Values{"after": {"not-a-cursor"}}, http.StatusBadRequest, 0},
+	}
+	for _, tt := range tests {
+		status, subs := list(t, helix, tt.query)
+		if status != tt.status || len(subs.Data) != tt.n {
+			t.Errorf("%s: status %d with %d subscriptions, want %d with %d", tt.query.Encode(), status,
+				len(subs.Data), tt.status, tt.n)
+		}
+	}
+}
+
+func list(t *testing.T, helix *eventsubtest.Server, query url.Values) (int, twitcheventsub.SubscriptionResponse) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, helix.URL()+"?"+query.Encode(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	req.Header.Set("Client-Id", testClientID)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	var subs twitcheventsub.SubscriptionResponse
+	if res.StatusCode == http.StatusOK {
+		if err := json.NewDecoder(res.Body).Decode(&subs); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return res.StatusCode, subs
+}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"os"
 	"os/signal"
-	"sync"
 	"syscall"
 
 	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
@@ -35,34 +33,21 @@ func main() {
 		fmt.Println("Subscription created: " + sub.Data[0].Id)
 	}
 
-	//Setup the tls server to listen for incoming events with the handler function
-	srv := &http.Server{Addr: ":443"}
-	stop := &sync.WaitGroup{}
-	stop.Add(1)
-	http.HandleFunc("/eventsub", client.HandleEvent)
-	defer stop.Done()
-	go func() {
-		if err := srv.ListenAndServeTLS(crtPath, keyPath); err != http.ErrServerClosed {
-			panic("eventsub start server: " + err.Error())
-		}
-	}()
-
-	//Wait for an os.Signal to delete example subscription and stop server
-	quitChannel := make(chan os.Signal, 1)
-	signal.Notify(quitChannel, syscall.SIGINT, syscall.SIGTERM)
-	<-quitChannel
+	//Setup the tls server to listen for incoming events, it stops when the context is cancelled by an os.Signal
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	srv := twitcheventsub.NewServer(client, twitcheventsub.ServerConfig{Addr: ":443", Path: "/eventsub",
+		CertFile: crtPath, KeyFile: keyPath})
+	srv.Expect(sub.Data[0].Id)
+	if err := srv.ListenAndServe(ctx); err != nil && err != http.ErrServerClosed {
+		fmt.Println("eventsub server: " + err.Error())
+	}
 
 	//Delete the subscription created for the example
 	err = client.DeleteSubscription(sub.Data[0].Id, token, clientID)
 	if err != nil {
 		fmt.Println("unable to delete subscription " + sub.Data[0].Id + " " + err.Error())
 	}
-
-	//Server stop
-	if err := srv.Shutdown(context.Background()); err != nil {
-		fmt.Println("unable to stop server: " + err.Error())
-	}
-	stop.Wait()
 	fmt.Println("Closing!")
 }
 
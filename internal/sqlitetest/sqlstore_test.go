@@ -0,0 +1,112 @@
+// Package sqlitetest runs the SQLStore against an in-process SQLite database, it is its own module so the
+// driver never becomes a dependency of the library.
+package sqlitetest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+	_ "modernc.org/sqlite"
+)
+
+func newStore(t *testing.T) (*twitcheventsub.SQLStore, *sql.DB) {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "eventsub.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	store := twitcheventsub.NewSQLStore(db, twitcheventsub.SQLite)
+	if err := store.Migrate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return store, db
+}
+
+func envelope(t *testing.T, id string, f eventsubtest.Fixture) twitcheventsub.Envelope {
+	t.Helper()
+	body, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	return twitcheventsub.Envelope{MessageID: id, MessageTimestamp: now, ReceivedAt: now, Subscription: f.Subscription,
+		Body: body}
+}
+
+func count(t *testing.T, db *sql.DB, table string) int {
+	t.Helper()
+	var n int
+	if err := db.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM %s", table)).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestMigrateIdempotent(t *testing.T) {
+	store, db := newStore(t)
+	if err := store.Migrate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	var versions, latest int
+	if err := db.QueryRow("SELECT COUNT(*), MAX(version) FROM schema_migrations").Scan(&versions, &latest); err != nil {
+		t.Fatal(err)
+	}
+	if versions != latest {
+		t.Errorf("%d migrations recorded up to version %d", versions, latest)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	store, db := newStore(t)
+	ctx := context.Background()
+	envs := []twitcheventsub.Envelope{
+		envelope(t, "cheer", eventsubtest.NewChannelCheerEvent().WithBits(500).Fixture()),
+		envelope(t, "gift", eventsubtest.NewChannelSubscriptionGiftEvent().WithTotal(5).WithCumulativeTotal(42).Fixture()),
+		envelope(t, "resub", eventsubtest.NewChannelSubscriptionMessageEvent().WithCumulativeMonths(12).Fixture()),
+		envelope(t, "raid", eventsubtest.NewChannelRaidEvent().Fixture()),
+		envelope(t, "follow", eventsubtest.NewChannelFollowEvent().Fixture()),
+	}
+	for range 2 {
+		for _, env := range envs {
+			if err := store.Record(ctx, env); err != nil {
+				t.Fatalf("%s: %v", env.MessageID, err)
+			}
+		}
+	}
+
+	for table, want := range map[string]int{"deliveries": 5, "cheers": 1, "subs": 2, "raids": 1, "bans": 0,
+		"redemptions": 0} {
+		if n := count(t, db, table); n != want {
+			t.Errorf("%d rows in %s, want %d", n, table, want)
+		}
+	}
+	var bits int
+	if err := db.QueryRow("SELECT bits FROM cheers WHERE message_id = 'cheer'").Scan(&bits); err != nil {
+		t.Fatal(err)
+	}
+	if bits != 500 {
+		t.Errorf("bits = %d, want 500", bits)
+	}
+	var months, total sql.NullInt64
+	if err := db.QueryRow("SELECT cumulative_months, cumulative_total FROM subs WHERE message_id = 'gift'").
+		Scan(&months, &total); err != nil {
+		t.Fatal(err)
+	}
+	if months.Valid || total.Int64 != 42 {
+		t.Errorf("gift cumulative_months = %v, cumulative_total = %v, want NULL and 42", months, total)
+	}
+	if err := db.QueryRow("SELECT cumulative_months, cumulative_total FROM subs WHERE message_id = 'resub'").
+		Scan(&months, &total); err != nil {
+		t.Fatal(err)
+	}
+	if months.Int64 != 12 || total.Valid {
+		t.Errorf("resub cumulative_months = %v, cumulative_total = %v, want 12 and NULL", months, total)
+	}
+}
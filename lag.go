@@ -0,0 +1,125 @@
+package twitcheventsub
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// lagWindow is the number of recent deliveries the percentiles are computed from, per subscription type.
+const lagWindow = 1024
+
+// LagStats describes the delivery lag of the recent notifications of a subscription type, the time between
+// Twitch-Eventsub-Message-Timestamp and the moment HandleEvent received the message.
+type LagStats struct {
+	Count int
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+type lagTracker struct {
+	mu      sync.Mutex
+	samples map[string]*lagSamples
+
+	threshold   time.Duration
+	onThreshold func(env Envelope, lag time.Duration)
+	maxAge      time.Duration
+	maxAgeTypes []string
+}
+
+// lagSamples is a ring of the last lagWindow lags.
+type lagSamples struct {
+	values []time.Duration
+	next   int
+}
+
+func newLagTracker() *lagTracker {
+	return &lagTracker{samples: map[string]*lagSamples{}}
+}
+
+// OnLagThreshold calls f for every notification delivered more than threshold after Twitch sent it,
+// f runs before the notification is dispatched so it must not block.
+func (c *Client) OnLagThreshold(threshold time.Duration, f func(env Envelope, lag time.Duration)) {
+	c.lag.mu.Lock()
+	defer c.lag.mu.Unlock()
+	c.lag.threshold, c.lag.onThreshold = threshold, f
+}
+
+// SetMaxEventAge acknowledges notifications of the given types that were sent more than age ago without
+// dispatching them, for time-sensitive events like channel.poll.progress that are useless once late. Without
+// types it applies to every subscription type, an age of zero disables it.
+func (c *Client) SetMaxEventAge(age time.Duration, types ...EventType) {
+	c.lag.mu.Lock()
+	defer c.lag.mu.Unlock()
+	c.lag.maxAge = age
+	c.lag.maxAgeTypes = nil
+	for _, t := range types {
+		c.lag.maxAgeTypes = append(c.lag.maxAgeTypes, string(t))
+	}
+}
+
+// Lag returns the delivery lag of the recent notifications of a subscription type.
+func (c *Client) Lag(subType EventType) LagStats {
+	c.lag.mu.Lock()
+	defer c.lag.mu.Unlock()
+	return c.lag.samples[string(subType)].stats()
+}
+
+// LagByType returns the delivery lag of every subscription type received so far.
+func (c *Client) LagByType() map[EventType]LagStats {
+	c.lag.mu.Lock()
+	defer c.lag.mu.Unlock()
+	stats := make(map[EventType]LagStats, len(c.lag.samples))
+	for t, s := range c.lag.samples {
+		stats[EventType(t)] = s.stats()
+	}
+	return stats
+}
+
+// observe records the lag of env and reports whether it is too old to be dispatched.
+func (l *lagTracker) observe(env Envelope) (lag time.Duration, stale bool) {
+	if env.MessageTimestamp.IsZero() {
+		return 0, false
+	}
+	lag = max(env.ReceivedAt.Sub(env.MessageTimestamp), 0)
+	l.mu.Lock()
+	s, ok := l.samples[env.Subscription.Type]
+	if !ok {
+		s = &lagSamples{}
+		l.samples[env.Subscription.Type] = s
+	}
+	s.add(lag)
+	f := l.onThreshold
+	exceeded := f != nil && lag > l.threshold
+	stale = l.maxAge > 0 && lag > l.maxAge &&
+		(len(l.maxAgeTypes) == 0 || slices.Contains(l.maxAgeTypes, env.Subscription.Type))
+	l.mu.Unlock()
+	if exceeded {
+		f(env, lag)
+	}
+	return lag, stale
+}
+
+func (s *lagSamples) add(d time.Duration) {
+	if len(s.values) < lagWindow {
+		s.values = append(s.values, d)
+		return
+	}
+	s.values[s.next] = d
+	s.next = (s.next + 1) % lagWindow
+}
+
+func (s *lagSamples) stats() LagStats {
+	if s == nil || len(s.values) == 0 {
+		return LagStats{}
+	}
+	sorted := slices.Clone(s.values)
+	slices.Sort(sorted)
+	at := func(p float64) time.Duration {
+		return sorted[int(math.Ceil(p*float64(len(sorted))))-1]
+	}
+	return LagStats{Count: len(sorted), P50: at(.5), P90: at(.9), P99: at(.99), Max: sorted[len(sorted)-1]}
+}
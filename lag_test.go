@@ -0,0 +1,124 @@
+package twitcheventsub_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+// late sends the fixture to c as a notification Twitch sent lag ago and returns the status code.
+func late(t *testing.T, c *twitcheventsub.Client, f eventsubtest.Fixture, lag time.Duration) int {
+	t.Helper()
+	body, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := eventsubtest.NewRequest("http://localhost/eventsub", testSecret, eventsubtest.MessageTypeNotification,
+		f.Subscription, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := time.Now().Add(-lag).UTC().Format(time.RFC3339Nano)
+	req.Header.Set("Twitch-Eventsub-Message-Timestamp", ts)
+	req.Header.Set("Twitch-Eventsub-Message-Signature",
+		twitcheventsub.Sign(testSecret, req.Header.Get("Twitch-Eventsub-Message-Id"), ts, body))
+	status, _ := c.Process(req.Header, body)
+	return status
+}
+
+// near reports whether a measured lag is d plus the time the test took to send it.
+func near(lag, d time.Duration) bool {
+	return lag >= d && lag < d+time.Second
+}
+
+func TestLagPercentiles(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	for i := 100; i > 0; i-- {
+		late(t, c, follow(t, "1"), time.Duration(i)*time.Second)
+	}
+	late(t, c, eventsubtest.NewChannelCheerEvent().Fixture(), 0)
+
+	s := c.Lag(twitcheventsub.Follow)
+	if s.Count != 100 {
+		t.Errorf("Count = %d, want 100", s.Count)
+	}
+	for name, tt := range map[string]struct{ got, want time.Duration }{
+		"P50": {s.P50, 50 * time.Second},
+		"P90": {s.P90, 90 * time.Second},
+		"P99": {s.P99, 99 * time.Second},
+		"Max": {s.Max, 100 * time.Second},
+	} {
+		if !near(tt.got, tt.want) {
+			t.Errorf("%s = %s, want %s", name, tt.got, tt.want)
+		}
+	}
+	byType := c.LagByType()
+	if len(byType) != 2 || byType[twitcheventsub.Cheer].Count != 1 {
+		t.Errorf("LagByType = %v, want follows and one cheer", byType)
+	}
+	if s := c.Lag(twitcheventsub.Raid); s != (twitcheventsub.LagStats{}) {
+		t.Errorf("Lag of a type never received = %v, want zero", s)
+	}
+}
+
+func TestLagThreshold(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	var lags []time.Duration
+	c.OnLagThreshold(time.Minute, func(env twitcheventsub.Envelope, lag time.Duration) {
+		if env.Subscription.Type != string(twitcheventsub.Follow) {
+			t.Errorf("threshold called for %s", env.Subscription.Type)
+		}
+		lags = append(lags, lag)
+	})
+	handled := follows(c)
+	late(t, c, follow(t, "1"), time.Second)
+	late(t, c, follow(t, "2"), 2*time.Minute)
+	if len(lags) != 1 || !near(lags[0], 2*time.Minute) {
+		t.Errorf("threshold called with %v, want one lag of 2m", lags)
+	}
+	// the threshold only reports, late notifications are still dispatched
+	if len(*handled) != 2 {
+		t.Errorf("%d follows handled, want 2", len(*handled))
+	}
+}
+
+func TestMaxEventAge(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	c.SetMaxEventAge(time.Minute, twitcheventsub.Follow)
+	handled := follows(c)
+	cheers := 0
+	c.OnChannelCheer(func(twitcheventsub.ChannelCheerEvent) {
+		cheers++
+	})
+
+	if status := late(t, c, follow(t, "1"), 2*time.Minute); status != http.StatusNoContent {
+		t.Errorf("stale notification: status = %d, want %d so Twitch does not redeliver", status, http.StatusNoContent)
+	}
+	late(t, c, follow(t, "2"), time.Second)
+	late(t, c, eventsubtest.NewChannelCheerEvent().Fixture(), 2*time.Minute)
+	if len(*handled) != 1 || (*handled)[0] != "2" {
+		t.Errorf("follows handled %v, want only the fresh one", *handled)
+	}
+	if cheers != 1 {
+		t.Errorf("%d cheers handled, want the type without a max age", cheers)
+	}
+	// stale notifications still count in the lag
+	if n := c.Lag(twitcheventsub.Follow).Count; n != 2 {
+		t.Errorf("Count = %d, want 2", n)
+	}
+
+	// without types the age applies to all of them, zero disables it
+	c.SetMaxEventAge(time.Minute)
+	late(t, c, eventsubtest.NewChannelCheerEvent().Fixture(), 2*time.Minute)
+	c.SetMaxEventAge(0)
+	late(t, c, follow(t, "3"), time.Hour)
+	if cheers != 1 || len(*handled) != 2 {
+		t.Errorf("%d cheers and %d follows handled, want 1 and 2", cheers, len(*handled))
+	}
+}
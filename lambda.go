@@ -0,0 +1,139 @@
+package twitcheventsub
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// APIGatewayProxyRequest is the part of an API Gateway REST API (payload format 1.0) event read by
+// HandleAPIGatewayProxy, it decodes from the same JSON as events.APIGatewayProxyRequest of aws-lambda-go.
+type APIGatewayProxyRequest struct {
+	Resource                        string              `json:"resource"`
+	Path                            string              `json:"path"`
+	HTTPMethod                      string              `json:"httpMethod"`
+	Headers                         map[string]string   `json:"headers"`
+	MultiValueHeaders               map[string][]string `json:"multiValueHeaders"`
+	QueryStringParameters           map[string]string   `json:"queryStringParameters"`
+	MultiValueQueryStringParameters map[string][]string `json:"multiValueQueryStringParameters"`
+	Body                            string              `json:"body"`
+	IsBase64Encoded                 bool                `json:"isBase64Encoded"`
+}
+
+// APIGatewayProxyResponse is the response of HandleAPIGatewayProxy.
+type APIGatewayProxyResponse struct {
+	StatusCode        int                 `json:"statusCode"`
+	Headers           map[string]string   `json:"headers"`
+	MultiValueHeaders map[string][]string `json:"multiValueHeaders"`
+	Body              string              `json:"body"`
+	IsBase64Encoded   bool                `json:"isBase64Encoded"`
+}
+
+// APIGatewayV2HTTPRequest is the part of an API Gateway HTTP API (payload format 2.0) event read by
+// HandleAPIGatewayV2, it decodes from the same JSON as events.APIGatewayV2HTTPRequest of aws-lambda-go.
+type APIGatewayV2HTTPRequest struct {
+	Version               string            `json:"version"`
+	RouteKey              string            `json:"routeKey"`
+	RawPath               string            `json:"rawPath"`
+	RawQueryString        string            `json:"rawQueryString"`
+	Cookies               []string          `json:"cookies,omitempty"`
+	Headers               map[string]string `json:"headers"`
+	QueryStringParameters map[string]string `json:"queryStringParameters,omitempty"`
+	Body                  string            `json:"body,omitempty"`
+	IsBase64Encoded       bool              `json:"isBase64Encoded"`
+}
+
+// APIGatewayV2HTTPResponse is the response of HandleAPIGatewayV2.
+type APIGatewayV2HTTPResponse struct {
+	StatusCode      int               `json:"statusCode"`
+	Headers         map[string]string `json:"headers"`
+	Body            string            `json:"body"`
+	IsBase64Encoded bool              `json:"isBase64Encoded,omitempty"`
+	Cookies         []string          `json:"cookies"`
+}
+
+// Lambda Function URLs use the payload format 2.0 of API Gateway HTTP APIs.
+type (
+	LambdaFunctionURLRequest  = APIGatewayV2HTTPRequest
+	LambdaFunctionURLResponse = APIGatewayV2HTTPResponse
+)
+
+// HandleAPIGatewayProxy processes a webhook message received through an API Gateway REST API, it has the
+// signature lambda.Start expects:
+//
+//	lambda.Start(client.HandleAPIGatewayProxy)
+//
+// Like the other Lambda handlers it only returns once the handlers of the message are done, whatever the sync
+// mode, since Lambda freezes the goroutines still running when the function returns. SetSyncMode(true) is still
+// needed for a failing handler to make Twitch redeliver the message.
+func (c *Client) HandleAPIGatewayProxy(ctx context.Context, req APIGatewayProxyRequest) (APIGatewayProxyResponse, error) {
+	headers := http.Header{}
+	for k, values := range req.MultiValueHeaders {
+		for _, v := range values {
+			headers.Add(k, v)
+		}
+	}
+	if len(req.MultiValueHeaders) == 0 {
+		for k, v := range req.Headers {
+			headers.Set(k, v)
+		}
+	}
+	status, body := c.processLambda(ctx, headers, req.Body, req.IsBase64Encoded)
+	return APIGatewayProxyResponse{StatusCode: status, Headers: lambdaHeaders(body), Body: string(body)}, nil
+}
+
+// HandleAPIGatewayV2 processes a webhook message received through an API Gateway HTTP API, see
+// HandleAPIGatewayProxy.
+func (c *Client) HandleAPIGatewayV2(ctx context.Context, req APIGatewayV2HTTPRequest) (APIGatewayV2HTTPResponse, error) {
+	headers := http.Header{}
+	for k, v := range req.Headers {
+		headers.Set(k, v)
+	}
+	status, body := c.processLambda(ctx, headers, req.Body, req.IsBase64Encoded)
+	return APIGatewayV2HTTPResponse{StatusCode: status, Headers: lambdaHeaders(body), Body: string(body)}, nil
+}
+
+// HandleFunctionURL processes a webhook message received through a Lambda Function URL, see
+// HandleAPIGatewayProxy.
+func (c *Client) HandleFunctionURL(ctx context.Context, req LambdaFunctionURLRequest) (LambdaFunctionURLResponse, error) {
+	return c.HandleAPIGatewayV2(ctx, req)
+}
+
+// processLambda runs Process on an event body and waits for the handlers it started, until the invocation
+// deadline carried by ctx.
+func (c *Client) processLambda(ctx context.Context, headers http.Header, body string, isBase64 bool) (int, []byte) {
+	raw := []byte(body)
+	if isBase64 {
+		var err error
+		raw, err = base64.StdEncoding.DecodeString(body)
+		if err != nil {
+			c.onError(errors.New("error decoding base64 body: " + err.Error()))
+			msgType, subType, version := messageLabels(headers.Get(headerType), nil)
+			c.metrics.Message(msgType, subType, version, OutcomeBadRequest)
+			return http.StatusBadRequest, nil
+		}
+	}
+	// only the handlers of this message are waited for, concurrent invocations keep their own count
+	var wg sync.WaitGroup
+	status, res := c.ProcessContext(context.WithValue(ctx, invocationKey{}, &wg), headers, raw)
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		c.onError(errors.New("error waiting for handlers: " + ctx.Err().Error()))
+	}
+	return status, res
+}
+
+func lambdaHeaders(body []byte) map[string]string {
+	if len(body) == 0 {
+		return nil
+	}
+	return map[string]string{contentType: textPlain}
+}
@@ -0,0 +1,123 @@
+package twitcheventsub_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+// lambdaMessage returns the signed headers and body of a message, header names are lowercased like API Gateway
+// HTTP APIs send them.
+func lambdaMessage(t *testing.T, messageType string, f eventsubtest.Fixture) (map[string]string, string) {
+	t.Helper()
+	body, err := f.Body()
+	if messageType == eventsubtest.MessageTypeChallenge {
+		body, err = eventsubtest.ChallengeBody(f.Subscription, "challenge-value")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := eventsubtest.NewRequest("http://localhost/eventsub", testSecret, messageType, f.Subscription, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headers := map[string]string{}
+	for k := range req.Header {
+		headers[strings.ToLower(k)] = req.Header.Get(k)
+	}
+	return headers, string(body)
+}
+
+func TestLambdaV1Base64(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	var userID string
+	c.OnChannelFollow(func(event twitcheventsub.ChannelFollowEvent) {
+		userID = event.UserID
+	})
+	headers, body := lambdaMessage(t, eventsubtest.MessageTypeNotification,
+		eventsubtest.NewChannelFollowEvent().WithUserID("1234").Fixture())
+	multi := map[string][]string{}
+	for k, v := range headers {
+		// REST APIs keep the case the client sent
+		multi[strings.ToUpper(k[:1])+k[1:]] = []string{v}
+	}
+	res, err := c.HandleAPIGatewayProxy(context.Background(), twitcheventsub.APIGatewayProxyRequest{
+		MultiValueHeaders: multi, Body: base64.StdEncoding.EncodeToString([]byte(body)), IsBase64Encoded: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusNoContent)
+	}
+	if userID != "1234" {
+		t.Errorf("user id = %q, want 1234", userID)
+	}
+
+	res, _ = c.HandleAPIGatewayProxy(context.Background(), twitcheventsub.APIGatewayProxyRequest{
+		Headers: headers, Body: "not base64!", IsBase64Encoded: true})
+	if res.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid base64: status = %d, want %d", res.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestLambdaV2Challenge(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	headers, body := lambdaMessage(t, eventsubtest.MessageTypeChallenge, eventsubtest.NewChannelFollowEvent().Fixture())
+	res, err := c.HandleFunctionURL(context.Background(), twitcheventsub.LambdaFunctionURLRequest{Headers: headers,
+		Body: body})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK || res.Body != "challenge-value" {
+		t.Fatalf("status = %d, body = %q, want 200 and challenge-value", res.StatusCode, res.Body)
+	}
+	if ct := res.Headers["Content-Type"]; ct != "text/plain" {
+		t.Errorf("Content-Type = %q, want text/plain", ct)
+	}
+}
+
+func TestLambdaWaitsForHandlers(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	var handled atomic.Bool
+	release := make(chan struct{})
+	c.OnChannelFollow(func(event twitcheventsub.ChannelFollowEvent) {
+		if event.UserID == "blocked" {
+			<-release
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+		handled.Store(true)
+	})
+	defer close(release)
+
+	// a handler still running for another invocation must not hold this one
+	headers, body := lambdaMessage(t, eventsubtest.MessageTypeNotification,
+		eventsubtest.NewChannelFollowEvent().WithUserID("blocked").Fixture())
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	c.HandleAPIGatewayV2(ctx, twitcheventsub.APIGatewayV2HTTPRequest{Headers: headers, Body: body})
+
+	headers, body = lambdaMessage(t, eventsubtest.MessageTypeNotification, eventsubtest.NewChannelFollowEvent().Fixture())
+	start := time.Now()
+	res, err := c.HandleAPIGatewayV2(context.Background(), twitcheventsub.APIGatewayV2HTTPRequest{Headers: headers,
+		Body: body})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusNoContent)
+	}
+	if !handled.Load() {
+		t.Error("returned before the handler finished")
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("returned after %s, waiting on another invocation", d)
+	}
+}
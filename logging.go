@@ -0,0 +1,104 @@
+package twitcheventsub
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SetLogger makes the client emit structured records to l, every delivery is logged once it is answered with
+// message_id, message_type, subscription_id, type, broadcaster_id, outcome, status and latency fields: at debug
+// level when it was accepted, warn when it was rejected and error when the handlers failed. A message rejected
+// before its signature is verified is logged without the subscription fields. A nil l goes back to OnDebug.
+func (c *Client) SetLogger(l *slog.Logger) {
+	if l == nil {
+		l = slog.New(&debugHandler{client: c})
+	}
+	c.logger = l
+}
+
+// logDelivery logs an answered delivery, the subscription fields only once its signature is verified so an
+// unsigned request cannot write arbitrary values to the logs.
+func (c *Client) logDelivery(ctx context.Context, messageId, messageType string, verified *Subscription, outcome string,
+	status int, latency time.Duration) {
+	level := slog.LevelDebug
+	switch {
+	case status >= http.StatusInternalServerError:
+		level = slog.LevelError
+	case outcome != OutcomeOK:
+		level = slog.LevelWarn
+	}
+	attrs := []slog.Attr{slog.String("message_id", messageId), slog.String("message_type", messageType)}
+	if verified != nil {
+		attrs = append(attrs, slog.String("subscription_id", verified.Id), slog.String("type", verified.Type),
+			slog.String("broadcaster_id", BroadcasterKey(*verified)))
+	}
+	attrs = append(attrs, slog.String("outcome", outcome), slog.Int("status", status), slog.Duration("latency", latency))
+	c.logger.LogAttrs(ctx, level, "eventsub message answered", attrs...)
+}
+
+// observeAPICall reports a subscription API call to the metrics and the logger.
+func (c *Client) observeAPICall(operation string, start time.Time, res *http.Response, err error, args ...any) {
+	latency := time.Since(start)
+	outcome := apiOutcome(res, err)
+	c.metrics.APICall(operation, outcome, latency)
+	level := slog.LevelDebug
+	if err != nil || res.StatusCode >= http.StatusBadRequest {
+		level = slog.LevelWarn
+	}
+	args = append(args, "operation", operation, "outcome", outcome, "latency", latency)
+	if err != nil {
+		args = append(args, "error", err)
+	}
+	c.logger.Log(context.Background(), level, "subscription api call", args...)
+}
+
+// debugHandler is the default slog.Handler of a client, it formats records as text for OnDebug
+// when SetDebug is enabled.
+type debugHandler struct {
+	client *Client
+	prefix string
+	attrs  string
+}
+
+func (h *debugHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.client.debug
+}
+
+func (h *debugHandler) Handle(ctx context.Context, r slog.Record) error {
+	var b strings.Builder
+	b.WriteString(r.Message)
+	b.WriteString(h.attrs)
+	r.Attrs(func(a slog.Attr) bool {
+		h.write(&b, a)
+		return true
+	})
+	h.client.onDebug(b.String())
+	return nil
+}
+
+func (h *debugHandler) write(b *strings.Builder, a slog.Attr) {
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	fmt.Fprintf(b, " %s%s=%v", h.prefix, a.Key, a.Value.Resolve())
+}
+
+func (h *debugHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var b strings.Builder
+	b.WriteString(h.attrs)
+	for _, a := range attrs {
+		h.write(&b, a)
+	}
+	return &debugHandler{client: h.client, prefix: h.prefix, attrs: b.String()}
+}
+
+func (h *debugHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &debugHandler{client: h.client, prefix: h.prefix + name + ".", attrs: h.attrs}
+}
@@ -0,0 +1,89 @@
+package twitcheventsub_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+// answered returns the delivery records written to buf as JSON objects.
+func answered(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var r map[string]any
+		if err := dec.Decode(&r); err != nil {
+			t.Fatal(err)
+		}
+		if r["msg"] == "eventsub message answered" {
+			records = append(records, r)
+		}
+	}
+	return records
+}
+
+func TestLogDelivery(t *testing.T) {
+	var buf bytes.Buffer
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	c.SetLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	c.OnError(func(error) {})
+	twitcheventsub.Handle(c, twitcheventsub.Cheer, func(ctx context.Context, event twitcheventsub.ChannelCheerEvent) error {
+		return errors.New("handler failed")
+	})
+	h := http.HandlerFunc(c.HandleEvent)
+
+	accepted := follow(t, "1")
+	send(t, h, "http://localhost/eventsub", testSecret, accepted)
+	rejected, err := follow(t, "1").WithBroadcaster("injected\nvalue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	send(t, h, "http://localhost/eventsub", "wrong-secret-value", rejected)
+	send(t, h, "http://localhost/eventsub", testSecret, eventsubtest.NewChannelCheerEvent().Fixture())
+
+	records := answered(t, &buf)
+	if len(records) != 3 {
+		t.Fatalf("%d deliveries logged, want 3", len(records))
+	}
+	tests := []struct {
+		name   string
+		want   map[string]any
+		fields []string
+	}{
+		{"accepted", map[string]any{"level": "DEBUG", "message_type": "notification", "outcome": "ok", "status": 204.0,
+			"subscription_id": accepted.Subscription.Id, "type": "channel.follow", "broadcaster_id": "1"},
+			[]string{"message_id", "latency"}},
+		{"rejected", map[string]any{"level": "WARN", "message_type": "notification", "outcome": "invalid_signature",
+			"status": 403.0}, []string{"message_id", "latency"}},
+		{"handler error", map[string]any{"level": "ERROR", "status": 500.0, "type": "channel.cheer"},
+			[]string{"subscription_id", "broadcaster_id"}},
+	}
+	for i, tt := range tests {
+		r := records[i]
+		for key, value := range tt.want {
+			if r[key] != value {
+				t.Errorf("%s: %s = %v, want %v", tt.name, key, r[key], value)
+			}
+		}
+		for _, key := range tt.fields {
+			if _, ok := r[key]; !ok {
+				t.Errorf("%s: no %s field", tt.name, key)
+			}
+		}
+	}
+	// nothing from the body of an unverified request reaches the logs
+	for _, key := range []string{"subscription_id", "type", "broadcaster_id"} {
+		if v, ok := records[1][key]; ok {
+			t.Errorf("rejected: %s = %v logged before the signature was verified", key, v)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package twitcheventsub
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Outcomes reported to Metrics.
+const (
+	OutcomeOK               = "ok"
+	OutcomeBadRequest       = "bad_request"
+	OutcomeUnknownSecret    = "unknown_secret"
+	OutcomeInvalidSignature = "invalid_signature"
+	OutcomeRefused          = "refused"
+	OutcomeSinkError        = "sink_error"
+	OutcomeStale            = "stale"
+	OutcomePublishError     = "publish_error"
+	OutcomeUnknownType      = "unknown_type"
+	OutcomeError            = "error"
+	OutcomeTimeout          = "timeout"
+)
+
+// LabelUnverified replaces the labels Metrics.Message takes from a request until its signature is verified, so
+// unsigned requests cannot create new series.
+const LabelUnverified = "unverified"
+
+// Metrics receives the measurements of a client, PrometheusMetrics is the built-in implementation.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// Message counts a webhook message by message type, subscription type and version once HandleEvent answers it.
+	// The labels are LabelUnverified when the message was rejected before its signature was verified.
+	Message(messageType, subType, version, outcome string)
+	// Handled observes how long the listeners and handlers of a notification ran.
+	Handled(subType, version, outcome string, d time.Duration)
+	// ParseError counts events that could not be decoded into their struct.
+	ParseError(subType, version string)
+	// APICall observes a subscription API call, operation is create, list or delete and outcome the status code
+	// or error when the request could not be sent.
+	APICall(operation, outcome string, d time.Duration)
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) Message(messageType, subType, version, outcome string)     {}
+func (nopMetrics) Handled(subType, version, outcome string, d time.Duration) {}
+func (nopMetrics) ParseError(subType, version string)                        {}
+func (nopMetrics) APICall(operation, outcome string, d time.Duration)        {}
+
+// messageLabels returns the Message labels of a request, the subscription ones only once it is verified.
+func messageLabels(msgType string, sub *Subscription) (string, string, string) {
+	switch msgType {
+	case headerChallenge, notification, revocation:
+	default:
+		msgType = LabelUnverified
+	}
+	if sub == nil {
+		return msgType, LabelUnverified, LabelUnverified
+	}
+	return msgType, sub.Type, sub.Version
+}
+
+// SetMetrics makes the client report to m, nil disables metrics.
+func (c *Client) SetMetrics(m Metrics) {
+	if m == nil {
+		m = nopMetrics{}
+	}
+	c.metrics = m
+}
+
+// DefaultBuckets are the histogram buckets of PrometheusMetrics, in seconds.
+var DefaultBuckets = []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// PrometheusMetrics keeps the client metrics in memory and serves them in the Prometheus text format,
+// mount it on the path scraped by Prometheus.
+type PrometheusMetrics struct {
+	buckets []float64
+
+	mu       sync.Mutex
+	families map[string]*metricFamily
+}
+
+type metricFamily struct {
+	help   string
+	kind   string
+	series map[string]*metricSeries
+}
+
+type metricSeries struct {
+	value  float64
+	counts []uint64
+	sum    float64
+}
+
+const (
+	metricMessages    = "twitch_eventsub_messages_total"
+	metricHandler     = "twitch_eventsub_handler_duration_seconds"
+	metricParseErrors = "twitch_eventsub_parse_errors_total"
+	metricAPI         = "twitch_eventsub_api_request_duration_seconds"
+)
+
+// NewPrometheusMetrics creates an empty PrometheusMetrics, buckets default to DefaultBuckets.
+func NewPrometheusMetrics(buckets ...float64) *PrometheusMetrics {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+	return &PrometheusMetrics{buckets: buckets, families: map[string]*metricFamily{
+		metricMessages:    {help: "Webhook messages received.", kind: "counter", series: map[string]*metricSeries{}},
+		metricHandler:     {help: "Time spent in notification handlers.", kind: "histogram", series: map[string]*metricSeries{}},
+		metricParseErrors: {help: "Events that could not be decoded.", kind: "counter", series: map[string]*metricSeries{}},
+		metricAPI:         {help: "Subscription API call latency.", kind: "histogram", series: map[string]*metricSeries{}},
+	}}
+}
+
+func (p *PrometheusMetrics) Message(messageType, subType, version, outcome string) {
+	p.add(metricMessages, labels("message_type", messageType, "subscription_type", subType, "version", version,
+		"outcome", outcome))
+}
+
+func (p *PrometheusMetrics) Handled(subType, version, outcome string, d time.Duration) {
+	p.observe(metricHandler, labels("subscription_type", subType, "version", version, "outcome", outcome), d)
+}
+
+func (p *PrometheusMetrics) ParseError(subType, version string) {
+	p.add(metricParseErrors, labels("subscription_type", subType, "version", version))
+}
+
+func (p *PrometheusMetrics) APICall(operation, outcome string, d time.Duration) {
+	p.observe(metricAPI, labels("operation", operation, "outcome", outcome), d)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labels renders name and value pairs, escaped as the text format requires.
+func labels(kv ...string) string {
+	var b strings.Builder
+	for i := 0; i+1 < len(kv); i += 2 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(kv[i])
+		b.WriteString(`="`)
+		b.WriteString(labelEscaper.Replace(kv[i+1]))
+		b.WriteByte('"')
+	}
+	return b.String()
+}
+
+func (p *PrometheusMetrics) add(name, labels string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	f := p.families[name]
+	s, ok := f.series[labels]
+	if !ok {
+		s = &metricSeries{}
+		f.series[labels] = s
+	}
+	s.value++
+}
+
+func (p *PrometheusMetrics) observe(name, labels string, d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	f := p.families[name]
+	s, ok := f.series[labels]
+	if !ok {
+		s = &metricSeries{counts: make([]uint64, len(p.buckets))}
+		f.series[labels] = s
+	}
+	v := d.Seconds()
+	for i, b := range p.buckets {
+		if v <= b {
+			s.counts[i]++
+		}
+	}
+	s.value++
+	s.sum += v
+}
+
+func (p *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set(contentType, "text/plain; version=0.0.4")
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	names := make([]string, 0, len(p.families))
+	for name := range p.families {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		f := p.families[name]
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, f.help, name, f.kind)
+		keys := make([]string, 0, len(f.series))
+		for k := range f.series {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			s := f.series[k]
+			if f.kind == "counter" {
+				fmt.Fprintf(w, "%s{%s} %s\n", name, k, formatFloat(s.value))
+				continue
+			}
+			for i, b := range p.buckets {
+				fmt.Fprintf(w, "%s_bucket{%s,le=\"%s\"} %d\n", name, k, formatFloat(b), s.counts[i])
+			}
+			fmt.Fprintf(w, "%s_bucket{%s,le=\"+Inf\"} %s\n", name, k, formatFloat(s.value))
+			fmt.Fprintf(w, "%s_sum{%s} %s\n", name, k, formatFloat(s.sum))
+			fmt.Fprintf(w, "%s_count{%s} %s\n", name, k, formatFloat(s.value))
+		}
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// handlerOutcome maps the status code of runSync to an outcome.
+func handlerOutcome(status int) string {
+	switch status {
+	case http.StatusNoContent:
+		return OutcomeOK
+	case http.StatusServiceUnavailable:
+		return OutcomeTimeout
+	}
+	return OutcomeError
+}
+
+// apiOutcome is the status code of a subscription API response, or error when there is none.
+func apiOutcome(res *http.Response, err error) string {
+	if err != nil {
+		return OutcomeError
+	}
+	return strconv.Itoa(res.StatusCode)
+}
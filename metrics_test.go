@@ -0,0 +1,105 @@
+package twitcheventsub_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+// scrape returns the exposition served by m.
+func scrape(t *testing.T, m *twitcheventsub.PrometheusMetrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("Content-Type = %q, want the text format", ct)
+	}
+	return rec.Body.String()
+}
+
+func TestPrometheusMetrics(t *testing.T) {
+	m := twitcheventsub.NewPrometheusMetrics(10, 60)
+	c, _ := newHelix(t)
+	c.SetSyncMode(true)
+	c.SetMetrics(m)
+	c.OnChannelFollow(func(twitcheventsub.ChannelFollowEvent) {})
+	c.OnError(func(error) {})
+	h := http.HandlerFunc(c.HandleEvent)
+
+	process(t, c, follow(t, "1"))
+	process(t, c, follow(t, "2"))
+	malformed, err := follow(t, "1").WithField("followed_at", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	process(t, c, malformed)
+	send(t, h, "http://localhost/eventsub", "wrong-secret-value", follow(t, "1"))
+	// an unsigned request cannot choose the labels of its series
+	sub := twitcheventsub.Subscription{Type: "made.up", Version: "9"}
+	req, err := eventsubtest.NewRequest("http://localhost/eventsub", "wrong-secret-value", "made_up", sub, []byte("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	if _, err := c.CreateSubscription(twitcheventsub.SubscriptionRequest{Type: twitcheventsub.Cheer, Version: "1",
+		Condition: twitcheventsub.Condition{BroadcasterUserId: "1"}}, "test-token", testClientID); err != nil {
+		t.Fatal(err)
+	}
+
+	out := scrape(t, m)
+	for _, want := range []string{
+		"# HELP twitch_eventsub_messages_total Webhook messages received.\n# TYPE twitch_eventsub_messages_total counter\n",
+		`twitch_eventsub_messages_total{message_type="notification",subscription_type="channel.follow",version="2",outcome="ok"} 3` + "\n",
+		`twitch_eventsub_messages_total{message_type="notification",subscription_type="unverified",version="unverified",outcome="invalid_signature"} 1` + "\n",
+		`twitch_eventsub_messages_total{message_type="unverified",subscription_type="unverified",version="unverified",outcome="invalid_signature"} 1` + "\n",
+		"# TYPE twitch_eventsub_parse_errors_total counter\n",
+		`twitch_eventsub_parse_errors_total{subscription_type="channel.follow",version="2"} 1` + "\n",
+		"# TYPE twitch_eventsub_handler_duration_seconds histogram\n",
+		`twitch_eventsub_handler_duration_seconds_bucket{subscription_type="channel.follow",version="2",outcome="ok",le="10"} 3` + "\n",
+		`twitch_eventsub_handler_duration_seconds_bucket{subscription_type="channel.follow",version="2",outcome="ok",le="60"} 3` + "\n",
+		`twitch_eventsub_handler_duration_seconds_bucket{subscription_type="channel.follow",version="2",outcome="ok",le="+Inf"} 3` + "\n",
+		`twitch_eventsub_handler_duration_seconds_count{subscription_type="channel.follow",version="2",outcome="ok"} 3` + "\n",
+		`twitch_eventsub_handler_duration_seconds_sum{subscription_type="channel.follow",version="2",outcome="ok"} `,
+		"# TYPE twitch_eventsub_api_request_duration_seconds histogram\n",
+		`twitch_eventsub_api_request_duration_seconds_count{operation="create",outcome="202"} 1` + "\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("exposition is missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "made") {
+		t.Errorf("unsigned request labels exposed:\n%s", out)
+	}
+	// families are sorted by name
+	api := strings.Index(out, "# HELP twitch_eventsub_api_request_duration_seconds")
+	messages := strings.Index(out, "# HELP twitch_eventsub_messages_total")
+	if api < 0 || messages < api {
+		t.Errorf("families out of order:\n%s", out)
+	}
+}
+
+func TestPrometheusMetricsBuckets(t *testing.T) {
+	m := twitcheventsub.NewPrometheusMetrics(1, 0.1)
+	m.APICall("list", "200", 50*time.Millisecond)
+	m.APICall("list", "200", 500*time.Millisecond)
+	m.APICall("list", "200", 5*time.Second)
+	m.Message("notification", "a\"b\\c\nd", "1", twitcheventsub.OutcomeOK)
+	out := scrape(t, m)
+	for _, want := range []string{
+		`twitch_eventsub_api_request_duration_seconds_bucket{operation="list",outcome="200",le="0.1"} 1` + "\n" +
+			`twitch_eventsub_api_request_duration_seconds_bucket{operation="list",outcome="200",le="1"} 2` + "\n" +
+			`twitch_eventsub_api_request_duration_seconds_bucket{operation="list",outcome="200",le="+Inf"} 3` + "\n" +
+			`twitch_eventsub_api_request_duration_seconds_sum{operation="list",outcome="200"} 5.55` + "\n" +
+			`twitch_eventsub_api_request_duration_seconds_count{operation="list",outcome="200"} 3` + "\n",
+		`subscription_type="a\"b\\c\nd"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("exposition is missing %q:\n%s", want, out)
+		}
+	}
+}
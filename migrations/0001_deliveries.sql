@@ -0,0 +1,14 @@
+CREATE TABLE deliveries (
+	message_id        TEXT PRIMARY KEY,
+	subscription_id   TEXT NOT NULL,
+	type              TEXT NOT NULL,
+	version           TEXT NOT NULL,
+	broadcaster_id    TEXT NOT NULL,
+	message_timestamp TEXT NOT NULL,
+	received_at       TEXT NOT NULL,
+	payload           TEXT NOT NULL
+);
+
+CREATE INDEX deliveries_type ON deliveries (type, received_at);
+
+CREATE INDEX deliveries_broadcaster ON deliveries (broadcaster_id, received_at);
@@ -0,0 +1,69 @@
+CREATE TABLE cheers (
+	message_id          TEXT PRIMARY KEY REFERENCES deliveries (message_id),
+	broadcaster_user_id TEXT NOT NULL,
+	user_id             TEXT,
+	user_login          TEXT,
+	is_anonymous        INTEGER NOT NULL,
+	bits                INTEGER NOT NULL,
+	message             TEXT NOT NULL
+);
+
+CREATE TABLE subs (
+	message_id          TEXT PRIMARY KEY REFERENCES deliveries (message_id),
+	type                TEXT NOT NULL,
+	broadcaster_user_id TEXT NOT NULL,
+	user_id             TEXT,
+	user_login          TEXT,
+	tier                TEXT NOT NULL,
+	is_gift             INTEGER NOT NULL,
+	is_anonymous        INTEGER NOT NULL,
+	total               INTEGER,
+	cumulative_total    INTEGER,
+	cumulative_months   INTEGER,
+	message             TEXT
+);
+
+CREATE TABLE redemptions (
+	message_id          TEXT PRIMARY KEY REFERENCES deliveries (message_id),
+	redemption_id       TEXT NOT NULL,
+	broadcaster_user_id TEXT NOT NULL,
+	user_id             TEXT NOT NULL,
+	user_login          TEXT NOT NULL,
+	reward_id           TEXT NOT NULL,
+	reward_title        TEXT NOT NULL,
+	cost                INTEGER NOT NULL,
+	user_input          TEXT NOT NULL,
+	status              TEXT NOT NULL,
+	redeemed_at         TEXT NOT NULL
+);
+
+CREATE TABLE bans (
+	message_id          TEXT PRIMARY KEY REFERENCES deliveries (message_id),
+	broadcaster_user_id TEXT NOT NULL,
+	user_id             TEXT NOT NULL,
+	user_login          TEXT NOT NULL,
+	moderator_user_id   TEXT NOT NULL,
+	reason              TEXT NOT NULL,
+	is_permanent        INTEGER NOT NULL,
+	banned_at           TEXT NOT NULL,
+	ends_at             TEXT
+);
+
+CREATE TABLE raids (
+	message_id                  TEXT PRIMARY KEY REFERENCES deliveries (message_id),
+	from_broadcaster_user_id    TEXT NOT NULL,
+	from_broadcaster_user_login TEXT NOT NULL,
+	to_broadcaster_user_id      TEXT NOT NULL,
+	to_broadcaster_user_login   TEXT NOT NULL,
+	viewers                     INTEGER NOT NULL
+);
+
+CREATE INDEX cheers_broadcaster ON cheers (broadcaster_user_id);
+
+CREATE INDEX subs_broadcaster ON subs (broadcaster_user_id);
+
+CREATE INDEX redemptions_broadcaster ON redemptions (broadcaster_user_id, reward_id);
+
+CREATE INDEX bans_broadcaster ON bans (broadcaster_user_id, user_id);
+
+CREATE INDEX raids_to_broadcaster ON raids (to_broadcaster_user_id);
@@ -12,6 +12,28 @@ type Response struct {
 	Events       json.RawMessage `json:"events"`
 }
 
+// Envelope is a verified notification together with the delivery headers it was received with,
+// Body is the message exactly as Twitch sent it.
+type Envelope struct {
+	MessageID        string          `json:"message_id"`
+	MessageTimestamp time.Time       `json:"message_timestamp"`
+	ReceivedAt       time.Time       `json:"received_at"`
+	Subscription     Subscription    `json:"subscription"`
+	Body             json.RawMessage `json:"body"`
+}
+
+// Payload returns the event of the notification, or its events list for batched subscription types.
+func (e Envelope) Payload() json.RawMessage {
+	var data Response
+	if err := json.Unmarshal(e.Body, &data); err != nil {
+		return nil
+	}
+	if len(data.Event) > 0 {
+		return data.Event
+	}
+	return data.Events
+}
+
 type Subscription struct {
 	Id        string    `json:"id"`
 	Status    string    `json:"status"`
@@ -32,10 +54,11 @@ type SubscriptionResponse struct {
 }
 
 type SubscriptionRequest struct {
-	Type      string    `json:"type"`
-	Version   string    `json:"version"`
-	Condition Condition `json:"condition"`
-	Transport Transport `json:"transport"`
+	Type              string    `json:"type"`
+	Version           string    `json:"version"`
+	Condition         Condition `json:"condition"`
+	Transport         Transport `json:"transport"`
+	IsBatchingEnabled bool      `json:"is_batching_enabled,omitempty"`
 }
 
 type Condition struct {
@@ -44,6 +67,10 @@ type Condition struct {
 	UserID                string `json:"user_id"`
 	FromBroadcasterUserId string `json:"from_broadcaster_user_id"`
 	ToBroadcasterUserId   string `json:"to_broadcaster_user_id"`
+	OrganizationID        string `json:"organization_id,omitempty"`
+	CategoryID            string `json:"category_id,omitempty"`
+	CampaignID            string `json:"campaign_id,omitempty"`
+	ClientID              string `json:"client_id,omitempty"`
 }
 
 type Transport struct {
@@ -1280,15 +1307,14 @@ type ConduitTransport struct {
 	DisconnectedAt *time.Time `json:"disconnected_at"`
 }
 
+// DropEntitlementGrantEvent is a single entry of the events list sent by drop.entitlement.grant.
 type DropEntitlementGrantEvent struct {
-	Events []DropEvent `json:"events"`
-}
-
-type DropEvent struct {
 	ID   string   `json:"id"`
 	Data DropData `json:"data"`
 }
 
+type DropEvent = DropEntitlementGrantEvent
+
 type DropData struct {
 	OrganizationID string    `json:"organization_id"`
 	CategoryID     string    `json:"category_id"`
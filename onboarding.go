@@ -0,0 +1,317 @@
+package twitcheventsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"sync"
+	"time"
+)
+
+const onboardingCleanupTimeout = 30 * time.Second
+
+// OnboardingTemplate describes a subscription created for every user that authorizes the client.
+type OnboardingTemplate struct {
+	Type    EventType
+	Version string
+	// Condition builds the condition for the user, when nil the user is used as the broadcaster.
+	Condition func(userID string) Condition
+}
+
+// OnboardingStore keeps the subscriptions created for each user so they can be removed after a restart.
+type OnboardingStore interface {
+	Load(userID string) ([]string, bool, error)
+	Save(userID string, subscriptionIDs []string) error
+	Delete(userID string) error
+}
+
+// Onboarding creates the template subscriptions when a user.authorization.grant notification arrives for the
+// client id and deletes them when the matching user.authorization.revoke arrives.
+type Onboarding struct {
+	client    *Client
+	store     OnboardingStore
+	clientId  string
+	token     func() (string, error)
+	templates []OnboardingTemplate
+
+	onOnboarded  func(userID string, subs []Subscription)
+	onOffboarded func(userID string)
+
+	mu    sync.Mutex
+	locks map[string]*userLock
+}
+
+type userLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// NewOnboarding registers the authorization handlers on c, token must return an application OAuth access token.
+// The handlers return errors, so with sync mode enabled a failed onboarding is retried by Twitch. They replace
+// handlers registered with Handle for both types, reporting ErrHandlerReplaced, handlers registered with the On
+// functions still run.
+func NewOnboarding(c *Client, store OnboardingStore, clientId string, token func() (string, error), templates ...OnboardingTemplate) *Onboarding {
+	o := &Onboarding{client: c, store: store, clientId: clientId, token: token, templates: templates,
+		onOnboarded: func(userID string, subs []Subscription) {}, onOffboarded: func(userID string) {},
+		locks: map[string]*userLock{}}
+	Handle(c, UserAuthorizationGrant, o.grant)
+	Handle(c, UserAuthorizationRevoke, o.revoke)
+	return o
+}
+
+// OnOnboarded is called once the template subscriptions for a user have been created.
+func (o *Onboarding) OnOnboarded(f func(userID string, subs []Subscription)) {
+	o.onOnboarded = f
+}
+
+// OnOffboarded is called once the subscriptions of a user that revoked the authorization have been removed.
+func (o *Onboarding) OnOffboarded(f func(userID string)) {
+	o.onOffboarded = f
+}
+
+// SubscribeToAuthorizations creates the user.authorization.grant and user.authorization.revoke subscriptions
+// for the client id, existing ones are kept.
+func (o *Onboarding) SubscribeToAuthorizations() error {
+	token, err := o.token()
+	if err != nil {
+		return fmt.Errorf("unable to get token: %w", err)
+	}
+	for _, t := range []EventType{UserAuthorizationGrant, UserAuthorizationRevoke} {
+		subReq := SubscriptionRequest{Type: string(t), Version: "1", Condition: Condition{ClientID: o.clientId}}
+		if _, err := o.client.CreateSubscription(subReq, token, o.clientId); err != nil && !errors.Is(err, ErrSubscriptionExists) {
+			return fmt.Errorf("unable to subscribe to %s: %w", t, err)
+		}
+	}
+	return nil
+}
+
+func (o *Onboarding) grant(ctx context.Context, e UserAuthorizationGrantEvent) error {
+	if e.ClientID != o.clientId {
+		return nil
+	}
+	// a redelivery waits for the run it duplicates and then finds the user onboarded
+	unlock := o.lock(e.UserID)
+	defer unlock()
+	if _, ok, err := o.store.Load(e.UserID); err != nil {
+		return fmt.Errorf("unable to load onboarding state for %s: %w", e.UserID, err)
+	} else if ok {
+		return nil
+	}
+	token, err := o.token()
+	if err != nil {
+		return fmt.Errorf("unable to get token: %w", err)
+	}
+	subs := make([]Subscription, 0, len(o.templates))
+	ids := make([]string, 0, len(o.templates))
+	for _, t := range o.templates {
+		subReq := SubscriptionRequest{Type: string(t.Type), Version: t.Version,
+			Condition: Condition{BroadcasterUserId: e.UserID}}
+		if subReq.Version == "" {
+			subReq.Version = "1"
+		}
+		if t.Condition != nil {
+			subReq.Condition = t.Condition(e.UserID)
+		}
+		sub, err := o.create(ctx, subReq, token)
+		if err != nil {
+			o.deleteAll(ids, token)
+			return fmt.Errorf("unable to create %s for %s: %w", t.Type, e.UserID, err)
+		}
+		subs = append(subs, sub)
+		ids = append(ids, sub.Id)
+	}
+	if err := o.store.Save(e.UserID, ids); err != nil {
+		o.deleteAll(ids, token)
+		return fmt.Errorf("unable to save onboarding state for %s: %w", e.UserID, err)
+	}
+	o.onOnboarded(e.UserID, subs)
+	return nil
+}
+
+// create creates a template subscription, a subscription left by a run that crashed before saving the state
+// makes Twitch answer 409 and is looked up instead.
+func (o *Onboarding) create(ctx context.Context, subReq SubscriptionRequest, token string) (Subscription, error) {
+	res, err := o.client.CreateSubscriptionContext(ctx, subReq, token, o.clientId)
+	if errors.Is(err, ErrSubscriptionExists) {
+		return o.find(ctx, subReq, token)
+	}
+	if err != nil {
+		return Subscription{}, err
+	}
+	if len(res.Data) == 0 {
+		return Subscription{}, errors.New("empty response")
+	}
+	return res.Data[0], nil
+}
+
+// find returns the existing subscription matching subReq, it must use the client callback.
+func (o *Onboarding) find(ctx context.Context, subReq SubscriptionRequest, token string) (Subscription, error) {
+	after := ""
+	for {
+		res, err := o.client.GetSubscriptionsContext(ctx, token, o.clientId, subReq.Type, "", after, nil)
+		if err != nil {
+			return Subscription{}, fmt.Errorf("unable to look up existing subscription: %w", err)
+		}
+		for _, sub := range res.Data {
+			if sub.Version == subReq.Version && sub.Condition == subReq.Condition &&
+				sub.Transport.Callback == o.client.callback {
+				return sub, nil
+			}
+		}
+		if res.Pagination.Cursor == "" || len(res.Data) == 0 {
+			return Subscription{}, ErrSubscriptionExists
+		}
+		after = res.Pagination.Cursor
+	}
+}
+
+func (o *Onboarding) revoke(ctx context.Context, e UserAuthorizationRevokeEvent) error {
+	if e.ClientID != o.clientId {
+		return nil
+	}
+	unlock := o.lock(e.UserID)
+	defer unlock()
+	ids, ok, err := o.store.Load(e.UserID)
+	if err != nil {
+		return fmt.Errorf("unable to load onboarding state for %s: %w", e.UserID, err)
+	}
+	if !ok {
+		return nil
+	}
+	token, err := o.token()
+	if err != nil {
+		return fmt.Errorf("unable to get token: %w", err)
+	}
+	o.deleteAll(ids, token)
+	if err := o.store.Delete(e.UserID); err != nil {
+		return fmt.Errorf("unable to delete onboarding state for %s: %w", e.UserID, err)
+	}
+	o.onOffboarded(e.UserID)
+	return nil
+}
+
+// lock serializes the grants and revokes of a user and returns the function releasing it.
+func (o *Onboarding) lock(userID string) func() {
+	o.mu.Lock()
+	l, ok := o.locks[userID]
+	if !ok {
+		l = &userLock{}
+		o.locks[userID] = l
+	}
+	l.refs++
+	o.mu.Unlock()
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		o.mu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(o.locks, userID)
+		}
+		o.mu.Unlock()
+	}
+}
+
+// deleteAll only reports failures, Twitch disables the subscriptions of a revoked user on its own. It does not
+// use the handler context, a rollback must go on once the handler deadline has passed.
+func (o *Onboarding) deleteAll(ids []string, token string) {
+	ctx, cancel := context.WithTimeout(context.Background(), onboardingCleanupTimeout)
+	defer cancel()
+	for _, id := range ids {
+		if err := o.client.DeleteSubscriptionContext(ctx, id, token, o.clientId); err != nil {
+			o.client.onError(fmt.Errorf("unable to delete subscription %s: %w", id, err))
+		}
+	}
+}
+
+// MemoryOnboardingStore is an OnboardingStore that does not survive restarts.
+type MemoryOnboardingStore struct {
+	mu    sync.Mutex
+	users map[string][]string
+}
+
+func NewMemoryOnboardingStore() *MemoryOnboardingStore {
+	return &MemoryOnboardingStore{users: map[string][]string{}}
+}
+
+func (s *MemoryOnboardingStore) Load(userID string) ([]string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids, ok := s.users[userID]
+	return ids, ok, nil
+}
+
+func (s *MemoryOnboardingStore) Save(userID string, subscriptionIDs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[userID] = subscriptionIDs
+	return nil
+}
+
+func (s *MemoryOnboardingStore) Delete(userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.users, userID)
+	return nil
+}
+
+// FileOnboardingStore is an OnboardingStore that keeps its state in a JSON file, rewritten on every change.
+type FileOnboardingStore struct {
+	path string
+	mem  *MemoryOnboardingStore
+}
+
+// NewFileOnboardingStore loads the state in path, a missing file starts with an empty state.
+func NewFileOnboardingStore(path string) (*FileOnboardingStore, error) {
+	s := &FileOnboardingStore{path: path, mem: NewMemoryOnboardingStore()}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &s.mem.users); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", path, err)
+	}
+	if s.mem.users == nil {
+		s.mem.users = map[string][]string{}
+	}
+	return s, nil
+}
+
+func (s *FileOnboardingStore) Load(userID string) ([]string, bool, error) {
+	return s.mem.Load(userID)
+}
+
+func (s *FileOnboardingStore) Save(userID string, subscriptionIDs []string) error {
+	s.mem.mu.Lock()
+	defer s.mem.mu.Unlock()
+	users := maps.Clone(s.mem.users)
+	users[userID] = subscriptionIDs
+	return s.flush(users)
+}
+
+func (s *FileOnboardingStore) Delete(userID string) error {
+	s.mem.mu.Lock()
+	defer s.mem.mu.Unlock()
+	users := maps.Clone(s.mem.users)
+	delete(users, userID)
+	return s.flush(users)
+}
+
+// flush replaces the state file with users and only then the state in memory, so a failed write leaves both
+// unchanged and a redelivered grant onboards the user again. s.mem.mu must be held.
+func (s *FileOnboardingStore) flush(users map[string][]string) error {
+	b, err := json.Marshal(users)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(s.path, b, 0o600); err != nil {
+		return err
+	}
+	s.mem.users = users
+	return nil
+}
@@ -0,0 +1,212 @@
+package twitcheventsub_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+const testClientID = "test-client-id"
+
+var onboardingTemplates = []twitcheventsub.OnboardingTemplate{
+	{Type: twitcheventsub.Follow, Version: "2", Condition: func(userID string) twitcheventsub.Condition {
+		return twitcheventsub.Condition{BroadcasterUserId: userID, ModeratorUserId: userID}
+	}},
+	{Type: twitcheventsub.Cheer},
+}
+
+// newHelix returns a client in sync mode whose callback answers the challenges of the mock Helix it is
+// pointed at.
+func newHelix(t *testing.T) (*twitcheventsub.Client, *eventsubtest.Server) {
+	t.Helper()
+	var c *twitcheventsub.Client
+	callback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		c.HandleEvent(w, req)
+	}))
+	t.Cleanup(callback.Close)
+	c = twitcheventsub.NewClient(testSecret, callback.URL)
+	c.SetSyncMode(true)
+	helix := eventsubtest.NewServer()
+	t.Cleanup(helix.Close)
+	c.SetBaseURL(helix.URL())
+	return c, helix
+}
+
+func token() (string, error) {
+	return "test-token", nil
+}
+
+func grant(t *testing.T, c *twitcheventsub.Client, userID string) int {
+	t.Helper()
+	return process(t, c, eventsubtest.NewUserAuthorizationGrantEvent().WithClientID(testClientID).WithUserID(userID).
+		Fixture())
+}
+
+func revoke(t *testing.T, c *twitcheventsub.Client, userID string) int {
+	t.Helper()
+	return process(t, c, eventsubtest.NewUserAuthorizationRevokeEvent().WithClientID(testClientID).WithUserID(userID).
+		Fixture())
+}
+
+func subscriptionIDs(helix *eventsubtest.Server) []string {
+	var ids []string
+	for _, sub := range helix.Subscriptions() {
+		ids = append(ids, sub.Id)
+	}
+	return ids
+}
+
+func TestOnboardingGrantAndRevoke(t *testing.T) {
+	c, helix := newHelix(t)
+	store := twitcheventsub.NewMemoryOnboardingStore()
+	o := twitcheventsub.NewOnboarding(c, store, testClientID, token, onboardingTemplates...)
+	var onboarded []twitcheventsub.Subscription
+	o.OnOnboarded(func(userID string, subs []twitcheventsub.Subscription) {
+		onboarded = append(onboarded, subs...)
+	})
+	offboarded := ""
+	o.OnOffboarded(func(userID string) {
+		offboarded = userID
+	})
+
+	if status := grant(t, c, "1337"); status != http.StatusNoContent {
+		t.Fatalf("grant: status = %d, want %d", status, http.StatusNoContent)
+	}
+	// a redelivery finds the user onboarded
+	if status := grant(t, c, "1337"); status != http.StatusNoContent {
+		t.Fatalf("redelivered grant: status = %d, want %d", status, http.StatusNoContent)
+	}
+	helix.Wait()
+	subs := helix.Subscriptions()
+	if len(subs) != 2 || len(onboarded) != 2 {
+		t.Fatalf("%d subscriptions, %d onboarded, want 2", len(subs), len(onboarded))
+	}
+	if subs[0].Type != twitcheventsub.Follow || subs[0].Version != "2" || subs[0].Condition.ModeratorUserId != "1337" {
+		t.Errorf("follow subscription = %+v", subs[0])
+	}
+	if subs[1].Type != twitcheventsub.Cheer || subs[1].Version != "1" || subs[1].Condition.BroadcasterUserId != "1337" {
+		t.Errorf("cheer subscription = %+v", subs[1])
+	}
+	if ids, ok, _ := store.Load("1337"); !ok || !slices.Equal(ids, subscriptionIDs(helix)) {
+		t.Errorf("stored %v, want %v", ids, subscriptionIDs(helix))
+	}
+
+	if status := revoke(t, c, "1337"); status != http.StatusNoContent {
+		t.Fatalf("revoke: status = %d, want %d", status, http.StatusNoContent)
+	}
+	if n := len(helix.Subscriptions()); n != 0 {
+		t.Errorf("%d subscriptions left after the revoke", n)
+	}
+	if _, ok, _ := store.Load("1337"); ok || offboarded != "1337" {
+		t.Errorf("user still stored or not offboarded")
+	}
+}
+
+func TestOnboardingReusesExistingSubscription(t *testing.T) {
+	c, helix := newHelix(t)
+	// left by a run that crashed before saving its state
+	res, err := c.CreateSubscription(twitcheventsub.SubscriptionRequest{Type: twitcheventsub.Cheer, Version: "1",
+		Condition: twitcheventsub.Condition{BroadcasterUserId: "1337"}}, "test-token", testClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := twitcheventsub.NewMemoryOnboardingStore()
+	twitcheventsub.NewOnboarding(c, store, testClientID, token, onboardingTemplates...)
+	if status := grant(t, c, "1337"); status != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", status, http.StatusNoContent)
+	}
+	helix.Wait()
+	if n := len(helix.Subscriptions()); n != 2 {
+		t.Fatalf("%d subscriptions, want 2", n)
+	}
+	ids, _, _ := store.Load("1337")
+	if !slices.Contains(ids, res.Data[0].Id) {
+		t.Errorf("stored %v, want the existing %s reused", ids, res.Data[0].Id)
+	}
+}
+
+func TestOnboardingRollsBackFailedCreate(t *testing.T) {
+	c, helix := newHelix(t)
+	helix.MaxTotalCost = 1
+	store := twitcheventsub.NewMemoryOnboardingStore()
+	twitcheventsub.NewOnboarding(c, store, testClientID, token, onboardingTemplates...)
+	if status := grant(t, c, "1337"); status != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d", status, http.StatusInternalServerError)
+	}
+	helix.Wait()
+	if n := len(helix.Subscriptions()); n != 0 {
+		t.Errorf("%d subscriptions left after the rollback", n)
+	}
+	if _, ok, _ := store.Load("1337"); ok {
+		t.Error("user stored after a failed grant")
+	}
+}
+
+type failingStore struct {
+	*twitcheventsub.MemoryOnboardingStore
+}
+
+func (failingStore) Save(userID string, subscriptionIDs []string) error {
+	return errors.New("disk full")
+}
+
+func TestOnboardingRollsBackFailedSave(t *testing.T) {
+	c, helix := newHelix(t)
+	store := failingStore{twitcheventsub.NewMemoryOnboardingStore()}
+	twitcheventsub.NewOnboarding(c, store, testClientID, token, onboardingTemplates...)
+	for range 2 {
+		if status := grant(t, c, "1337"); status != http.StatusInternalServerError {
+			t.Fatalf("status = %d, want %d", status, http.StatusInternalServerError)
+		}
+		helix.Wait()
+		if n := len(helix.Subscriptions()); n != 0 {
+			t.Errorf("%d subscriptions left after the rollback", n)
+		}
+	}
+}
+
+func TestFileOnboardingStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "onboarding.json")
+	store, err := twitcheventsub.NewFileOnboardingStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save("1", []string{"a", "b"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save("2", []string{"c"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete("2"); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := twitcheventsub.NewFileOnboardingStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids, ok, _ := reloaded.Load("1"); !ok || !slices.Equal(ids, []string{"a", "b"}) {
+		t.Errorf("Load(1) = %v, %t, want [a b]", ids, ok)
+	}
+	if _, ok, _ := reloaded.Load("2"); ok {
+		t.Error("deleted user reloaded")
+	}
+}
+
+func TestFileOnboardingStoreFailedWrite(t *testing.T) {
+	store, err := twitcheventsub.NewFileOnboardingStore(filepath.Join(t.TempDir(), "missing", "onboarding.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save("1", []string{"a"}); err == nil {
+		t.Fatal("Save succeeded without a directory")
+	}
+	if _, ok, _ := store.Load("1"); ok {
+		t.Error("user kept in memory after a failed write")
+	}
+}
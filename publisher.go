@@ -0,0 +1,127 @@
+package twitcheventsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Publisher pushes notifications to a message broker. Publishers run before HandleEvent answers, whatever the
+// sync mode, so Twitch only receives a 2xx once every publisher has returned and redelivers the notification
+// when one fails. Publish must return once the broker acknowledged the message.
+type Publisher interface {
+	// Publish receives the notification and its event decoded by DecodeEvent.
+	Publish(ctx context.Context, env Envelope, event any) error
+}
+
+// Serializer turns a notification into the payload of a broker message.
+type Serializer func(env Envelope, event any) ([]byte, error)
+
+// EnvelopeJSON serializes the whole envelope, the default of the built-in publishers.
+func EnvelopeJSON(env Envelope, event any) ([]byte, error) {
+	return json.Marshal(env)
+}
+
+// EventJSON only serializes the decoded event.
+func EventJSON(env Envelope, event any) ([]byte, error) {
+	return json.Marshal(event)
+}
+
+// AddPublisher makes the client publish every notification with p, publishers run in the order they were added
+// and share the handler timeout with the sync handlers.
+func (c *Client) AddPublisher(p Publisher) {
+	c.publishers = append(c.publishers, p)
+}
+
+// publish runs the publishers until the deadline of ctx, which the sync handlers run under afterwards. An event
+// that fails to decode is published raw and reported once by parseNotification.
+func (c *Client) publish(ctx context.Context, env Envelope, event *notificationEvent) error {
+	decoded, err := event.decode()
+	if err != nil {
+		decoded = event.raw
+	}
+	for _, p := range c.publishers {
+		if err := p.Publish(ctx, env, decoded); err != nil {
+			return fmt.Errorf("unable to publish message %s: %w", env.MessageID, err)
+		}
+	}
+	return nil
+}
+
+// NATSConn is the part of a *nats.Conn used by NATSPublisher.
+type NATSConn interface {
+	Publish(subject string, data []byte) error
+	FlushWithContext(ctx context.Context) error
+}
+
+// NATSPublisher publishes notifications to NATS, flushing the connection so the server has received every
+// message before Publish returns.
+type NATSPublisher struct {
+	Conn NATSConn
+	// Subject maps a notification to its subject, twitch.eventsub.<subscription type> by default.
+	Subject   func(env Envelope) string
+	Serialize Serializer
+}
+
+// NewNATSPublisher creates a NATSPublisher with the default subjects and serialization.
+func NewNATSPublisher(conn NATSConn) *NATSPublisher {
+	return &NATSPublisher{Conn: conn, Subject: TopicByType("twitch.eventsub."), Serialize: EnvelopeJSON}
+}
+
+// TopicByType returns a Subject or Stream function that appends the subscription type to prefix.
+func TopicByType(prefix string) func(env Envelope) string {
+	return func(env Envelope) string {
+		return prefix + env.Subscription.Type
+	}
+}
+
+func (p *NATSPublisher) Publish(ctx context.Context, env Envelope, event any) error {
+	data, err := p.Serialize(env, event)
+	if err != nil {
+		return err
+	}
+	if err := p.Conn.Publish(p.Subject(env), data); err != nil {
+		return err
+	}
+	return p.Conn.FlushWithContext(ctx)
+}
+
+// RedisStreams adds entries to Redis streams, with go-redis it is a thin wrapper around XAdd:
+//
+//	func (r goRedis) XAdd(ctx context.Context, stream string, values map[string]any) (string, error) {
+//		return r.rdb.XAdd(ctx, &redis.XAddArgs{Stream: stream, Values: values}).Result()
+//	}
+type RedisStreams interface {
+	XAdd(ctx context.Context, stream string, values map[string]any) (string, error)
+}
+
+// RedisStreamPublisher adds every notification to a Redis stream, XADD only returns once the entry is stored.
+// Entries carry the message_id, type, version, broadcaster_id and received_at fields along with the serialized data.
+type RedisStreamPublisher struct {
+	Client RedisStreams
+	// Stream maps a notification to its stream, twitch:eventsub:<subscription type> by default.
+	Stream    func(env Envelope) string
+	Serialize Serializer
+}
+
+// NewRedisStreamPublisher creates a RedisStreamPublisher with the default streams and serialization.
+func NewRedisStreamPublisher(client RedisStreams) *RedisStreamPublisher {
+	return &RedisStreamPublisher{Client: client, Stream: TopicByType("twitch:eventsub:"), Serialize: EnvelopeJSON}
+}
+
+func (p *RedisStreamPublisher) Publish(ctx context.Context, env Envelope, event any) error {
+	data, err := p.Serialize(env, event)
+	if err != nil {
+		return err
+	}
+	_, err = p.Client.XAdd(ctx, p.Stream(env), map[string]any{
+		"message_id":     env.MessageID,
+		"type":           env.Subscription.Type,
+		"version":        env.Subscription.Version,
+		"broadcaster_id": BroadcasterKey(env.Subscription),
+		"received_at":    env.ReceivedAt.Format(time.RFC3339Nano),
+		"data":           string(data),
+	})
+	return err
+}
@@ -0,0 +1,144 @@
+package twitcheventsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+func TestNATSFlushedBeforeAck(t *testing.T) {
+	for _, sync := range []bool{false, true} {
+		c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+		c.SetSyncMode(sync)
+		nats := &eventsubtest.NATS{}
+		c.AddPublisher(twitcheventsub.NewNATSPublisher(nats))
+		flushed := -1
+		c.OnChannelFollow(func(event twitcheventsub.ChannelFollowEvent) {
+			flushed = len(nats.Messages())
+		})
+		if status := process(t, c, eventsubtest.NewChannelFollowEvent().Fixture()); status != http.StatusNoContent {
+			t.Fatalf("sync %t: status = %d, want %d", sync, status, http.StatusNoContent)
+		}
+		if n := len(nats.Messages("twitch.eventsub.channel.follow")); n != 1 {
+			t.Errorf("sync %t: %d messages flushed when answering, want 1", sync, n)
+		}
+		if sync && flushed != 1 {
+			t.Errorf("%d messages flushed when the handler ran, want 1", flushed)
+		}
+	}
+}
+
+func TestRedisStreamAddedBeforeAck(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	redis := &eventsubtest.RedisStreams{}
+	c.AddPublisher(twitcheventsub.NewRedisStreamPublisher(redis))
+	if status := process(t, c, eventsubtest.NewChannelFollowEvent().Fixture()); status != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", status, http.StatusNoContent)
+	}
+	entries := redis.Stream("twitch:eventsub:channel.follow")
+	if len(entries) != 1 {
+		t.Fatalf("%d entries, want 1", len(entries))
+	}
+	if entries[0].Values["type"] != "channel.follow" {
+		t.Errorf("type = %v, want channel.follow", entries[0].Values["type"])
+	}
+}
+
+func TestPublishError(t *testing.T) {
+	publishers := map[string]twitcheventsub.Publisher{
+		"nats":  twitcheventsub.NewNATSPublisher(&eventsubtest.NATS{Err: errors.New("nats unavailable")}),
+		"redis": twitcheventsub.NewRedisStreamPublisher(&eventsubtest.RedisStreams{Err: errors.New("redis unavailable")}),
+	}
+	for name, p := range publishers {
+		t.Run(name, func(t *testing.T) {
+			c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+			c.SetSyncMode(true)
+			c.AddPublisher(p)
+			called := false
+			c.OnChannelFollow(func(event twitcheventsub.ChannelFollowEvent) {
+				called = true
+			})
+			if status := process(t, c, eventsubtest.NewChannelFollowEvent().Fixture()); status != http.StatusInternalServerError {
+				t.Fatalf("status = %d, want %d", status, http.StatusInternalServerError)
+			}
+			if called {
+				t.Error("handler called after a publish error")
+			}
+		})
+	}
+}
+
+type slowPublisher time.Duration
+
+func (p slowPublisher) Publish(ctx context.Context, env twitcheventsub.Envelope, event any) error {
+	time.Sleep(time.Duration(p))
+	return nil
+}
+
+func TestPublishAndHandlersShareDeadline(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	c.SetHandlerTimeout(200 * time.Millisecond)
+	c.AddPublisher(slowPublisher(150 * time.Millisecond))
+	c.AddListener(func(ctx context.Context, env twitcheventsub.Envelope) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	start := time.Now()
+	status := process(t, c, eventsubtest.NewChannelFollowEvent().Fixture())
+	if status < 500 {
+		t.Fatalf("status = %d, want a 5xx", status)
+	}
+	if d := time.Since(start); d > 300*time.Millisecond {
+		t.Errorf("answered after %s, want about the 200ms timeout", d)
+	}
+}
+
+type eventPublisher struct {
+	events []any
+}
+
+func (p *eventPublisher) Publish(ctx context.Context, env twitcheventsub.Envelope, event any) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func TestPublishMalformedEvent(t *testing.T) {
+	f, err := eventsubtest.NewChannelFollowEvent().Fixture().WithField("followed_at", 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, handler := range []bool{false, true} {
+		c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+		c.SetSyncMode(true)
+		var reported []error
+		c.OnError(func(err error) {
+			reported = append(reported, err)
+		})
+		publisher := &eventPublisher{}
+		c.AddPublisher(publisher)
+		if handler {
+			c.OnChannelFollow(func(event twitcheventsub.ChannelFollowEvent) {
+				t.Error("handler called with a malformed event")
+			})
+		}
+		if status := process(t, c, f); status != http.StatusNoContent {
+			t.Fatalf("handler %t: status = %d, want %d", handler, status, http.StatusNoContent)
+		}
+		if len(publisher.events) != 1 {
+			t.Fatalf("handler %t: %d events published, want 1", handler, len(publisher.events))
+		}
+		if _, ok := publisher.events[0].(json.RawMessage); !ok {
+			t.Errorf("handler %t: published %T, want the raw event", handler, publisher.events[0])
+		}
+		if len(reported) != 1 {
+			t.Errorf("handler %t: reported %v, want one parse error", handler, reported)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package twitcheventsub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRelayAttempts   = 10
+	defaultRelayMinBackoff = time.Second
+	defaultRelayMaxBackoff = 5 * time.Minute
+	maxRelayBackoff        = 24 * time.Hour
+	defaultRelayWorkers    = 4
+	relayFileExt           = ".json"
+)
+
+// RelayRoute sends the notifications matching Types and Broadcasters to URL, an empty list matches everything.
+type RelayRoute struct {
+	URL          string
+	Types        []EventType
+	Broadcasters []string
+}
+
+// RelayConfig configures a Relay, zero values use the defaults.
+type RelayConfig struct {
+	Routes []RelayRoute
+	// Secret signs the forwarded messages, internal services verify them with a Client using the same secret.
+	Secret string
+	// MaxAttempts is the number of deliveries tried before a message is dropped, 10 by default.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the exponential wait between attempts, 1s and 5m by default, at most 24h.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// QueueDir keeps pending deliveries on disk so they survive a restart, without it they are only kept in memory.
+	QueueDir   string
+	Workers    int
+	HTTPClient *http.Client
+}
+
+type relayDelivery struct {
+	Name     string    `json:"-"`
+	URL      string    `json:"url"`
+	Attempt  int       `json:"attempt"`
+	NextAt   time.Time `json:"next_at"`
+	Envelope Envelope  `json:"envelope"`
+}
+
+// Relay forwards the verified notifications a client receives to internal services. Every message is posted with
+// the Twitch headers and body, re-signed with the relay secret, so internal services can run their own Client.
+// Failed deliveries are retried with exponential backoff on network errors, 429 and 5xx answers.
+type Relay struct {
+	client *Client
+	cfg    RelayConfig
+
+	mu    sync.Mutex
+	queue []*relayDelivery
+	seq   uint64
+	wake  chan struct{}
+}
+
+// NewRelay creates a Relay fed by c and loads the deliveries left in the queue directory, nothing is sent until
+// Run is called.
+func NewRelay(c *Client, cfg RelayConfig) (*Relay, error) {
+	if cfg.Secret == "" {
+		return nil, errors.New("relay secret is required")
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultRelayAttempts
+	}
+	if cfg.MinBackoff <= 0 {
+		cfg.MinBackoff = defaultRelayMinBackoff
+	}
+	if cfg.MaxBackoff < cfg.MinBackoff {
+		cfg.MaxBackoff = max(defaultRelayMaxBackoff, cfg.MinBackoff)
+	}
+	cfg.MaxBackoff = min(cfg.MaxBackoff, maxRelayBackoff)
+	cfg.MinBackoff = min(cfg.MinBackoff, cfg.MaxBackoff)
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaultRelayWorkers
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	r := &Relay{client: c, cfg: cfg, wake: make(chan struct{}, cfg.Workers)}
+	if cfg.QueueDir != "" {
+		if err := r.load(); err != nil {
+			return nil, err
+		}
+	}
+	c.AddPublisher(r)
+	return r, nil
+}
+
+// load reads the pending deliveries of a previous run, in the order they were queued.
+func (r *Relay) load() error {
+	if err := os.MkdirAll(r.cfg.QueueDir, 0o700); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(r.cfg.QueueDir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), relayFileExt) {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(r.cfg.QueueDir, e.Name()))
+		if err != nil {
+			return err
+		}
+		d := &relayDelivery{Name: e.Name()}
+		if err := json.Unmarshal(b, d); err != nil {
+			return fmt.Errorf("error decoding %s: %w", e.Name(), err)
+		}
+		r.queue = append(r.queue, d)
+	}
+	return nil
+}
+
+// Pending returns the number of deliveries waiting to be sent or retried.
+func (r *Relay) Pending() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.queue)
+}
+
+// Publish queues the deliveries of a notification, it implements Publisher. NewRelay adds the relay to the
+// client publishers, so a notification is on disk before Twitch receives the 2xx and a crash after the answer
+// loses nothing.
+func (r *Relay) Publish(ctx context.Context, env Envelope, event any) error {
+	broadcaster := BroadcasterKey(env.Subscription)
+	var deliveries []*relayDelivery
+	for _, route := range r.cfg.Routes {
+		if len(route.Types) > 0 && !slices.Contains(route.Types, EventType(env.Subscription.Type)) {
+			continue
+		}
+		if len(route.Broadcasters) > 0 && !slices.Contains(route.Broadcasters, broadcaster) {
+			continue
+		}
+		r.mu.Lock()
+		r.seq++
+		name := fmt.Sprintf("%020d-%d%s", time.Now().UnixNano(), r.seq, relayFileExt)
+		r.mu.Unlock()
+		d := &relayDelivery{Name: name, URL: route.URL, Envelope: env}
+		if err := r.persist(d); err != nil {
+			// Twitch redelivers the notification, the deliveries already written would be sent twice
+			for _, d := range deliveries {
+				r.remove(d)
+			}
+			return fmt.Errorf("unable to queue relay delivery: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	r.mu.Lock()
+	r.queue = append(r.queue, deliveries...)
+	r.mu.Unlock()
+	for range deliveries {
+		r.notify()
+	}
+	return nil
+}
+
+func (r *Relay) notify() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// persist writes a delivery to the queue directory, replacing its previous state.
+func (r *Relay) persist(d *relayDelivery) error {
+	if r.cfg.QueueDir == "" {
+		return nil
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(r.cfg.QueueDir, d.Name), b, 0o600)
+}
+
+func (r *Relay) remove(d *relayDelivery) {
+	if r.cfg.QueueDir == "" {
+		return
+	}
+	if err := os.Remove(filepath.Join(r.cfg.QueueDir, d.Name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		r.client.onError(errors.New("relay queue: " + err.Error()))
+	}
+}
+
+// Run delivers queued messages until ctx is done, deliveries still pending then stay in the queue directory.
+func (r *Relay) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for range r.cfg.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.work(ctx)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+func (r *Relay) work(ctx context.Context) {
+	// a delivery interrupted by the shutdown is requeued as due, it must not be taken again
+	for ctx.Err() == nil {
+		d, wait := r.next()
+		if d == nil {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-r.wake:
+			case <-timer.C:
+			}
+			timer.Stop()
+			continue
+		}
+		r.deliver(ctx, d)
+	}
+}
+
+// next takes the first delivery that is due out of the queue, otherwise it returns how long until one is.
+func (r *Relay) next() (*relayDelivery, time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	wait := time.Hour
+	for i, d := range r.queue {
+		if !d.NextAt.After(now) {
+			r.queue = slices.Delete(r.queue, i, i+1)
+			return d, 0
+		}
+		wait = min(wait, d.NextAt.Sub(now))
+	}
+	return nil, wait
+}
+
+func (r *Relay) deliver(ctx context.Context, d *relayDelivery) {
+	retry, err := r.post(ctx, d)
+	if err == nil {
+		r.remove(d)
+		return
+	}
+	if ctx.Err() != nil {
+		r.requeue(d)
+		return
+	}
+	d.Attempt++
+	if !retry || d.Attempt >= r.cfg.MaxAttempts {
+		r.client.onError(fmt.Errorf("relay of %s to %s dropped after %d attempts: %w", d.Envelope.MessageID, d.URL,
+			d.Attempt, err))
+		r.remove(d)
+		return
+	}
+	d.NextAt = time.Now().Add(r.backoff(d.Attempt))
+	if err := r.persist(d); err != nil {
+		r.client.onError(errors.New("relay queue: " + err.Error()))
+	}
+	r.requeue(d)
+}
+
+func (r *Relay) requeue(d *relayDelivery) {
+	r.mu.Lock()
+	r.queue = append(r.queue, d)
+	r.mu.Unlock()
+	r.notify()
+}
+
+// backoff doubles the wait for every attempt and adds up to 20% of jitter.
+func (r *Relay) backoff(attempt int) time.Duration {
+	wait := r.cfg.MinBackoff
+	for i := 1; i < attempt && wait < r.cfg.MaxBackoff; i++ {
+		wait *= 2
+	}
+	wait = min(wait, r.cfg.MaxBackoff)
+	return wait + rand.N(wait/5+1)
+}
+
+// post sends one delivery and reports whether a failure is worth retrying.
+func (r *Relay) post(ctx context.Context, d *relayDelivery) (bool, error) {
+	env := d.Envelope
+	timestamp := env.MessageTimestamp
+	if timestamp.IsZero() {
+		timestamp = env.ReceivedAt
+	}
+	ts := timestamp.UTC().Format(time.RFC3339Nano)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(env.Body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set(contentType, "application/json")
+	req.Header.Set(headerId, env.MessageID)
+	req.Header.Set(headerTimestamp, ts)
+	req.Header.Set(headerType, notification)
+	req.Header.Set(headerRetry, strconv.Itoa(d.Attempt))
+	req.Header.Set(headerSubType, env.Subscription.Type)
+	req.Header.Set(headerSubVer, env.Subscription.Version)
+	req.Header.Set(headerSignature, Sign(r.cfg.Secret, env.MessageID, ts, env.Body))
+	res, err := r.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return false, nil
+	}
+	err = errors.New("unexpected status " + res.Status)
+	return res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500, err
+}
@@ -0,0 +1,271 @@
+package twitcheventsub_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+const relaySecret = "relay-secret-value"
+
+// service is an internal service answering the relay with the statuses in order, then with 204.
+type service struct {
+	mu       sync.Mutex
+	statuses []int
+	attempts []time.Time
+}
+
+func (s *service) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts = append(s.attempts, time.Now())
+	if len(s.statuses) > 0 {
+		w.WriteHeader(s.statuses[0])
+		s.statuses = s.statuses[1:]
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *service) calls() []time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]time.Time(nil), s.attempts...)
+}
+
+// runRelay runs r until the test ends.
+func runRelay(t *testing.T, r *twitcheventsub.Relay) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+// eventually polls cond for up to a second.
+func eventually(t *testing.T, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); !cond(); time.Sleep(5 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met within a second")
+		}
+	}
+}
+
+func queued(t *testing.T, dir string) int {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(files)
+}
+
+func TestRelayForwards(t *testing.T) {
+	// the internal service runs its own client with the relay secret
+	internal := twitcheventsub.NewClient(relaySecret, "http://localhost/eventsub")
+	internal.SetSyncMode(true)
+	var mu sync.Mutex
+	var received []string
+	internal.OnChannelFollow(func(event twitcheventsub.ChannelFollowEvent) {
+		mu.Lock()
+		received = append(received, event.BroadcasterUserID)
+		mu.Unlock()
+	})
+	srv := httptest.NewServer(http.HandlerFunc(internal.HandleEvent))
+	defer srv.Close()
+
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	r, err := twitcheventsub.NewRelay(c, twitcheventsub.RelayConfig{Secret: relaySecret,
+		Routes: []twitcheventsub.RelayRoute{{URL: srv.URL, Types: []twitcheventsub.EventType{twitcheventsub.Follow},
+			Broadcasters: []string{"1"}}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	runRelay(t, r)
+	process(t, c, follow(t, "2"))
+	process(t, c, eventsubtest.NewChannelCheerEvent().Fixture())
+	process(t, c, follow(t, "1"))
+	eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 1
+	})
+	if received[0] != "1" {
+		t.Errorf("relayed the follow of %s, want 1", received[0])
+	}
+}
+
+func TestRelayBackoff(t *testing.T) {
+	s := &service{statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests,
+		http.StatusInternalServerError}}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	r, err := twitcheventsub.NewRelay(c, twitcheventsub.RelayConfig{Secret: relaySecret,
+		Routes: []twitcheventsub.RelayRoute{{URL: srv.URL}}, MinBackoff: 20 * time.Millisecond,
+		MaxBackoff: 40 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	runRelay(t, r)
+	process(t, c, follow(t, "1"))
+	eventually(t, func() bool { return len(s.calls()) == 4 })
+	attempts := s.calls()
+	// doubled from the minimum up to the maximum, plus up to 20% of jitter
+	for i, want := range []time.Duration{20 * time.Millisecond, 40 * time.Millisecond, 40 * time.Millisecond} {
+		if wait := attempts[i+1].Sub(attempts[i]); wait < want || wait > want*6/5+30*time.Millisecond {
+			t.Errorf("attempt %d after %s, want %s", i+2, wait, want)
+		}
+	}
+	eventually(t, func() bool { return r.Pending() == 0 })
+}
+
+func TestRelayRetryLimit(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		status   int
+		attempts int
+	}{
+		{"server error", http.StatusBadGateway, 3},
+		{"client error", http.StatusBadRequest, 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{statuses: []int{tt.status, tt.status, tt.status, tt.status}}
+			srv := httptest.NewServer(s)
+			defer srv.Close()
+			c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+			dropped := make(chan error, 1)
+			c.OnError(func(err error) {
+				select {
+				case dropped <- err:
+				default:
+				}
+			})
+			dir := t.TempDir()
+			r, err := twitcheventsub.NewRelay(c, twitcheventsub.RelayConfig{Secret: relaySecret,
+				Routes: []twitcheventsub.RelayRoute{{URL: srv.URL}}, MaxAttempts: 3, MinBackoff: time.Millisecond,
+				QueueDir: dir})
+			if err != nil {
+				t.Fatal(err)
+			}
+			runRelay(t, r)
+			process(t, c, follow(t, "1"))
+			select {
+			case <-dropped:
+			case <-time.After(time.Second):
+				t.Fatal("delivery not dropped")
+			}
+			if n := len(s.calls()); n != tt.attempts {
+				t.Errorf("%d attempts, want %d", n, tt.attempts)
+			}
+			if r.Pending() != 0 || queued(t, dir) != 0 {
+				t.Errorf("dropped delivery still queued")
+			}
+		})
+	}
+}
+
+func TestRelayQueueReload(t *testing.T) {
+	dir := t.TempDir()
+	s := &service{}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+	cfg := twitcheventsub.RelayConfig{Secret: relaySecret, QueueDir: dir,
+		Routes: []twitcheventsub.RelayRoute{{URL: srv.URL}, {URL: srv.URL, Types: []twitcheventsub.EventType{twitcheventsub.Follow}}}}
+
+	// the first run stops before delivering
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	r, err := twitcheventsub.NewRelay(c, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	process(t, c, follow(t, "1"))
+	if r.Pending() != 2 || queued(t, dir) != 2 {
+		t.Fatalf("%d pending and %d files, want 2 deliveries on disk", r.Pending(), queued(t, dir))
+	}
+
+	c = twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	r, err = twitcheventsub.NewRelay(c, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Pending() != 2 {
+		t.Fatalf("%d deliveries reloaded, want 2", r.Pending())
+	}
+	runRelay(t, r)
+	eventually(t, func() bool { return len(s.calls()) == 2 && queued(t, dir) == 0 })
+	if r.Pending() != 0 {
+		t.Errorf("%d deliveries pending after the run", r.Pending())
+	}
+}
+
+func TestRelayQueueError(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "queue")
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	r, err := twitcheventsub.NewRelay(c, twitcheventsub.RelayConfig{Secret: relaySecret, QueueDir: dir,
+		Routes: []twitcheventsub.RelayRoute{{URL: "http://localhost/first"}, {URL: "http://localhost/second"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	if status := process(t, c, follow(t, "1")); status != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d so Twitch redelivers", status, http.StatusInternalServerError)
+	}
+	if r.Pending() != 0 {
+		t.Errorf("%d deliveries queued after a failed write", r.Pending())
+	}
+}
+
+func TestRelayShutdown(t *testing.T) {
+	started := make(chan struct{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// the connection is only watched for the relay hanging up once the body is read
+		io.Copy(io.Discard, req.Body)
+		started <- struct{}{}
+		<-req.Context().Done()
+	}))
+	defer srv.Close()
+	dir := t.TempDir()
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	r, err := twitcheventsub.NewRelay(c, twitcheventsub.RelayConfig{Secret: relaySecret, QueueDir: dir,
+		Routes: []twitcheventsub.RelayRoute{{URL: srv.URL}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.Run(ctx)
+	}()
+	process(t, c, follow(t, "1"))
+	<-started
+	// the delivery in flight is kept for the next run
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run still delivering after the context is done")
+	}
+	if r.Pending() != 1 || queued(t, dir) != 1 {
+		t.Errorf("%d pending and %d files after the shutdown, want the interrupted delivery", r.Pending(), queued(t, dir))
+	}
+}
@@ -0,0 +1,126 @@
+package twitcheventsub
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// Router dispatches the notifications received by a client to the tenant registered for the subscription,
+// so a single endpoint can serve many broadcasters. Tenants are regular clients, only their handlers are used
+// to process notifications, and parse errors are reported to the tenant OnError. Notifications without a
+// registered tenant are handled by the client the router was created with, which acts as the fallback.
+//
+// HandleEvent routes on the request path first: mounted on a pattern like /eventsub/{tenant}, the notifications
+// of subscriptions created WithCallback for that path go to the tenant named by the path, whatever the body
+// says. Other requests, and the ones Process receives, are routed on the subscription of the body. A message
+// routed to a tenant is only verified with the secret of the tenant, or its secret resolver, and is rejected when
+// the tenant has none.
+type Router struct {
+	host    *Client
+	mu      sync.RWMutex
+	tenants map[string]*Client
+	key     func(sub Subscription) string
+	pathKey func(req *http.Request) string
+}
+
+// tenantKey is the context key of the tenant named by the request path.
+type tenantKey struct{}
+
+// NewRouter creates a Router and attaches it to c, tenants can be added and removed while c is serving requests.
+func NewRouter(c *Client) *Router {
+	r := &Router{host: c, tenants: map[string]*Client{}, key: BroadcasterKey, pathKey: TenantPathValue}
+	c.router = r
+	return r
+}
+
+// TenantPathValue is the default path key, the {tenant} wildcard of the http.ServeMux pattern HandleEvent is
+// mounted on.
+func TenantPathValue(req *http.Request) string {
+	return req.PathValue("tenant")
+}
+
+// BroadcasterKey is the default routing key, it is the broadcaster the subscription condition refers to,
+// falling back to the user for user.* subscription types.
+func BroadcasterKey(sub Subscription) string {
+	switch {
+	case sub.Condition.BroadcasterUserId != "":
+		return sub.Condition.BroadcasterUserId
+	case sub.Condition.ToBroadcasterUserId != "":
+		return sub.Condition.ToBroadcasterUserId
+	case sub.Condition.FromBroadcasterUserId != "":
+		return sub.Condition.FromBroadcasterUserId
+	}
+	return sub.Condition.UserID
+}
+
+// SetKeyFunc changes how the tenant of a subscription is found, for example to route on another condition field.
+func (r *Router) SetKeyFunc(f func(sub Subscription) string) {
+	r.mu.Lock()
+	r.key = f
+	r.mu.Unlock()
+}
+
+// SetPathKeyFunc changes how the tenant is read from the request path, for routers other than http.ServeMux that
+// do not set path values. An empty key routes the request on its body.
+func (r *Router) SetPathKeyFunc(f func(req *http.Request) string) {
+	r.mu.Lock()
+	r.pathKey = f
+	r.mu.Unlock()
+}
+
+// Add registers tenant for the given key, replacing any previous tenant.
+func (r *Router) Add(key string, tenant *Client) {
+	r.mu.Lock()
+	r.tenants[key] = tenant
+	r.mu.Unlock()
+}
+
+// Remove unregisters the tenant for the given key, its notifications go to the fallback afterwards.
+func (r *Router) Remove(key string) {
+	r.mu.Lock()
+	delete(r.tenants, key)
+	r.mu.Unlock()
+}
+
+// Tenant returns the tenant registered for the given key.
+func (r *Router) Tenant(key string) (*Client, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.tenants[key]
+	return t, ok
+}
+
+// Tenants returns the keys of all registered tenants.
+func (r *Router) Tenants() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	keys := make([]string, 0, len(r.tenants))
+	for k := range r.tenants {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// withPath returns ctx carrying the tenant named by the path of req, if any.
+func (r *Router) withPath(ctx context.Context, req *http.Request) context.Context {
+	r.mu.RLock()
+	key := r.pathKey(req)
+	r.mu.RUnlock()
+	if key == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, tenantKey{}, key)
+}
+
+// route returns the tenant of a message, the one named by the request path when there is one.
+func (r *Router) route(ctx context.Context, sub Subscription) (*Client, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	key, ok := ctx.Value(tenantKey{}).(string)
+	if !ok {
+		key = r.key(sub)
+	}
+	t, ok := r.tenants[key]
+	return t, ok
+}
@@ -0,0 +1,149 @@
+package twitcheventsub_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+// follows records the broadcasters of the follows handled by c.
+func follows(c *twitcheventsub.Client) *[]string {
+	var broadcasters []string
+	c.OnChannelFollow(func(event twitcheventsub.ChannelFollowEvent) {
+		broadcasters = append(broadcasters, event.BroadcasterUserID)
+	})
+	return &broadcasters
+}
+
+func follow(t *testing.T, broadcaster string) eventsubtest.Fixture {
+	t.Helper()
+	f, err := eventsubtest.NewChannelFollowEvent().Fixture().WithBroadcaster(broadcaster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// send delivers a notification for f signed with secret to h at url.
+func send(t *testing.T, h http.Handler, url, secret string, f eventsubtest.Fixture) int {
+	t.Helper()
+	body, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := eventsubtest.NewRequest(url, secret, eventsubtest.MessageTypeNotification, f.Subscription, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestRouterDispatch(t *testing.T) {
+	host := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	host.SetSyncMode(true)
+	r := twitcheventsub.NewRouter(host)
+	alice := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	bob := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	r.Add("1", alice)
+	r.Add("2", bob)
+	hosted, alices, bobs := follows(host), follows(alice), follows(bob)
+
+	for _, broadcaster := range []string{"1", "2", "3"} {
+		if status := process(t, host, follow(t, broadcaster)); status != http.StatusNoContent {
+			t.Fatalf("status = %d, want %d", status, http.StatusNoContent)
+		}
+	}
+	// tenants change while the client is serving
+	r.Remove("1")
+	r.Add("3", alice)
+	process(t, host, follow(t, "1"))
+	process(t, host, follow(t, "3"))
+
+	if got := strings.Join(*alices, ","); got != "1,3" {
+		t.Errorf("first tenant handled %s, want 1,3", got)
+	}
+	if got := strings.Join(*bobs, ","); got != "2" {
+		t.Errorf("second tenant handled %s, want 2", got)
+	}
+	if got := strings.Join(*hosted, ","); got != "3,1" {
+		t.Errorf("fallback handled %s, want 3,1", got)
+	}
+}
+
+func TestRouterPath(t *testing.T) {
+	host := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	host.SetSyncMode(true)
+	r := twitcheventsub.NewRouter(host)
+	alice := twitcheventsub.NewClient("alice-secret-value", "http://localhost/eventsub/alice")
+	r.Add("alice", alice)
+	hosted, alices := follows(host), follows(alice)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eventsub/{tenant}", host.HandleEvent)
+	mux.HandleFunc("/eventsub", host.HandleEvent)
+
+	// the path wins over the broadcaster of the body
+	if status := send(t, mux, "http://localhost/eventsub/alice", "alice-secret-value", follow(t, "2")); status != http.StatusNoContent {
+		t.Fatalf("tenant path: status = %d, want %d", status, http.StatusNoContent)
+	}
+	// an unknown tenant goes to the fallback
+	if status := send(t, mux, "http://localhost/eventsub/carol", testSecret, follow(t, "alice")); status != http.StatusNoContent {
+		t.Fatalf("unknown tenant path: status = %d, want %d", status, http.StatusNoContent)
+	}
+	// without a tenant in the path the body is used
+	if status := send(t, mux, "http://localhost/eventsub", "alice-secret-value", follow(t, "alice")); status != http.StatusNoContent {
+		t.Fatalf("body routing: status = %d, want %d", status, http.StatusNoContent)
+	}
+	if got := strings.Join(*alices, ","); got != "2,alice" {
+		t.Errorf("tenant handled %s, want 2,alice", got)
+	}
+	if got := strings.Join(*hosted, ","); got != "alice" {
+		t.Errorf("fallback handled %s, want alice", got)
+	}
+
+	// routers that do not set path values
+	r.SetPathKeyFunc(func(req *http.Request) string {
+		return strings.TrimPrefix(req.URL.Path, "/hooks/")
+	})
+	h := http.HandlerFunc(host.HandleEvent)
+	if status := send(t, h, "http://localhost/hooks/alice", "alice-secret-value", follow(t, "3")); status != http.StatusNoContent {
+		t.Fatalf("custom path key: status = %d, want %d", status, http.StatusNoContent)
+	}
+	if n := len(*alices); n != 3 {
+		t.Errorf("tenant handled %d follows, want 3", n)
+	}
+}
+
+func TestRouterSecrets(t *testing.T) {
+	host := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	host.SetSyncMode(true)
+	r := twitcheventsub.NewRouter(host)
+	r.Add("1", twitcheventsub.NewClient("alice-secret-value", "http://localhost/eventsub"))
+	r.Add("2", twitcheventsub.NewClient("bob-secret-value", "http://localhost/eventsub"))
+	r.Add("3", twitcheventsub.NewClient("", "http://localhost/eventsub"))
+	h := http.HandlerFunc(host.HandleEvent)
+	tests := []struct {
+		name        string
+		broadcaster string
+		secret      string
+		status      int
+	}{
+		{"tenant secret", "1", "alice-secret-value", http.StatusNoContent},
+		{"other tenant secret", "2", "bob-secret-value", http.StatusNoContent},
+		{"secret of another tenant", "2", "alice-secret-value", http.StatusForbidden},
+		{"host secret for a tenant", "1", testSecret, http.StatusForbidden},
+		{"tenant without secret", "3", testSecret, http.StatusForbidden},
+		{"unknown tenant", "4", testSecret, http.StatusNoContent},
+		{"tenant secret for the fallback", "4", "alice-secret-value", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		if status := send(t, h, "http://localhost/eventsub", tt.secret, follow(t, tt.broadcaster)); status != tt.status {
+			t.Errorf("%s: status = %d, want %d", tt.name, status, tt.status)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package twitcheventsub
+
+import (
+	"context"
+	"errors"
+)
+
+// SubscriptionOption overrides part of a subscription request before it is sent.
+type SubscriptionOption func(req *SubscriptionRequest)
+
+// WithSecret signs the subscription notifications with secret instead of the client secret,
+// HandleEvent needs a secret resolver that returns it to verify them.
+func WithSecret(secret string) SubscriptionOption {
+	return func(req *SubscriptionRequest) {
+		req.Transport.Secret = secret
+	}
+}
+
+// WithCallback delivers the subscription notifications to callback instead of the client callback,
+// for example a per tenant path like https://example.com/eventsub/{tenant} served by the same HandleEvent.
+func WithCallback(callback string) SubscriptionOption {
+	return func(req *SubscriptionRequest) {
+		req.Transport.Callback = callback
+	}
+}
+
+// WithVersion requests a subscription type version other than 1.
+func WithVersion(version string) SubscriptionOption {
+	return func(req *SubscriptionRequest) {
+		req.Version = version
+	}
+}
+
+func (c *Client) applyOptions(req *SubscriptionRequest, opts []SubscriptionOption) {
+	if len(opts) == 0 {
+		return
+	}
+	req.Transport = Transport{Method: "webhook", Callback: c.callback, Secret: c.secret}
+	for _, opt := range opts {
+		opt(req)
+	}
+}
+
+// SetSecretResolver makes HandleEvent verify each message with the secret returned by f instead of the client
+// secret. The subscription comes from the unverified body, so f should only use it to look the secret up, using
+// its id, condition or transport callback. Returning an error rejects the message with a 403. With a Router
+// attached, the messages routed to a tenant are verified with the secret of the tenant instead.
+func (c *Client) SetSecretResolver(f func(sub Subscription) (string, error)) {
+	c.resolver = f
+}
+
+// secretFor fails closed: a message routed to a tenant is only verified with the secret of the tenant, and an
+// empty secret rejects the message.
+func (c *Client) secretFor(ctx context.Context, sub Subscription) (string, error) {
+	if c.router != nil {
+		if t, ok := c.router.route(ctx, sub); ok {
+			return t.secretFor(ctx, sub)
+		}
+	}
+	secret := c.secret
+	if c.resolver != nil {
+		var err error
+		if secret, err = c.resolver(sub); err != nil {
+			return "", err
+		}
+	}
+	if secret == "" {
+		return "", errors.New("empty secret")
+	}
+	return secret, nil
+}
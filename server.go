@@ -0,0 +1,298 @@
+package twitcheventsub
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	defaultPath            = "/eventsub"
+	defaultReloadInterval  = time.Minute
+	defaultShutdownTimeout = 10 * time.Second
+	defaultReadyInterval   = 30 * time.Second
+)
+
+// ServerConfig configures a Server, zero values use the defaults.
+type ServerConfig struct {
+	// Addr defaults to :443 when serving TLS and :8080 otherwise.
+	Addr string
+	// Path is where HandleEvent is mounted, /eventsub by default.
+	Path string
+	// CertFile and KeyFile enable TLS, the files are reloaded when they change so certificates can be renewed
+	// without a restart.
+	CertFile       string
+	KeyFile        string
+	ReloadInterval time.Duration
+
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	IdleTimeout     time.Duration
+	ShutdownTimeout time.Duration
+
+	// ClientID and Token let /readyz ask the subscription API about expected subscriptions that were enabled
+	// before the server started, without them only subscriptions verified by this process count as ready.
+	// Token must return an application OAuth access token.
+	ClientID string
+	Token    func() (string, error)
+	// ReadyInterval is the minimum time between two subscription API queries of /readyz, probes in between
+	// get the answer of the last query. 30s by default.
+	ReadyInterval time.Duration
+}
+
+// Server serves a client HandleEvent along with /healthz and /readyz.
+type Server struct {
+	client *Client
+	cfg    ServerConfig
+	mux    *http.ServeMux
+
+	mu       sync.Mutex
+	expected []string
+	enabled  map[string]bool
+	// checked is the time of the last subscription API query and checkErr its outcome
+	checked  time.Time
+	checkErr error
+
+	certMu  sync.RWMutex
+	cert    *tls.Certificate
+	certMod time.Time
+	keyMod  time.Time
+}
+
+// NewServer creates a Server for c, nothing is served until ListenAndServe is called.
+func NewServer(c *Client, cfg ServerConfig) *Server {
+	if cfg.Path == "" {
+		cfg.Path = defaultPath
+	}
+	if cfg.Addr == "" {
+		cfg.Addr = ":8080"
+		if cfg.CertFile != "" {
+			cfg.Addr = ":443"
+		}
+	}
+	if cfg.ReloadInterval <= 0 {
+		cfg.ReloadInterval = defaultReloadInterval
+	}
+	if cfg.ReadTimeout <= 0 {
+		cfg.ReadTimeout = 5 * time.Second
+	}
+	if cfg.WriteTimeout <= 0 {
+		cfg.WriteTimeout = maxHandlerTimeout + 5*time.Second
+	}
+	if cfg.IdleTimeout <= 0 {
+		cfg.IdleTimeout = 2 * time.Minute
+	}
+	if cfg.ShutdownTimeout <= 0 {
+		cfg.ShutdownTimeout = defaultShutdownTimeout
+	}
+	if cfg.ReadyInterval <= 0 {
+		cfg.ReadyInterval = defaultReadyInterval
+	}
+	s := &Server{client: c, cfg: cfg, mux: http.NewServeMux(), enabled: map[string]bool{}}
+	s.mux.HandleFunc(cfg.Path, c.HandleEvent)
+	s.mux.HandleFunc("/healthz", s.healthz)
+	s.mux.HandleFunc("/readyz", s.readyz)
+	return s
+}
+
+// Expect adds subscriptions that must be enabled before /readyz reports the server as ready.
+func (s *Server) Expect(ids ...string) {
+	s.mu.Lock()
+	s.expected = append(s.expected, ids...)
+	// the last query did not ask about them
+	s.checked = time.Time{}
+	s.mu.Unlock()
+}
+
+// Handler returns the routes of the server, to mount them on an existing http.Server.
+func (s *Server) Handler() http.Handler {
+	return s.mux
+}
+
+// ListenAndServe serves until ctx is done, then stops accepting requests and waits for the client handlers
+// of acknowledged messages, both bound by the shutdown timeout.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	srv := &http.Server{Addr: s.cfg.Addr, Handler: s.mux, ReadTimeout: s.cfg.ReadTimeout,
+		WriteTimeout: s.cfg.WriteTimeout, IdleTimeout: s.cfg.IdleTimeout}
+	if s.cfg.CertFile != "" {
+		if err := s.loadCertificate(); err != nil {
+			return err
+		}
+		srv.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12, GetCertificate: s.getCertificate}
+		go s.watchCertificate(ctx)
+	}
+	errs := make(chan error, 1)
+	go func() {
+		var err error
+		if srv.TLSConfig != nil {
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		errs <- err
+	}()
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+	defer cancel()
+	err := srv.Shutdown(shutdownCtx)
+	if cerr := s.client.Shutdown(shutdownCtx); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (s *Server) healthz(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set(contentType, textPlain)
+	w.Write([]byte("ok"))
+}
+
+func (s *Server) readyz(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set(contentType, textPlain)
+	if err := s.ready(); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	w.Write([]byte("ok"))
+}
+
+// ready checks the expected subscriptions, first against the challenges answered by the client and then,
+// for the remaining ones, against the subscription API at most once per ReadyInterval. A subscription stays
+// ready once it was seen enabled, until the client receives its revocation.
+func (s *Server) ready() error {
+	s.mu.Lock()
+	var pending []string
+	for _, id := range s.expected {
+		// challenge outcomes expire in the client, the server remembers the ones it saw
+		ok, err := s.client.verified(id)
+		if err != nil {
+			delete(s.enabled, id)
+			s.mu.Unlock()
+			return fmt.Errorf("subscription %s: %w", id, err)
+		}
+		if ok {
+			s.enabled[id] = true
+		}
+		if !s.enabled[id] {
+			pending = append(pending, id)
+		}
+	}
+	if len(pending) == 0 {
+		s.mu.Unlock()
+		return nil
+	}
+	if s.cfg.Token == nil {
+		s.mu.Unlock()
+		return fmt.Errorf("%d subscriptions are not enabled", len(pending))
+	}
+	if time.Since(s.checked) < s.cfg.ReadyInterval {
+		err := s.checkErr
+		s.mu.Unlock()
+		if err == nil {
+			err = fmt.Errorf("%d subscriptions are not enabled", len(pending))
+		}
+		return err
+	}
+	s.checked = time.Now()
+	s.mu.Unlock()
+	err := s.query(pending)
+	s.mu.Lock()
+	s.checkErr = err
+	s.mu.Unlock()
+	return err
+}
+
+// query asks the subscription API which of the pending subscriptions are enabled.
+func (s *Server) query(pending []string) error {
+	token, err := s.cfg.Token()
+	if err != nil {
+		return fmt.Errorf("unable to get token: %w", err)
+	}
+	enabled := map[string]bool{}
+	after := ""
+	for {
+		res, err := s.client.GetSubscriptions(token, s.cfg.ClientID, "", "", after, []string{StatusEnabled})
+		if err != nil {
+			return fmt.Errorf("unable to get subscriptions: %w", err)
+		}
+		for _, sub := range res.Data {
+			enabled[sub.Id] = true
+		}
+		if res.Pagination.Cursor == "" || len(res.Data) == 0 {
+			break
+		}
+		after = res.Pagination.Cursor
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	missing := 0
+	for _, id := range pending {
+		if enabled[id] {
+			s.enabled[id] = true
+		} else {
+			missing++
+		}
+	}
+	if missing > 0 {
+		return fmt.Errorf("%d subscriptions are not enabled", missing)
+	}
+	return nil
+}
+
+func (s *Server) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	s.certMu.RLock()
+	defer s.certMu.RUnlock()
+	return s.cert, nil
+}
+
+// loadCertificate reads the key pair if either file changed since it was last loaded.
+func (s *Server) loadCertificate() error {
+	certInfo, err := os.Stat(s.cfg.CertFile)
+	if err != nil {
+		return err
+	}
+	keyInfo, err := os.Stat(s.cfg.KeyFile)
+	if err != nil {
+		return err
+	}
+	s.certMu.RLock()
+	unchanged := s.cert != nil && certInfo.ModTime().Equal(s.certMod) && keyInfo.ModTime().Equal(s.keyMod)
+	s.certMu.RUnlock()
+	if unchanged {
+		return nil
+	}
+	cert, err := tls.LoadX509KeyPair(s.cfg.CertFile, s.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("unable to load certificate: %w", err)
+	}
+	s.certMu.Lock()
+	s.cert, s.certMod, s.keyMod = &cert, certInfo.ModTime(), keyInfo.ModTime()
+	s.certMu.Unlock()
+	return nil
+}
+
+// watchCertificate keeps serving the previous certificate when a reload fails, renewals often replace
+// the two files one after the other.
+func (s *Server) watchCertificate(ctx context.Context) {
+	t := time.NewTicker(s.cfg.ReloadInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := s.loadCertificate(); err != nil {
+				s.client.onError(errors.New("certificate reload: " + err.Error()))
+			}
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package twitcheventsub_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+// probe returns the status code of a /readyz request to s.
+func probe(s *twitcheventsub.Server) int {
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	return rec.Code
+}
+
+func TestServerReady(t *testing.T) {
+	c, _ := newHelix(t)
+	s := twitcheventsub.NewServer(c, twitcheventsub.ServerConfig{})
+	if status := probe(s); status != http.StatusOK {
+		t.Errorf("nothing expected: status = %d, want %d", status, http.StatusOK)
+	}
+	s.Expect("1")
+	if status := probe(s); status != http.StatusServiceUnavailable {
+		t.Errorf("challenge not answered: status = %d, want %d", status, http.StatusServiceUnavailable)
+	}
+	message(t, c, eventsubtest.MessageTypeChallenge, "1")
+	if status := probe(s); status != http.StatusOK {
+		t.Errorf("challenge answered: status = %d, want %d", status, http.StatusOK)
+	}
+	message(t, c, eventsubtest.MessageTypeRevocation, "1")
+	if status := probe(s); status != http.StatusServiceUnavailable {
+		t.Errorf("revoked: status = %d, want %d", status, http.StatusServiceUnavailable)
+	}
+}
+
+func TestServerReadyAPI(t *testing.T) {
+	replica, helix := newHelix(t)
+	// the subscriptions were verified by another replica, only the API knows about them
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetBaseURL(helix.URL())
+	queries := 0
+	s := twitcheventsub.NewServer(c, twitcheventsub.ServerConfig{ClientID: testClientID, ReadyInterval: 200 * time.Millisecond,
+		Token: func() (string, error) {
+			queries++
+			return token()
+		}})
+	create := func(broadcaster string) string {
+		res, err := replica.CreateSubscription(twitcheventsub.SubscriptionRequest{Type: twitcheventsub.Cheer, Version: "1",
+			Condition: twitcheventsub.Condition{BroadcasterUserId: broadcaster}}, "test-token", testClientID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		helix.Wait()
+		return res.Data[0].Id
+	}
+	id := create("1")
+	if err := helix.SetStatus(id, twitcheventsub.StatusVerificationPending); err != nil {
+		t.Fatal(err)
+	}
+	s.Expect(id)
+
+	for range 3 {
+		if status := probe(s); status != http.StatusServiceUnavailable {
+			t.Errorf("pending: status = %d, want %d", status, http.StatusServiceUnavailable)
+		}
+	}
+	if queries != 1 {
+		t.Errorf("%d queries for probes within the interval, want 1", queries)
+	}
+	if err := helix.SetStatus(id, twitcheventsub.StatusEnabled); err != nil {
+		t.Fatal(err)
+	}
+	if status := probe(s); status != http.StatusServiceUnavailable {
+		t.Errorf("enabled within the interval: status = %d, want the cached %d", status, http.StatusServiceUnavailable)
+	}
+	time.Sleep(250 * time.Millisecond)
+	if status := probe(s); status != http.StatusOK {
+		t.Errorf("enabled: status = %d, want %d", status, http.StatusOK)
+	}
+	// ready subscriptions are not queried again
+	time.Sleep(250 * time.Millisecond)
+	probe(s)
+	if queries != 2 {
+		t.Errorf("%d queries, want 2", queries)
+	}
+
+	// a new expected subscription is queried right away
+	s.Expect(create("2"))
+	if status := probe(s); status != http.StatusOK || queries != 3 {
+		t.Errorf("new subscription: status = %d after %d queries, want %d after 3", status, queries, http.StatusOK)
+	}
+}
+
+// freeAddr returns a local address nothing listens on.
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+// serve runs s until the test ends.
+func serve(t *testing.T, s *twitcheventsub.Server, addr string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- s.ListenAndServe(ctx)
+	}()
+	// wait for the listener
+	eventually(t, func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+		}
+		return err == nil
+	})
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+// writeCertificate writes a self-signed key pair with the given serial number to dir.
+func writeCertificate(t *testing.T, dir string, serial int64, modTime time.Time) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{SerialNumber: big.NewInt(serial), NotBefore: time.Now().Add(-time.Hour),
+		NotAfter: time.Now().Add(time.Hour), IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1)}}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	for file, block := range map[string]*pem.Block{certFile: {Type: "CERTIFICATE", Bytes: der},
+		keyFile: {Type: "EC PRIVATE KEY", Bytes: keyDER}} {
+		if err := os.WriteFile(file, pem.EncodeToMemory(block), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		// the server compares modification times, which a quick rewrite may not change
+		if err := os.Chtimes(file, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return certFile, keyFile
+}
+
+// serial returns the serial number of the certificate served at addr.
+func serial(t *testing.T, addr string) int64 {
+	t.Helper()
+	conn, err := tls.Dial("tcp", addr, &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64()
+}
+
+func TestServerCertificateReload(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Now().Add(-time.Minute)
+	certFile, keyFile := writeCertificate(t, dir, 1, start)
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	reloadErrs := make(chan error, 10)
+	c.OnError(func(err error) {
+		select {
+		case reloadErrs <- err:
+		default:
+		}
+	})
+	addr := freeAddr(t)
+	s := twitcheventsub.NewServer(c, twitcheventsub.ServerConfig{Addr: addr, CertFile: certFile, KeyFile: keyFile,
+		ReloadInterval: 10 * time.Millisecond})
+	serve(t, s, addr)
+	if n := serial(t, addr); n != 1 {
+		t.Fatalf("serving certificate %d, want 1", n)
+	}
+
+	// a renewal replacing only one of the files keeps the previous certificate
+	if err := os.WriteFile(keyFile, []byte("not a key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-reloadErrs:
+	case <-time.After(time.Second):
+		t.Fatal("failed reload not reported")
+	}
+	if n := serial(t, addr); n != 1 {
+		t.Errorf("serving certificate %d after a failed reload, want 1", n)
+	}
+
+	writeCertificate(t, dir, 2, start.Add(time.Second))
+	eventually(t, func() bool { return serial(t, addr) == 2 })
+}
+
+func TestServerTimeouts(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	addr := freeAddr(t)
+	s := twitcheventsub.NewServer(c, twitcheventsub.ServerConfig{Addr: addr, ReadTimeout: 50 * time.Millisecond})
+	serve(t, s, addr)
+
+	res, err := http.Get("http://" + addr + "/healthz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK || string(body) != "ok" {
+		t.Errorf("healthz = %d %q, want 200 ok", res.StatusCode, body)
+	}
+
+	// a client that never finishes its request is disconnected
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte("POST /eventsub HTTP/1.1\r\nHost: localhost\r\n")); err != nil {
+		t.Fatal(err)
+	}
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	start := time.Now()
+	if _, err := bufio.NewReader(conn).ReadString('\n'); err == nil {
+		// the server may answer the timeout before closing, then the connection must end
+		_, err = io.ReadAll(conn)
+		if err != nil {
+			t.Fatalf("connection still open: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("slow request kept open for %s, want it closed after the read timeout", elapsed)
+	}
+}
+
+func TestServerShutdown(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	addr := freeAddr(t)
+	s := twitcheventsub.NewServer(c, twitcheventsub.ServerConfig{Addr: addr})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- s.ListenAndServe(ctx)
+	}()
+	eventually(t, func() bool {
+		res, err := http.Get("http://" + addr + "/healthz")
+		if err == nil {
+			res.Body.Close()
+		}
+		return err == nil
+	})
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("ListenAndServe = %v, want nil after the context is done", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("server still running after the context is done")
+	}
+	if _, err := http.Get("http://" + addr + "/healthz"); err == nil {
+		t.Error("server still accepting requests")
+	}
+}
@@ -0,0 +1,205 @@
+package twitcheventsub
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// SQLDialect adapts the queries of an SQLStore to a database, the schema itself only uses types shared by
+// SQLite and PostgreSQL.
+type SQLDialect struct {
+	// Placeholder returns the bind parameter at position n, starting at 1.
+	Placeholder func(n int) string
+}
+
+var (
+	SQLite   = SQLDialect{Placeholder: func(n int) string { return "?" }}
+	Postgres = SQLDialect{Placeholder: func(n int) string { return "$" + strconv.Itoa(n) }}
+)
+
+// SQLStore is an EventSink that writes every notification to a deliveries table, along with a row in the
+// cheers, subs, redemptions, bans or raids table for those subscription types. Recording the same message ID
+// twice is a no-op, so Twitch redeliveries are stored once.
+type SQLStore struct {
+	db      *sql.DB
+	dialect SQLDialect
+}
+
+// NewSQLStore creates an SQLStore on db, the driver is up to the caller. Migrate must be called before
+// the first Record.
+func NewSQLStore(db *sql.DB, dialect SQLDialect) *SQLStore {
+	if dialect.Placeholder == nil {
+		dialect = SQLite
+	}
+	return &SQLStore{db: db, dialect: dialect}
+}
+
+// Migrate applies the embedded migrations that were not applied yet, each one in its own transaction.
+func (s *SQLStore) Migrate(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    INTEGER PRIMARY KEY,
+	applied_at TEXT NOT NULL
+)`); err != nil {
+		return errors.New("error creating schema_migrations: " + err.Error())
+	}
+	var current sql.NullInt64
+	if err := s.db.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_migrations").Scan(&current); err != nil {
+		return errors.New("error reading schema version: " + err.Error())
+	}
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		base := strings.TrimPrefix(name, "migrations/")
+		version, err := strconv.Atoi(strings.SplitN(base, "_", 2)[0])
+		if err != nil {
+			return fmt.Errorf("invalid migration name %s", base)
+		}
+		if int64(version) <= current.Int64 {
+			continue
+		}
+		b, err := migrations.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if err := s.migrate(ctx, version, string(b)); err != nil {
+			return fmt.Errorf("error applying migration %s: %w", base, err)
+		}
+	}
+	return nil
+}
+
+func (s *SQLStore) migrate(ctx context.Context, version int, script string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, stmt := range strings.Split(script, ";") {
+		if strings.TrimSpace(stmt) == "" {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, s.rebind("INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)"),
+		version, time.Now().UTC().Format(time.RFC3339Nano)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// rebind replaces the ? placeholders of query with the ones of the dialect.
+func (s *SQLStore) rebind(query string) string {
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString(s.dialect.Placeholder(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Record stores a notification, it implements EventSink.
+func (s *SQLStore) Record(ctx context.Context, env Envelope) error {
+	sub := env.Subscription
+	payload := env.Payload()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	res, err := tx.ExecContext(ctx, s.rebind(`INSERT INTO deliveries (message_id, subscription_id, type, version,
+	broadcaster_id, message_timestamp, received_at, payload) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT (message_id) DO NOTHING`), env.MessageID, sub.Id, sub.Type, sub.Version, BroadcasterKey(sub),
+		formatTime(env.MessageTimestamp), formatTime(env.ReceivedAt), string(payload))
+	if err != nil {
+		return errors.New("error inserting delivery: " + err.Error())
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return tx.Commit()
+	}
+	// an event that does not decode is still kept in deliveries, Twitch would redeliver it forever otherwise
+	event, err := DecodeEvent(sub, payload)
+	if err != nil {
+		return tx.Commit()
+	}
+	query, args := s.domainRow(env.MessageID, sub.Type, event)
+	if query != "" {
+		if _, err := tx.ExecContext(ctx, s.rebind(query), args...); err != nil {
+			return fmt.Errorf("error inserting %s event: %w", sub.Type, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// domainRow returns the insert of the per domain table of event, if it has one.
+func (s *SQLStore) domainRow(id, subType string, event any) (string, []any) {
+	const subs = `INSERT INTO subs (message_id, type, broadcaster_user_id, user_id, user_login, tier, is_gift,
+	is_anonymous, total, cumulative_months, cumulative_total, message) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	switch e := event.(type) {
+	case ChannelCheerEvent:
+		return `INSERT INTO cheers (message_id, broadcaster_user_id, user_id, user_login, is_anonymous, bits, message)
+	VALUES (?, ?, ?, ?, ?, ?, ?)`, []any{id, e.BroadcasterUserID, e.UserID, e.UserLogin, boolInt(e.IsAnonymous),
+			e.Bits, e.Message}
+	case ChannelSubscribeEvent:
+		return subs, []any{id, subType, e.BroadcasterUserID, e.UserID, e.UserLogin, e.Tier, boolInt(e.IsGift), 0, nil,
+			nil, nil, nil}
+	case ChannelSubscriptionMessageEvent:
+		return subs, []any{id, subType, e.BroadcasterUserID, e.UserID, e.UserLogin, e.Tier, 0, 0, nil,
+			e.CumulativeMonths, nil, e.Message.Text}
+	case ChannelSubscriptionGiftEvent:
+		return subs, []any{id, subType, e.BroadcasterUserID, e.UserID, e.UserLogin, e.Tier, 1, boolInt(e.IsAnonymous),
+			e.Total, nil, e.CumulativeTotal, nil}
+	case ChannelPointsCustomRewardRedemptionAddEvent:
+		return `INSERT INTO redemptions (message_id, redemption_id, broadcaster_user_id, user_id, user_login, reward_id,
+	reward_title, cost, user_input, status, redeemed_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			[]any{id, e.ID, e.BroadcasterUserID, e.UserID, e.UserLogin, e.Reward.ID, e.Reward.Title, e.Reward.Cost,
+				e.UserInput, e.Status, formatTime(e.RedeemedAt)}
+	case ChannelBanEvent:
+		var endsAt any
+		if e.EndsAt != nil {
+			endsAt = formatTime(*e.EndsAt)
+		}
+		return `INSERT INTO bans (message_id, broadcaster_user_id, user_id, user_login, moderator_user_id, reason,
+	is_permanent, banned_at, ends_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			[]any{id, e.BroadcasterUserID, e.UserID, e.UserLogin, e.ModeratorUserID, e.Reason, boolInt(e.IsPermanent),
+				formatTime(e.BannedAt), endsAt}
+	case ChannelRaidEvent:
+		return `INSERT INTO raids (message_id, from_broadcaster_user_id, from_broadcaster_user_login,
+	to_broadcaster_user_id, to_broadcaster_user_login, viewers) VALUES (?, ?, ?, ?, ?, ?)`,
+			[]any{id, e.FromBroadcasterUserID, e.FromBroadcasterUserLogin, e.ToBroadcasterUserID,
+				e.ToBroadcasterUserLogin, e.Viewers}
+	}
+	return "", nil
+}
+
+// boolInt stores booleans as integers, the one representation SQLite and PostgreSQL share.
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,143 @@
+package twitcheventsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultSSEBufferSize = 256
+	sseClientQueue       = 64
+	sseHeartbeat         = 15 * time.Second
+)
+
+// SSEEvent is the data of every Server-Sent Event, the SSE event name is the subscription type.
+type SSEEvent struct {
+	MessageID     string          `json:"message_id"`
+	Type          string          `json:"type"`
+	Version       string          `json:"version"`
+	BroadcasterID string          `json:"broadcaster_user_id"`
+	Event         json.RawMessage `json:"event"`
+}
+
+type sseEntry struct {
+	data SSEEvent
+	raw  []byte
+}
+
+// SSEServer re-broadcasts the notifications a client receives to browsers as Server-Sent Events. Browsers pick
+// what they receive with the type and broadcaster query parameters, both repeatable or comma separated, like
+// /events?type=channel.follow,channel.cheer&broadcaster=1337. The most recent events are buffered so a reconnecting
+// EventSource resumes from its Last-Event-ID, slow browsers are disconnected, see Broadcaster.
+type SSEServer struct {
+	types       []string
+	broadcaster *Broadcaster[sseEntry]
+	// epoch prefixes the event ids, a Last-Event-ID from before a restart does not match it and gets the
+	// whole buffer, the sequence numbers having started over
+	epoch string
+}
+
+// NewSSEServer creates an SSEServer fed by c, keeping the last bufferSize events for resumption. When types are
+// given only those subscription types are broadcast.
+func NewSSEServer(c *Client, bufferSize int, types ...EventType) *SSEServer {
+	if bufferSize <= 0 {
+		bufferSize = defaultSSEBufferSize
+	}
+	s := &SSEServer{broadcaster: NewBroadcaster[sseEntry](bufferSize),
+		epoch: strconv.FormatInt(time.Now().UnixNano(), 36)}
+	for _, t := range types {
+		s.types = append(s.types, string(t))
+	}
+	c.AddListener(s.broadcast)
+	return s
+}
+
+func (s *SSEServer) broadcast(ctx context.Context, env Envelope) error {
+	if len(s.types) > 0 && !slices.Contains(s.types, env.Subscription.Type) {
+		return nil
+	}
+	data := SSEEvent{MessageID: env.MessageID, Type: env.Subscription.Type, Version: env.Subscription.Version,
+		BroadcasterID: BroadcasterKey(env.Subscription), Event: env.Payload()}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil
+	}
+	s.broadcaster.Publish(func(seq uint64) sseEntry {
+		return sseEntry{data: data, raw: raw}
+	})
+	return nil
+}
+
+func queryList(values []string) []string {
+	var list []string
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+	}
+	return list
+}
+
+func (s *SSEServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	q := req.URL.Query()
+	types, broadcasters := queryList(q["type"]), queryList(q["broadcaster"])
+	match := func(e sseEntry) bool {
+		return (len(types) == 0 || slices.Contains(types, e.data.Type)) &&
+			(len(broadcasters) == 0 || slices.Contains(broadcasters, e.data.BroadcasterID))
+	}
+	var after func(e BroadcastEntry[sseEntry]) bool
+	if lastEventId := req.Header.Get("Last-Event-ID"); lastEventId != "" {
+		epoch, seq, _ := strings.Cut(lastEventId, "-")
+		lastSeq, err := strconv.ParseUint(seq, 10, 64)
+		if epoch != s.epoch || err != nil {
+			lastSeq = 0
+		}
+		after = func(e BroadcastEntry[sseEntry]) bool { return e.Seq <= lastSeq }
+	}
+
+	w.Header().Set(contentType, "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	r, backlog, _ := s.broadcaster.Subscribe(sseClientQueue, match, after)
+	defer r.Close()
+
+	for _, e := range backlog {
+		s.write(w, e)
+	}
+	flusher.Flush()
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		case e, ok := <-r.C():
+			if !ok {
+				return
+			}
+			s.write(w, e)
+		}
+		flusher.Flush()
+	}
+}
+
+func (s *SSEServer) write(w http.ResponseWriter, e BroadcastEntry[sseEntry]) {
+	fmt.Fprintf(w, "id: %s-%d\nevent: %s\ndata: %s\n\n", s.epoch, e.Seq, e.Value.data.Type, e.Value.raw)
+}
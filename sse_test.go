@@ -0,0 +1,159 @@
+package twitcheventsub_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+type sseMessage struct {
+	id    string
+	event string
+	data  twitcheventsub.SSEEvent
+}
+
+// connect opens an event stream on url and returns the events it receives.
+func connect(t *testing.T, url, lastEventId string) <-chan sseMessage {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastEventId != "" {
+		req.Header.Set("Last-Event-ID", lastEventId)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { res.Body.Close() })
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q, want text/event-stream", ct)
+	}
+	messages := make(chan sseMessage, 16)
+	go func() {
+		defer close(messages)
+		var m sseMessage
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			field, value, _ := strings.Cut(scanner.Text(), ": ")
+			switch field {
+			case "id":
+				m.id = value
+			case "event":
+				m.event = value
+			case "data":
+				json.Unmarshal([]byte(value), &m.data)
+			case "":
+				messages <- m
+				m = sseMessage{}
+			}
+		}
+	}()
+	return messages
+}
+
+func next(t *testing.T, messages <-chan sseMessage) sseMessage {
+	t.Helper()
+	select {
+	case m := <-messages:
+		return m
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return sseMessage{}
+	}
+}
+
+// none checks that no event arrives in the next 50ms.
+func none(t *testing.T, messages <-chan sseMessage) {
+	t.Helper()
+	select {
+	case m := <-messages:
+		t.Errorf("unexpected %s event %s", m.event, m.id)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func newSSE(t *testing.T) (*twitcheventsub.Client, *httptest.Server) {
+	t.Helper()
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	srv := httptest.NewServer(twitcheventsub.NewSSEServer(c, 0))
+	t.Cleanup(srv.Close)
+	return c, srv
+}
+
+func TestSSEFilters(t *testing.T) {
+	c, srv := newSSE(t)
+	messages := connect(t, srv.URL+"?type=channel.follow,channel.cheer&broadcaster=1", "")
+	cheer, err := eventsubtest.NewChannelCheerEvent().Fixture().WithBroadcaster("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	raid := eventsubtest.NewChannelRaidEvent().Fixture()
+	for _, f := range []eventsubtest.Fixture{follow(t, "2"), raid, follow(t, "1"), cheer} {
+		process(t, c, f)
+	}
+	m := next(t, messages)
+	if m.event != "channel.follow" || m.data.Type != "channel.follow" || m.data.BroadcasterID != "1" {
+		t.Errorf("first event %s of %s, want the follow of 1", m.event, m.data.BroadcasterID)
+	}
+	var e twitcheventsub.ChannelFollowEvent
+	if err := json.Unmarshal(m.data.Event, &e); err != nil || e.BroadcasterUserID != "1" {
+		t.Errorf("event %s does not decode to the follow: %v", m.data.Event, err)
+	}
+	if m = next(t, messages); m.event != "channel.cheer" {
+		t.Errorf("second event %s, want channel.cheer", m.event)
+	}
+	none(t, messages)
+}
+
+func TestSSEResume(t *testing.T) {
+	c, srv := newSSE(t)
+	for _, id := range []string{"1", "2", "3"} {
+		process(t, c, follow(t, id))
+	}
+	messages := connect(t, srv.URL, "")
+	// without Last-Event-ID only new events are sent
+	none(t, messages)
+	process(t, c, follow(t, "4"))
+	last := next(t, messages).id
+
+	process(t, c, follow(t, "5"))
+	process(t, c, follow(t, "6"))
+	messages = connect(t, srv.URL, last)
+	for _, want := range []string{"5", "6"} {
+		if m := next(t, messages); m.data.BroadcasterID != want {
+			t.Errorf("resumed with %s, want %s", m.data.BroadcasterID, want)
+		}
+	}
+	none(t, messages)
+}
+
+func TestSSEResumeAfterRestart(t *testing.T) {
+	c, srv := newSSE(t)
+	process(t, c, follow(t, "1"))
+	messages := connect(t, srv.URL, "")
+	for _, id := range []string{"2", "3", "4"} {
+		process(t, c, follow(t, id))
+	}
+	var last string
+	for range 3 {
+		last = next(t, messages).id
+	}
+
+	// a restarted server numbers its events from 1 again
+	c, srv = newSSE(t)
+	process(t, c, follow(t, "5"))
+	messages = connect(t, srv.URL, last)
+	if m := next(t, messages); m.data.BroadcasterID != "5" || m.id == last {
+		t.Errorf("resumed after a restart with %s (%s), want the whole buffer", m.data.BroadcasterID, m.id)
+	}
+}
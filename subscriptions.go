@@ -2,17 +2,38 @@ package twitcheventsub
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 type EventType string
 
+var (
+	// ErrSubscriptionNotFound is returned by GetSubscription when Twitch has no subscription with the given id.
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+	// ErrSubscriptionExists is returned by CreateSubscription when Twitch answers 409 Conflict.
+	ErrSubscriptionExists = errors.New("subscription already exists")
+)
+
 const (
-	baseUrl                                             = "https://api.twitch.tv/helix/eventsub/subscriptions"
+	StatusEnabled                      = "enabled"
+	StatusVerificationPending          = "webhook_callback_verification_pending"
+	StatusVerificationFailed           = "webhook_callback_verification_failed"
+	StatusNotificationFailuresExceeded = "notification_failures_exceeded"
+	StatusAuthorizationRevoked         = "authorization_revoked"
+	StatusModeratorRemoved             = "moderator_removed"
+	StatusUserRemoved                  = "user_removed"
+	StatusVersionRemoved               = "version_removed"
+	StatusBetaMaintenance              = "beta_maintenance"
+)
+
+const (
+	defaultBaseUrl                                      = "https://api.twitch.tv/helix/eventsub/subscriptions"
 	Update                                    EventType = "channel.update"
 	Follow                                              = "channel.follow"
 	Subscribe                                           = "channel.subscribe"
@@ -52,27 +73,55 @@ const (
 	UserUpdate                                          = "user.update"
 	ChannelSuspiciousUserUpdate                         = "channel.suspicious_user.update"
 	ChannelBitsUse                                      = "channel.bits.use"
+	ChannelChatMessage                                  = "channel.chat.message"
 	ChannelSuspiciousUserMessage                        = "channel.suspicious_user.message"
 	ChannelWarningAcknowledge                           = "channel.warning.acknowledge"
 	ChannelWarningSend                                  = "channel.warning.send"
 	UserWhisperMessage                                  = "user.whisper.message"
 	ChannelPointsAutomaticRewardRedemptionAdd           = "channel.channel_points_automatic_reward_redemption.add"
+	DropEntitlementGrant                                = "drop.entitlement.grant"
 )
 
-func (c *Client) SubscribeToEvent(event EventType, broadcasterId, token, clientId string) (SubscriptionResponse, error) {
+func (c *Client) SubscribeToEvent(event EventType, broadcasterId, token, clientId string, opts ...SubscriptionOption) (SubscriptionResponse, error) {
 	subReq := SubscriptionRequest{Type: string(event), Version: "1",
-		Condition: Condition{BroadcasterUserId: broadcasterId},
-		Transport: Transport{Method: "webhook", Callback: c.callback, Secret: c.secret}}
+		Condition: Condition{BroadcasterUserId: broadcasterId}}
+	c.applyOptions(&subReq, opts)
+	return c.CreateSubscription(subReq, token, clientId)
+}
+
+// SubscribeToDropEntitlementGrant Requires an application OAuth access token, categoryId and campaignId are optional.
+// With batching enabled Twitch may send several entitlements in a single notification.
+func (c *Client) SubscribeToDropEntitlementGrant(organizationId, categoryId, campaignId string, batching bool, token, clientId string, opts ...SubscriptionOption) (SubscriptionResponse, error) {
+	subReq := SubscriptionRequest{Type: DropEntitlementGrant, Version: "1",
+		Condition:         Condition{OrganizationID: organizationId, CategoryID: categoryId, CampaignID: campaignId},
+		IsBatchingEnabled: batching}
+	c.applyOptions(&subReq, opts)
+	return c.CreateSubscription(subReq, token, clientId)
+}
+
+// CreateSubscription sends subReq as is, an empty transport is filled with the client webhook callback and secret.
+// It fails with ErrSubscriptionExists when Twitch already has the same subscription.
+func (c *Client) CreateSubscription(subReq SubscriptionRequest, token, clientId string) (SubscriptionResponse, error) {
+	return c.CreateSubscriptionContext(context.Background(), subReq, token, clientId)
+}
+
+// CreateSubscriptionContext is CreateSubscription with a context.
+func (c *Client) CreateSubscriptionContext(ctx context.Context, subReq SubscriptionRequest, token, clientId string) (SubscriptionResponse, error) {
+	if subReq.Transport.Method == "" {
+		subReq.Transport = Transport{Method: "webhook", Callback: c.callback, Secret: c.secret}
+	}
 	payload, err := json.Marshal(subReq)
 	if err != nil {
 		return SubscriptionResponse{}, errors.New("error encoding: " + err.Error())
 	}
 	client := http.Client{}
-	req, _ := http.NewRequest(http.MethodPost, baseUrl, bytes.NewBuffer(payload))
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl, bytes.NewBuffer(payload))
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Client-Id", clientId)
 	req.Header.Set("Content-Type", "application/json")
+	start := time.Now()
 	res, err := client.Do(req)
+	c.observeAPICall("create", start, res, err, "type", subReq.Type)
 	if err != nil {
 		return SubscriptionResponse{}, errors.New("error sending request: " + err.Error())
 	}
@@ -85,29 +134,46 @@ func (c *Client) SubscribeToEvent(event EventType, broadcasterId, token, clientI
 		}
 		return response, nil
 	}
+	if res.StatusCode == http.StatusConflict {
+		return SubscriptionResponse{}, fmt.Errorf("%w: %s", ErrSubscriptionExists, res.Status)
+	}
 	return SubscriptionResponse{}, errors.New(res.Status)
 }
 
 // DeleteSubscription Requires an application OAuth access token.
 func (c *Client) DeleteSubscription(id, token, clientId string) error {
+	return c.DeleteSubscriptionContext(context.Background(), id, token, clientId)
+}
+
+// DeleteSubscriptionContext is DeleteSubscription with a context.
+func (c *Client) DeleteSubscriptionContext(ctx context.Context, id, token, clientId string) error {
 	client := http.Client{}
-	url := fmt.Sprintf("%s?id=%s", baseUrl, url.QueryEscape(id))
-	req, _ := http.NewRequest(http.MethodDelete, url, nil)
+	url := fmt.Sprintf("%s?id=%s", c.baseUrl, url.QueryEscape(id))
+	req, _ := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Client-Id", clientId)
+	start := time.Now()
 	res, err := client.Do(req)
+	c.observeAPICall("delete", start, res, err, "subscription_id", id)
 	if err != nil {
 		return errors.New("error sending request: " + err.Error())
 	}
 	defer res.Body.Close()
 	if res.StatusCode == http.StatusNoContent {
+		c.forgetVerification(id)
 		return nil
 	}
 	return errors.New(res.Status)
 }
 
-// GetSubscriptions Requires an application OAuth access token.
+// GetSubscriptions Requires an application OAuth access token. Twitch accepts a single filter, status, subType
+// or userId, and a single status.
 func (c *Client) GetSubscriptions(token, clientId, subType, userId, after string, status []string) (SubscriptionResponse, error) {
+	return c.GetSubscriptionsContext(context.Background(), token, clientId, subType, userId, after, status)
+}
+
+// GetSubscriptionsContext is GetSubscriptions with a context.
+func (c *Client) GetSubscriptionsContext(ctx context.Context, token, clientId, subType, userId, after string, status []string) (SubscriptionResponse, error) {
 	v := url.Values{}
 	for _, s := range status {
 		v.Add("status", s)
@@ -121,13 +187,34 @@ func (c *Client) GetSubscriptions(token, clientId, subType, userId, after string
 	if after != "" {
 		v.Set("after", after)
 	}
+	return c.listSubscriptions(ctx, token, clientId, v, "type", subType)
+}
+
+// GetSubscription returns the subscription with the given id, or ErrSubscriptionNotFound. Requires an
+// application OAuth access token.
+func (c *Client) GetSubscription(ctx context.Context, id, token, clientId string) (Subscription, error) {
+	res, err := c.listSubscriptions(ctx, token, clientId, url.Values{"subscription_id": {id}}, "subscription_id", id)
+	if err != nil {
+		return Subscription{}, err
+	}
+	for _, sub := range res.Data {
+		if sub.Id == id {
+			return sub, nil
+		}
+	}
+	return Subscription{}, ErrSubscriptionNotFound
+}
+
+func (c *Client) listSubscriptions(ctx context.Context, token, clientId string, v url.Values, logArgs ...any) (SubscriptionResponse, error) {
 	client := http.Client{}
-	url := fmt.Sprintf("%s?%s", baseUrl, v.Encode())
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	url := fmt.Sprintf("%s?%s", c.baseUrl, v.Encode())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Client-Id", clientId)
 	req.Header.Set("Content-Type", "application/json")
+	start := time.Now()
 	res, err := client.Do(req)
+	c.observeAPICall("list", start, res, err, logArgs...)
 	if err != nil {
 		return SubscriptionResponse{}, errors.New("error sending request: " + err.Error())
 	}
@@ -142,3 +229,8 @@ func (c *Client) GetSubscriptions(token, clientId, subType, userId, after string
 	}
 	return SubscriptionResponse{}, errors.New(res.Status)
 }
+
+// SetBaseURL points the subscription functions to another EventSub subscriptions endpoint, like a mock server.
+func (c *Client) SetBaseURL(u string) {
+	c.baseUrl = u
+}
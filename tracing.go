@@ -0,0 +1,71 @@
+package twitcheventsub
+
+import "context"
+
+// Span names started by Process, eventsub.delivery is the parent of the other ones. eventsub.read is only
+// started when the client reads the body itself, with HandleEvent or ProcessReader.
+const (
+	SpanDelivery = "eventsub.delivery"
+	SpanRead     = "eventsub.read"
+	SpanDecode   = "eventsub.decode"
+	SpanVerify   = "eventsub.verify"
+	SpanPublish  = "eventsub.publish"
+	SpanDispatch = "eventsub.dispatch"
+)
+
+// Attribute keys set on the delivery span.
+const (
+	AttrMessageID        = "eventsub.message_id"
+	AttrMessageType      = "eventsub.message_type"
+	AttrSubscriptionID   = "eventsub.subscription_id"
+	AttrSubscriptionType = "eventsub.subscription_type"
+	AttrOutcome          = "eventsub.outcome"
+)
+
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// Tracer starts spans, it is small enough to wrap an OpenTelemetry tracer in a few lines. The context returned
+// by Start is the one passed to listeners and Handle handlers, so spans they start join the delivery trace.
+type Tracer interface {
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+type nopTracer struct{}
+
+type nopSpan struct{}
+
+func (nopTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	return ctx, nopSpan{}
+}
+
+func (nopSpan) SetAttributes(attrs ...Attribute) {}
+func (nopSpan) RecordError(err error)            {}
+func (nopSpan) End()                             {}
+
+// SetTracer makes HandleEvent trace every delivery with t, nil disables tracing.
+func (c *Client) SetTracer(t Tracer) {
+	if t == nil {
+		t = nopTracer{}
+	}
+	c.tracer = t
+}
+
+// span runs f in a child span of ctx, recording the error it returns.
+func (c *Client) span(ctx context.Context, name string, f func(ctx context.Context) error) error {
+	ctx, s := c.tracer.Start(ctx, name)
+	defer s.End()
+	err := f(ctx)
+	if err != nil {
+		s.RecordError(err)
+	}
+	return err
+}
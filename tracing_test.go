@@ -0,0 +1,165 @@
+package twitcheventsub_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+)
+
+// recorder is a Tracer keeping the spans it started, the parent of a span is the one found in its context.
+type recorder struct {
+	mu    sync.Mutex
+	spans []*recordedSpan
+}
+
+type recordedSpan struct {
+	r      *recorder
+	name   string
+	parent *recordedSpan
+	attrs  map[string]string
+	err    error
+	ended  bool
+}
+
+type spanKey struct{}
+
+func (r *recorder) Start(ctx context.Context, name string, attrs ...twitcheventsub.Attribute) (context.Context, twitcheventsub.Span) {
+	parent, _ := ctx.Value(spanKey{}).(*recordedSpan)
+	s := &recordedSpan{r: r, name: name, parent: parent, attrs: map[string]string{}}
+	s.SetAttributes(attrs...)
+	r.mu.Lock()
+	r.spans = append(r.spans, s)
+	r.mu.Unlock()
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...twitcheventsub.Attribute) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *recordedSpan) RecordError(err error) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	s.err = err
+}
+
+func (s *recordedSpan) End() {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	s.ended = true
+}
+
+// tree renders the spans as name(parent) in the order they started.
+func (r *recorder) tree() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var names []string
+	for _, s := range r.spans {
+		parent := ""
+		if s.parent != nil {
+			parent = s.parent.name
+		}
+		names = append(names, s.name+"("+parent+")")
+	}
+	return strings.Join(names, " ")
+}
+
+func (r *recorder) span(name string) *recordedSpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.spans {
+		if s.name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func TestTracing(t *testing.T) {
+	tracer := &recorder{}
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	c.SetTracer(tracer)
+	c.AddPublisher(&eventPublisher{})
+	twitcheventsub.Handle(c, twitcheventsub.Follow, func(ctx context.Context, event twitcheventsub.ChannelFollowEvent) error {
+		_, s := tracer.Start(ctx, "handler")
+		s.End()
+		return nil
+	})
+	f := follow(t, "1")
+	if status := send(t, http.HandlerFunc(c.HandleEvent), "http://localhost/eventsub", testSecret, f); status != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", status, http.StatusNoContent)
+	}
+
+	const want = "eventsub.delivery() eventsub.read(eventsub.delivery) eventsub.decode(eventsub.delivery) " +
+		"eventsub.verify(eventsub.delivery) eventsub.publish(eventsub.delivery) eventsub.dispatch(eventsub.delivery) " +
+		"handler(eventsub.dispatch)"
+	if got := tracer.tree(); got != want {
+		t.Errorf("spans = %s, want %s", got, want)
+	}
+	for _, s := range tracer.spans {
+		if !s.ended || s.err != nil {
+			t.Errorf("span %s ended %t with error %v, want ended without error", s.name, s.ended, s.err)
+		}
+	}
+	delivery := tracer.span(twitcheventsub.SpanDelivery)
+	for key, value := range map[string]string{
+		twitcheventsub.AttrMessageType:      "notification",
+		twitcheventsub.AttrSubscriptionType: string(twitcheventsub.Follow),
+		twitcheventsub.AttrSubscriptionID:   f.Subscription.Id,
+		twitcheventsub.AttrOutcome:          twitcheventsub.OutcomeOK,
+	} {
+		if delivery.attrs[key] != value {
+			t.Errorf("delivery %s = %q, want %q", key, delivery.attrs[key], value)
+		}
+	}
+	if delivery.attrs[twitcheventsub.AttrMessageID] == "" {
+		t.Error("delivery has no message id")
+	}
+}
+
+func TestTracingErrors(t *testing.T) {
+	tracer := &recorder{}
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetTracer(tracer)
+	c.OnError(func(error) {})
+	h := http.HandlerFunc(c.HandleEvent)
+	send(t, h, "http://localhost/eventsub", "wrong-secret-value", follow(t, "1"))
+
+	if got, want := tracer.tree(), "eventsub.delivery() eventsub.read(eventsub.delivery) "+
+		"eventsub.decode(eventsub.delivery) eventsub.verify(eventsub.delivery)"; got != want {
+		t.Errorf("spans = %s, want %s", got, want)
+	}
+	if s := tracer.span(twitcheventsub.SpanVerify); s.err == nil || !s.ended {
+		t.Error("verify span did not record the signature error")
+	}
+	if s := tracer.span(twitcheventsub.SpanDelivery); s.attrs[twitcheventsub.AttrOutcome] != twitcheventsub.OutcomeInvalidSignature || !s.ended {
+		t.Errorf("delivery outcome = %q, want %q", s.attrs[twitcheventsub.AttrOutcome], twitcheventsub.OutcomeInvalidSignature)
+	}
+
+	// a failing listener marks the dispatch span
+	tracer = &recorder{}
+	c = twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetSyncMode(true)
+	c.SetTracer(tracer)
+	failure := errors.New("listener failed")
+	c.AddListener(func(ctx context.Context, env twitcheventsub.Envelope) error {
+		return failure
+	})
+	process(t, c, follow(t, "1"))
+	if s := tracer.span(twitcheventsub.SpanDispatch); s == nil || !errors.Is(s.err, failure) {
+		t.Errorf("dispatch span did not record the listener error")
+	}
+	if s := tracer.span(twitcheventsub.SpanRead); s != nil {
+		t.Error("read span started for a body that was already read")
+	}
+}
@@ -0,0 +1,163 @@
+package twitcheventsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// verificationTTL is how long the outcome of a challenge is kept for WaitForVerification once nobody waits on it.
+const verificationTTL = time.Hour
+
+// ErrVerificationFailed is returned by WaitForVerification when a subscription can no longer become enabled.
+var ErrVerificationFailed = errors.New("subscription verification failed")
+
+type verification struct {
+	done       chan struct{}
+	err        error
+	resolvedAt time.Time
+	waiters    int
+}
+
+// getVerification returns the tracking entry for a subscription, creating it if needed, c.mu must be held.
+// Creating an entry drops the outcomes older than verificationTTL, so the map does not grow with every
+// subscription the client ever saw.
+func (c *Client) getVerification(id string) *verification {
+	v, ok := c.verifications[id]
+	if !ok {
+		now := time.Now()
+		for key, old := range c.verifications {
+			if old.waiters == 0 && !old.resolvedAt.IsZero() && now.Sub(old.resolvedAt) > verificationTTL {
+				delete(c.verifications, key)
+			}
+		}
+		v = &verification{done: make(chan struct{})}
+		c.verifications[id] = v
+	}
+	return v
+}
+
+// resolveVerification records the outcome of a challenge, only the first outcome for a subscription is kept.
+func (c *Client) resolveVerification(id string, err error) {
+	if id == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v := c.getVerification(id)
+	select {
+	case <-v.done:
+	default:
+		v.err = err
+		v.resolvedAt = time.Now()
+		close(v.done)
+	}
+}
+
+// revokeVerification records that a subscription was revoked, replacing a successful challenge outcome.
+func (c *Client) revokeVerification(id string, err error) {
+	if id == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v := c.getVerification(id)
+	select {
+	case <-v.done:
+		v = &verification{done: make(chan struct{})}
+		c.verifications[id] = v
+	default:
+	}
+	v.err = err
+	v.resolvedAt = time.Now()
+	close(v.done)
+}
+
+func (c *Client) forgetVerification(id string) {
+	c.mu.Lock()
+	delete(c.verifications, id)
+	c.mu.Unlock()
+}
+
+// WaitForVerification blocks until the challenge for the subscription has been answered, the challenge was
+// refused or the subscription was revoked, or ctx is done. It can be called before or after the challenge arrives.
+// Twitch sends nothing when it cannot reach the callback, use WaitForSubscription when that has to be detected.
+func (c *Client) WaitForVerification(ctx context.Context, subscriptionID string) error {
+	return c.WaitForVerificationFunc(ctx, subscriptionID, 0, nil)
+}
+
+// WaitForSubscription is WaitForVerification that also asks the subscription API for the subscription status
+// every interval. It returns once the subscription is enabled, even when another replica answered the challenge,
+// and fails as soon as Twitch reports a failed status. Requires an application OAuth access token.
+func (c *Client) WaitForSubscription(ctx context.Context, subscriptionID, token, clientId string, interval time.Duration) error {
+	return c.WaitForVerificationFunc(ctx, subscriptionID, interval, func(ctx context.Context) (string, error) {
+		sub, err := c.GetSubscription(ctx, subscriptionID, token, clientId)
+		return sub.Status, err
+	})
+}
+
+// WaitForVerificationFunc is WaitForVerification polling status every interval for the subscription status,
+// enabled ends the wait and any status other than webhook_callback_verification_pending fails it. Errors from
+// status are reported to OnError and polling goes on, except ErrSubscriptionNotFound which fails the wait.
+func (c *Client) WaitForVerificationFunc(ctx context.Context, subscriptionID string, interval time.Duration, status func(ctx context.Context) (string, error)) error {
+	c.mu.Lock()
+	v := c.getVerification(subscriptionID)
+	v.waiters++
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		v.waiters--
+		select {
+		case <-v.done:
+		default:
+			if v.waiters == 0 && c.verifications[subscriptionID] == v {
+				delete(c.verifications, subscriptionID)
+			}
+		}
+		c.mu.Unlock()
+	}()
+	var tick <-chan time.Time
+	if status != nil && interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-v.done:
+			return v.err
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-tick:
+			s, err := status(ctx)
+			switch {
+			case errors.Is(err, ErrSubscriptionNotFound):
+				return fmt.Errorf("%w: %w", ErrVerificationFailed, err)
+			case err != nil:
+				c.onError(fmt.Errorf("unable to get status of subscription %s: %w", subscriptionID, err))
+			case s == StatusEnabled:
+				return nil
+			case s != StatusVerificationPending:
+				return fmt.Errorf("%w: %s", ErrVerificationFailed, s)
+			}
+		}
+	}
+}
+
+// verified reports whether the challenge for the subscription has been answered, and the failure if it was refused
+// or the subscription revoked.
+func (c *Client) verified(id string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.verifications[id]
+	if !ok {
+		return false, nil
+	}
+	select {
+	case <-v.done:
+		return true, v.err
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,174 @@
+package twitcheventsub_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	twitcheventsub "github.com/Aiuzu42/go-twitch-eventsub"
+	"github.com/Aiuzu42/go-twitch-eventsub/eventsubtest"
+)
+
+// message delivers a challenge or a revocation for the subscription with the given id to c.
+func message(t *testing.T, c *twitcheventsub.Client, messageType, id string) int {
+	t.Helper()
+	sub := twitcheventsub.Subscription{Id: id, Type: twitcheventsub.Cheer, Version: "1",
+		Status: twitcheventsub.StatusAuthorizationRevoked}
+	body, err := eventsubtest.RevocationBody(sub)
+	if messageType == eventsubtest.MessageTypeChallenge {
+		sub.Status = twitcheventsub.StatusVerificationPending
+		body, err = eventsubtest.ChallengeBody(sub, "challenge-value")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := eventsubtest.NewRequest("http://localhost/eventsub", testSecret, messageType, sub, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, _ := c.Process(req.Header, body)
+	return status
+}
+
+// wait runs WaitForVerification in the background.
+func wait(c *twitcheventsub.Client, id string) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		done <- c.WaitForVerification(ctx, id)
+	}()
+	// let the wait start before the message arrives
+	time.Sleep(20 * time.Millisecond)
+	return done
+}
+
+func TestWaitForVerification(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.OnChallenge(func(sub twitcheventsub.Subscription) error {
+		if sub.Id == "refused" {
+			return errors.New("unknown subscription")
+		}
+		return nil
+	})
+
+	// challenge answered before the wait
+	if status := message(t, c, eventsubtest.MessageTypeChallenge, "before"); status != http.StatusOK {
+		t.Fatalf("challenge: status = %d, want %d", status, http.StatusOK)
+	}
+	if err := c.WaitForVerification(context.Background(), "before"); err != nil {
+		t.Errorf("challenge before the wait: %v", err)
+	}
+
+	// challenge answered during the wait
+	done := wait(c, "after")
+	message(t, c, eventsubtest.MessageTypeChallenge, "after")
+	if err := <-done; err != nil {
+		t.Errorf("challenge during the wait: %v", err)
+	}
+
+	done = wait(c, "refused")
+	if status := message(t, c, eventsubtest.MessageTypeChallenge, "refused"); status != http.StatusForbidden {
+		t.Errorf("refused challenge: status = %d, want %d", status, http.StatusForbidden)
+	}
+	if err := <-done; !errors.Is(err, twitcheventsub.ErrVerificationFailed) {
+		t.Errorf("refused challenge: %v, want ErrVerificationFailed", err)
+	}
+
+	done = wait(c, "revoked")
+	message(t, c, eventsubtest.MessageTypeRevocation, "revoked")
+	if err := <-done; !errors.Is(err, twitcheventsub.ErrVerificationFailed) {
+		t.Errorf("revocation during the wait: %v, want ErrVerificationFailed", err)
+	}
+
+	// a revocation replaces an answered challenge
+	message(t, c, eventsubtest.MessageTypeRevocation, "before")
+	if err := c.WaitForVerification(context.Background(), "before"); !errors.Is(err, twitcheventsub.ErrVerificationFailed) {
+		t.Errorf("revoked after the challenge: %v, want ErrVerificationFailed", err)
+	}
+}
+
+func TestWaitForVerificationForgotten(t *testing.T) {
+	c, helix := newHelix(t)
+	res, err := c.CreateSubscription(twitcheventsub.SubscriptionRequest{Type: twitcheventsub.Cheer, Version: "1",
+		Condition: twitcheventsub.Condition{BroadcasterUserId: "1"}}, "test-token", testClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := res.Data[0].Id
+	helix.Wait()
+	if err := c.WaitForVerification(context.Background(), id); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteSubscription(id, "test-token", testClientID); err != nil {
+		t.Fatal(err)
+	}
+	// the outcome went with the subscription
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := c.WaitForVerification(ctx, id); err != context.DeadlineExceeded {
+		t.Errorf("WaitForVerification after the delete = %v, want the context error", err)
+	}
+}
+
+func TestWaitForSubscription(t *testing.T) {
+	replica, helix := newHelix(t)
+	// c never sees the challenges, another replica answers them
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	c.SetBaseURL(helix.URL())
+	create := func(broadcaster string) string {
+		res, err := replica.CreateSubscription(twitcheventsub.SubscriptionRequest{Type: twitcheventsub.Cheer, Version: "1",
+			Condition: twitcheventsub.Condition{BroadcasterUserId: broadcaster}}, "test-token", testClientID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Data[0].Id
+	}
+	waitFor := func(id string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		return c.WaitForSubscription(ctx, id, "test-token", testClientID, 10*time.Millisecond)
+	}
+
+	if err := waitFor(create("1")); err != nil {
+		t.Errorf("enabled subscription: %v", err)
+	}
+	failed := create("2")
+	helix.Wait()
+	if err := helix.SetStatus(failed, twitcheventsub.StatusVerificationFailed); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitFor(failed); !errors.Is(err, twitcheventsub.ErrVerificationFailed) {
+		t.Errorf("failed subscription: %v, want ErrVerificationFailed", err)
+	}
+	if err := waitFor("missing"); !errors.Is(err, twitcheventsub.ErrSubscriptionNotFound) {
+		t.Errorf("missing subscription: %v, want ErrSubscriptionNotFound", err)
+	}
+}
+
+func TestWaitForVerificationFunc(t *testing.T) {
+	c := twitcheventsub.NewClient(testSecret, "http://localhost/eventsub")
+	var reported error
+	c.OnError(func(err error) {
+		reported = err
+	})
+	statuses := []string{"", twitcheventsub.StatusVerificationPending, twitcheventsub.StatusEnabled}
+	polls := 0
+	err := c.WaitForVerificationFunc(context.Background(), "polled", time.Millisecond,
+		func(ctx context.Context) (string, error) {
+			s := statuses[polls]
+			polls++
+			if s == "" {
+				return "", errors.New("helix unavailable")
+			}
+			return s, nil
+		})
+	if err != nil || polls != 3 {
+		t.Errorf("WaitForVerificationFunc = %v after %d polls, want nil after 3", err, polls)
+	}
+	if reported == nil {
+		t.Error("polling error not reported")
+	}
+}